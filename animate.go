@@ -0,0 +1,229 @@
+package globe
+
+import (
+	"errors"
+	"image"
+	"image/color"
+	"image/color/palette"
+	"image/gif"
+	"io"
+	"os"
+	"time"
+)
+
+// Animation constants.
+const (
+	// defaultFrameDelay is the delay between animation frames unless
+	// overridden by the FrameDelay option.
+	defaultFrameDelay = 100 * time.Millisecond
+
+	// maxPaletteSteps is the maximum number of shades of each color in an
+	// animation palette.
+	maxPaletteSteps = 32
+)
+
+// animation holds the options for animated output.
+type animation struct {
+	delay time.Duration
+	loops int
+}
+
+// AnimationOption configures animated output.
+type AnimationOption func(*animation)
+
+// FrameDelay sets the delay between frames of an animation.
+func FrameDelay(d time.Duration) AnimationOption {
+	return func(a *animation) {
+		a.delay = d
+	}
+}
+
+// Loops sets the number of times an animation plays. Zero, the default, loops
+// forever. Negative counts are invalid, and writing the animation fails.
+func Loops(n int) AnimationOption {
+	return func(a *animation) {
+		a.loops = n
+	}
+}
+
+// newAnimation builds animation settings from the given options.
+func newAnimation(options ...AnimationOption) (*animation, error) {
+	a := &animation{delay: defaultFrameDelay}
+	for _, option := range options {
+		option(a)
+	}
+	if a.loops < 0 {
+		return nil, errors.New("globe: loop count must not be negative")
+	}
+	return a, nil
+}
+
+// WriteGIF writes an animation of the visualization to w in GIF format with
+// dimensions (side, side). The animation has the given number of frames, which
+// must be positive, with the camera longitude advancing through a full
+// rotation of the globe.
+//
+// Frames share a palette of shades of the drawn colors. Visualizations with
+// more than 255 colors, such as long VertexColors gradients, are instead
+// quantized to the web-safe palette.
+func (g *Globe) WriteGIF(w io.Writer, side, frames int, options ...AnimationOption) error {
+	a, err := newAnimation(options...)
+	if err != nil {
+		return err
+	}
+	images, err := g.frames(side, frames)
+	if err != nil {
+		return err
+	}
+	anim := &gif.GIF{
+		Image: images,
+		Delay: make([]int, frames),
+	}
+	for i := range anim.Delay {
+		anim.Delay[i] = int(a.delay / (10 * time.Millisecond))
+	}
+	switch a.loops {
+	case 0:
+		anim.LoopCount = 0
+	case 1:
+		anim.LoopCount = -1
+	default:
+		anim.LoopCount = a.loops - 1
+	}
+	return gif.EncodeAll(w, anim)
+}
+
+// SaveGIF writes an animation of the visualization to filename in GIF format,
+// as in WriteGIF.
+func (g *Globe) SaveGIF(filename string, side, frames int, options ...AnimationOption) error {
+	f, err := os.Create(filename)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	return g.WriteGIF(f, side, frames, options...)
+}
+
+// frames renders n frames of a rotating globe, quantized to a common palette.
+func (g *Globe) frames(side, n int) ([]*image.Paletted, error) {
+	if n < 1 {
+		return nil, errors.New("globe: frame count must be positive")
+	}
+	p := g.palette()
+	frames := make([]*image.Paletted, n)
+	for i := range frames {
+		c := g.camera
+		c.Lng += 360 * float64(i) / float64(n)
+		frames[i] = quantize(g.image(c, side), p)
+	}
+	return frames, nil
+}
+
+// palette builds a palette suited to rendered images of the globe. Rendered
+// pixels are blends of the background with the colors of drawn geometry, so
+// the palette consists of shades between the background and each color, or
+// the web-safe colors if there are too many to fit.
+func (g *Globe) palette() color.Palette {
+	var colors []color.Color
+	seen := map[color.Color]bool{}
+	add := func(c color.Color) {
+		if c == nil || seen[c] {
+			return
+		}
+		seen[c] = true
+		colors = append(colors, c)
+	}
+	for _, l := range g.layers {
+		add(l.fill)
+		var lines []color.Color
+		if l.color != nil && (len(l.paths) > 0 || len(l.dots) > 0) {
+			lines = append(lines, l.color)
+		}
+		for _, c := range l.colors {
+			if c != nil {
+				lines = append(lines, c)
+			}
+		}
+		for _, c := range lines {
+			add(c)
+			if g.style.BackFace == BackFaceDim || g.style.BackFace == BackFaceDash {
+				add(g.style.fade(c))
+			}
+		}
+	}
+	if g.legend != nil {
+		add(g.style.LineColor)
+		min, max := g.legend.Domain()
+		for i := 0; i < legendStops; i++ {
+			add(g.legend.Color(min + float64(i)*(max-min)/(legendStops-1)))
+		}
+	}
+
+	bg := color.RGBAModel.Convert(color.Transparent).(color.RGBA)
+	if g.style.Background != nil {
+		bg = color.RGBAModel.Convert(g.style.Background).(color.RGBA)
+	}
+	p := color.Palette{bg}
+
+	if len(colors) == 0 {
+		return p
+	}
+	if len(colors) > 255 {
+		// There is no room for the colors, let alone their shades.
+		return append(p, palette.WebSafe...)
+	}
+	steps := 255 / len(colors)
+	if steps > maxPaletteSteps {
+		steps = maxPaletteSteps
+	}
+	for _, c := range colors {
+		full := over(color.RGBAModel.Convert(c).(color.RGBA), bg)
+		for k := 1; k <= steps; k++ {
+			p = append(p, mix(bg, full, float64(k)/float64(steps)))
+		}
+	}
+	return p
+}
+
+// over composites premultiplied color c over background bg.
+func over(c, bg color.RGBA) color.RGBA {
+	t := 1 - float64(c.A)/0xff
+	return color.RGBA{
+		R: c.R + uint8(t*float64(bg.R)),
+		G: c.G + uint8(t*float64(bg.G)),
+		B: c.B + uint8(t*float64(bg.B)),
+		A: c.A + uint8(t*float64(bg.A)),
+	}
+}
+
+// mix returns the premultiplied color fraction t of the way from a to b.
+func mix(a, b color.RGBA, t float64) color.RGBA {
+	m := func(x, y uint8) uint8 {
+		return uint8(float64(x) + t*(float64(y)-float64(x)) + 0.5)
+	}
+	return color.RGBA{
+		R: m(a.R, b.R),
+		G: m(a.G, b.G),
+		B: m(a.B, b.B),
+		A: m(a.A, b.A),
+	}
+}
+
+// quantize maps each pixel of m to the closest color in palette p.
+func quantize(m *image.RGBA, p color.Palette) *image.Paletted {
+	q := image.NewPaletted(m.Bounds(), p)
+	cache := map[color.RGBA]uint8{}
+	b := m.Bounds()
+	for y := b.Min.Y; y < b.Max.Y; y++ {
+		for x := b.Min.X; x < b.Max.X; x++ {
+			c := m.RGBAAt(x, y)
+			idx, ok := cache[c]
+			if !ok {
+				idx = uint8(p.Index(c))
+				cache[c] = idx
+			}
+			q.SetColorIndex(x, y, idx)
+		}
+	}
+	return q
+}
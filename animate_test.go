@@ -0,0 +1,172 @@
+package globe
+
+import (
+	"bytes"
+	"encoding/binary"
+	"image"
+	"image/color"
+	"image/color/palette"
+	"image/gif"
+	"image/png"
+	"testing"
+	"time"
+
+	"github.com/mmcloughlin/globe/geo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func AnimatedGlobe() *Globe {
+	g := New()
+	g.DrawGraticule(15.0)
+	g.DrawLandBoundaries()
+	g.DrawDot(51.453349, -2.588323, 0.1)
+	g.CenterOn(20, 0)
+	return g
+}
+
+func TestWriteGIF(t *testing.T) {
+	buf := new(bytes.Buffer)
+	err := AnimatedGlobe().WriteGIF(buf, 128, 6, FrameDelay(50*time.Millisecond), Loops(3))
+	require.NoError(t, err)
+
+	anim, err := gif.DecodeAll(buf)
+	require.NoError(t, err)
+	assert.Len(t, anim.Image, 6)
+	assert.Equal(t, []int{5, 5, 5, 5, 5, 5}, anim.Delay)
+	assert.Equal(t, 2, anim.LoopCount)
+	assert.Equal(t, 128, anim.Config.Width)
+	assert.NotEqual(t, anim.Image[0].Pix, anim.Image[1].Pix)
+}
+
+func TestWriteAPNG(t *testing.T) {
+	buf := new(bytes.Buffer)
+	err := AnimatedGlobe().WriteAPNG(buf, 128, 4, FrameDelay(40*time.Millisecond))
+	require.NoError(t, err)
+
+	// The default image must be readable by decoders without APNG support.
+	m, err := png.Decode(bytes.NewReader(buf.Bytes()))
+	require.NoError(t, err)
+	assert.Equal(t, 128, m.Bounds().Dx())
+
+	chunks, err := readPNGChunks(buf.Bytes())
+	require.NoError(t, err)
+	count := map[string]int{}
+	seq := []uint32{}
+	for _, c := range chunks {
+		count[c.typ]++
+		switch c.typ {
+		case "acTL":
+			assert.Equal(t, uint32(4), binary.BigEndian.Uint32(c.data))
+			assert.Equal(t, uint32(0), binary.BigEndian.Uint32(c.data[4:]))
+		case "fcTL":
+			assert.Equal(t, uint16(40), binary.BigEndian.Uint16(c.data[20:]))
+			seq = append(seq, binary.BigEndian.Uint32(c.data))
+		case "fdAT":
+			seq = append(seq, binary.BigEndian.Uint32(c.data))
+		}
+	}
+	assert.Equal(t, 1, count["acTL"])
+	assert.Equal(t, 4, count["fcTL"])
+	assert.Equal(t, "IEND", chunks[len(chunks)-1].typ)
+	for i, s := range seq {
+		assert.Equal(t, uint32(i), s)
+	}
+}
+
+func TestAnimationFrameCount(t *testing.T) {
+	cases := []struct {
+		Name   string
+		Frames int
+		OK     bool
+	}{
+		{"negative", -1, false},
+		{"zero", 0, false},
+		{"one", 1, true},
+	}
+	g := AnimatedGlobe()
+	for _, c := range cases {
+		errGIF := g.WriteGIF(new(bytes.Buffer), 32, c.Frames)
+		errAPNG := g.WriteAPNG(new(bytes.Buffer), 32, c.Frames)
+		if c.OK {
+			assert.NoError(t, errGIF, c.Name)
+			assert.NoError(t, errAPNG, c.Name)
+		} else {
+			assert.EqualError(t, errGIF, "globe: frame count must be positive", c.Name)
+			assert.EqualError(t, errAPNG, "globe: frame count must be positive", c.Name)
+		}
+	}
+}
+
+func TestWriteGIFVertexColors(t *testing.T) {
+	red := color.NRGBA{255, 0, 0, 255}
+	for _, mode := range []BackFace{BackFaceShow, BackFaceDim, BackFaceDash} {
+		g := New()
+		s := DefaultStyle
+		s.BackFace = mode
+		g.SetStyle(s)
+		points := []geo.LatLng{{Lat: -40, Lng: -30}, {Lat: 40, Lng: 30}}
+		g.DrawPath(points, VertexColors([]color.Color{red, red}))
+		g.CenterOn(0, 0)
+
+		buf := new(bytes.Buffer)
+		require.NoError(t, g.WriteGIF(buf, 256, 2))
+		anim, err := gif.DecodeAll(buf)
+		require.NoError(t, err)
+
+		// The path is in front in the first frame and behind in the second.
+		// Its pixels must stay red rather than be quantized to the gray of
+		// the default line color.
+		for i, m := range anim.Image {
+			assert.True(t, hasRed(m), "mode %d frame %d", mode, i)
+		}
+		if mode != BackFaceShow {
+			white := color.RGBA{255, 255, 255, 255}
+			faded := over(color.RGBAModel.Convert(s.fade(red)).(color.RGBA), white)
+			assert.Contains(t, g.palette(), faded, mode)
+		}
+	}
+}
+
+// hasRed reports whether m, on a white background, has a pixel tinted red.
+func hasRed(m image.Image) bool {
+	b := m.Bounds()
+	for y := b.Min.Y; y < b.Max.Y; y++ {
+		for x := b.Min.X; x < b.Max.X; x++ {
+			c := color.NRGBAModel.Convert(m.At(x, y)).(color.NRGBA)
+			if c.R == 0xff && c.G < 0xff && c.G == c.B {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func TestAnimationNegativeLoops(t *testing.T) {
+	g := AnimatedGlobe()
+	err := g.WriteGIF(new(bytes.Buffer), 32, 2, Loops(-1))
+	assert.EqualError(t, err, "globe: loop count must not be negative")
+	err = g.WriteAPNG(new(bytes.Buffer), 32, 2, Loops(-1))
+	assert.EqualError(t, err, "globe: loop count must not be negative")
+}
+
+func TestPaletteOverflow(t *testing.T) {
+	g := New()
+	for i := 0; i < 300; i++ {
+		c := color.NRGBA{uint8(i), uint8(i / 2), 255 - uint8(i), 255}
+		g.DrawLine(0, float64(i), 10, float64(i), Color(c))
+	}
+	p := g.palette()
+	assert.Equal(t, color.RGBA{255, 255, 255, 255}, p[0])
+	assert.Equal(t, palette.WebSafe, []color.Color(p[1:]))
+}
+
+func TestPalette(t *testing.T) {
+	g := New()
+	g.DrawGraticule(10.0)
+	g.DrawLine(0, 0, 10, 10, Color(color.NRGBA{0, 0, 255, 255}))
+	p := g.palette()
+	assert.Equal(t, color.RGBA{255, 255, 255, 255}, p[0])
+	assert.Len(t, p, 1+2*maxPaletteSteps)
+	assert.Equal(t, color.RGBA{0, 0, 255, 255}, p[len(p)-1])
+}
@@ -0,0 +1,178 @@
+package globe
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"hash/crc32"
+	"image"
+	"image/png"
+	"io"
+	"os"
+	"time"
+)
+
+// pngSignature is the magic header of PNG files.
+const pngSignature = "\x89PNG\r\n\x1a\n"
+
+// WriteAPNG writes an animation of the visualization to w in animated PNG
+// format, as in WriteGIF.
+func (g *Globe) WriteAPNG(w io.Writer, side, frames int, options ...AnimationOption) error {
+	a, err := newAnimation(options...)
+	if err != nil {
+		return err
+	}
+	images, err := g.frames(side, frames)
+	if err != nil {
+		return err
+	}
+	return encodeAPNG(w, images, a)
+}
+
+// SaveAPNG writes an animation of the visualization to filename in animated PNG
+// format, as in WriteGIF.
+func (g *Globe) SaveAPNG(filename string, side, frames int, options ...AnimationOption) error {
+	f, err := os.Create(filename)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	return g.WriteAPNG(f, side, frames, options...)
+}
+
+// pngChunk is a chunk of a PNG file.
+type pngChunk struct {
+	typ  string
+	data []byte
+}
+
+// encodeAPNG writes frames to w as an animated PNG. All frames must have the
+// same bounds and palette.
+func encodeAPNG(w io.Writer, frames []*image.Paletted, a *animation) error {
+	if len(frames) == 0 {
+		return errors.New("globe: animation has no frames")
+	}
+
+	bounds := frames[0].Bounds()
+	delay := a.delay / time.Millisecond
+	if delay > 0xffff {
+		delay = 0xffff
+	}
+
+	out := &apngWriter{w: w}
+	out.write([]byte(pngSignature))
+	seq := uint32(0)
+	for i, frame := range frames {
+		chunks, err := encodePNGChunks(frame)
+		if err != nil {
+			return err
+		}
+
+		if i == 0 {
+			// Header chunks precede the first frame data, with the
+			// animation control chunk inserted after IHDR.
+			for _, c := range chunks {
+				if c.typ == "IDAT" || c.typ == "IEND" {
+					continue
+				}
+				out.chunk(c.typ, c.data)
+				if c.typ == "IHDR" {
+					actl := make([]byte, 8)
+					binary.BigEndian.PutUint32(actl[0:], uint32(len(frames)))
+					binary.BigEndian.PutUint32(actl[4:], uint32(a.loops))
+					out.chunk("acTL", actl)
+				}
+			}
+		}
+
+		fctl := make([]byte, 26)
+		binary.BigEndian.PutUint32(fctl[0:], seq)
+		binary.BigEndian.PutUint32(fctl[4:], uint32(bounds.Dx()))
+		binary.BigEndian.PutUint32(fctl[8:], uint32(bounds.Dy()))
+		binary.BigEndian.PutUint16(fctl[20:], uint16(delay))
+		binary.BigEndian.PutUint16(fctl[22:], 1000)
+		out.chunk("fcTL", fctl)
+		seq++
+
+		for _, c := range chunks {
+			if c.typ != "IDAT" {
+				continue
+			}
+			if i == 0 {
+				out.chunk("IDAT", c.data)
+				continue
+			}
+			fdat := make([]byte, 4+len(c.data))
+			binary.BigEndian.PutUint32(fdat, seq)
+			copy(fdat[4:], c.data)
+			out.chunk("fdAT", fdat)
+			seq++
+		}
+	}
+	out.chunk("IEND", nil)
+
+	return out.err
+}
+
+// encodePNGChunks encodes m as a PNG and returns its chunks.
+func encodePNGChunks(m image.Image) ([]pngChunk, error) {
+	buf := new(bytes.Buffer)
+	if err := png.Encode(buf, m); err != nil {
+		return nil, err
+	}
+	return readPNGChunks(buf.Bytes())
+}
+
+// readPNGChunks parses the chunks of the PNG file b.
+func readPNGChunks(b []byte) ([]pngChunk, error) {
+	if !bytes.HasPrefix(b, []byte(pngSignature)) {
+		return nil, errors.New("globe: missing png signature")
+	}
+	b = b[len(pngSignature):]
+
+	var chunks []pngChunk
+	for len(b) > 0 {
+		if len(b) < 12 {
+			return nil, errors.New("globe: truncated png chunk")
+		}
+		n := binary.BigEndian.Uint32(b)
+		if uint64(len(b)) < 12+uint64(n) {
+			return nil, errors.New("globe: truncated png chunk")
+		}
+		chunks = append(chunks, pngChunk{
+			typ:  string(b[4:8]),
+			data: b[8 : 8+n],
+		})
+		b = b[12+n:]
+	}
+	return chunks, nil
+}
+
+// apngWriter writes PNG chunks, recording the first error encountered.
+type apngWriter struct {
+	w   io.Writer
+	err error
+}
+
+// write writes b to the underlying writer.
+func (a *apngWriter) write(b []byte) {
+	if a.err != nil {
+		return
+	}
+	_, a.err = a.w.Write(b)
+}
+
+// chunk writes a chunk with the given type and data.
+func (a *apngWriter) chunk(typ string, data []byte) {
+	hdr := make([]byte, 8)
+	binary.BigEndian.PutUint32(hdr, uint32(len(data)))
+	copy(hdr[4:], typ)
+	crc := crc32.NewIEEE()
+	crc.Write(hdr[4:])
+	crc.Write(data)
+	sum := make([]byte, 4)
+	binary.BigEndian.PutUint32(sum, crc.Sum32())
+	a.write(hdr)
+	a.write(data)
+	a.write(sum)
+}
@@ -0,0 +1,258 @@
+package globe
+
+import (
+	"image/color"
+	"math"
+)
+
+// BackFace specifies how geometry on the far side of the globe is drawn.
+type BackFace int
+
+// Supported BackFace modes.
+const (
+	// BackFaceShow draws far side geometry the same as the near side.
+	BackFaceShow BackFace = iota
+
+	// BackFaceHide does not draw far side geometry.
+	BackFaceHide
+
+	// BackFaceDim draws far side geometry in a faded color.
+	BackFaceDim
+
+	// BackFaceDash draws far side lines dashed, and far side dots faded.
+	BackFaceDash
+)
+
+// Back face rendering constants.
+const (
+	// backFaceFade is the proportion of the original color retained when
+	// fading far side geometry.
+	backFaceFade = 0.3
+
+	// backFaceDashLength is the length of dashes and the gaps between them in
+	// cartestian space.
+	backFaceDashLength = 0.02
+
+	// horizonIterations is the number of bisection steps taken to find the
+	// point a segment crosses the horizon.
+	horizonIterations = 24
+)
+
+// visible reports whether p, in camera space, is on the near side of the
+// globe, or within the bounds of a flat map.
+func (v view) visible(p point) bool {
+	switch {
+	case v.Projection == ProjectionMercator:
+		return math.Abs(p.y) < math.Sin(mercatorMaxLat)
+	case v.Projection.cut():
+		return true
+	}
+	return p.z < v.horizon()
+}
+
+// backFace applies back face handling to meshes.
+func (v view) backFace(meshes []*mesh) []*mesh {
+	if v.BackFace == BackFaceShow {
+		return meshes
+	}
+
+	var front, back []*mesh
+	for _, m := range meshes {
+		f, b := v.splitMesh(m)
+		front = append(front, f)
+		if b == nil {
+			continue
+		}
+		if b.color != nil {
+			b.color = v.fade(b.color)
+		}
+		for i, c := range b.colors {
+			b.colors[i] = v.fade(c)
+		}
+		if v.BackFace == BackFaceDash {
+			dashed := &mesh{color: b.color, dots: b.dots}
+			if b.colors != nil {
+				dashed.colors = []color.Color{}
+			}
+			for i, path := range b.paths {
+				for _, dash := range dashPath(path, backFaceDashLength) {
+					dashed.addPath(dash, b.pathColor(i))
+				}
+			}
+			b = dashed
+		}
+		back = append(back, b)
+	}
+
+	return append(back, front...)
+}
+
+// splitMesh splits m into geometry on the near and far side of the globe. The
+// far side mesh is nil if it would not be drawn. Fills are always clipped to
+// the near side.
+func (v view) splitMesh(m *mesh) (*mesh, *mesh) {
+	front := &mesh{color: m.color, fill: m.fill, fills: m.fills}
+	var back *mesh
+	if v.BackFace != BackFaceHide && (m.color != nil || m.colors != nil) {
+		back = &mesh{color: m.color}
+	}
+	if m.colors != nil {
+		front.colors = []color.Color{}
+		if back != nil {
+			back.colors = []color.Color{}
+		}
+	}
+
+	for i, path := range m.paths {
+		for _, piece := range v.splitPath(path) {
+			if v.visiblePiece(piece) {
+				front.addPath(piece, m.pathColor(i))
+			} else if back != nil {
+				back.addPath(piece, m.pathColor(i))
+			}
+		}
+	}
+
+	for _, d := range m.dots {
+		if v.visible(d.point) {
+			front.dots = append(front.dots, d)
+		} else if back != nil {
+			back.dots = append(back.dots, d)
+		}
+	}
+
+	return front, back
+}
+
+// splitPath cuts path where it crosses the horizon. The returned pieces are
+// each entirely on one side of the globe.
+func (v view) splitPath(path []point) [][]point {
+	if len(path) == 0 {
+		return nil
+	}
+
+	var pieces [][]point
+	piece := []point{path[0]}
+	for i := 1; i < len(path); i++ {
+		a, b := path[i-1], path[i]
+		if v.visible(a) != v.visible(b) {
+			c := v.crossing(a, b)
+			piece = append(piece, c)
+			pieces = append(pieces, piece)
+			piece = []point{c}
+		}
+		piece = append(piece, b)
+	}
+	return append(pieces, piece)
+}
+
+// visiblePiece reports whether a piece returned by splitPath is on the near
+// side. Only the end points of a piece may lie on the horizon.
+func (v view) visiblePiece(piece []point) bool {
+	if len(piece) == 2 {
+		return v.visible(midpoint(piece[0], piece[1]))
+	}
+	return v.visible(piece[len(piece)/2])
+}
+
+// crossing returns the point where the great circle segment from a to b
+// crosses the horizon. The points a and b must be on opposite sides.
+func (v view) crossing(a, b point) point {
+	va := v.visible(a)
+	lo, hi := 0.0, 1.0
+	for i := 0; i < horizonIterations; i++ {
+		t := (lo + hi) / 2
+		if v.visible(lerp(a, b, t)) == va {
+			lo = t
+		} else {
+			hi = t
+		}
+	}
+	return lerp(a, b, (lo+hi)/2)
+}
+
+// lerp returns the point fraction t along the chord from a to b, projected
+// back onto the unit sphere.
+func lerp(a, b point, t float64) point {
+	return normalize(point{
+		x: a.x + t*(b.x-a.x),
+		y: a.y + t*(b.y-a.y),
+		z: a.z + t*(b.z-a.z),
+	})
+}
+
+// midpoint returns the point half way between a and b on the unit sphere.
+func midpoint(a, b point) point {
+	return lerp(a, b, 0.5)
+}
+
+// normalize scales p to unit length.
+func normalize(p point) point {
+	n := math.Sqrt(p.x*p.x + p.y*p.y + p.z*p.z)
+	if n == 0 {
+		return p
+	}
+	return point{p.x / n, p.y / n, p.z / n}
+}
+
+// distance returns the euclidean distance between a and b.
+func distance(a, b point) float64 {
+	dx, dy, dz := b.x-a.x, b.y-a.y, b.z-a.z
+	return math.Sqrt(dx*dx + dy*dy + dz*dz)
+}
+
+// dashPath breaks path into dashes of length n separated by gaps of the same
+// length.
+func dashPath(path []point, n float64) [][]point {
+	var dashes [][]point
+	var dash []point
+	on := true
+	remain := n
+	for i := 0; i+1 < len(path); i++ {
+		a, b := path[i], path[i+1]
+		if on && len(dash) == 0 {
+			dash = []point{a}
+		}
+		d := distance(a, b)
+		pos := 0.0
+		for d-pos > remain {
+			pos += remain
+			c := lerp(a, b, pos/d)
+			if on {
+				dashes = append(dashes, append(dash, c))
+				dash = nil
+			} else {
+				dash = []point{c}
+			}
+			on = !on
+			remain = n
+		}
+		remain -= d - pos
+		if on {
+			dash = append(dash, b)
+		}
+	}
+	if len(dash) > 1 {
+		dashes = append(dashes, dash)
+	}
+	return dashes
+}
+
+// fade returns the color c faded towards the background.
+func (s Style) fade(c color.Color) color.Color {
+	n := color.NRGBAModel.Convert(c).(color.NRGBA)
+	if s.Background == nil {
+		n.A = uint8(float64(n.A) * backFaceFade)
+		return n
+	}
+	bg := color.NRGBAModel.Convert(s.Background).(color.NRGBA)
+	mix := func(a, b uint8) uint8 {
+		return uint8(math.Round(backFaceFade*float64(a) + (1-backFaceFade)*float64(b)))
+	}
+	return color.NRGBA{
+		R: mix(n.R, bg.R),
+		G: mix(n.G, bg.G),
+		B: mix(n.B, bg.B),
+		A: n.A,
+	}
+}
@@ -0,0 +1,64 @@
+package globe
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func BackFaceGlobe(mode BackFace) *Globe {
+	g := New()
+	s := DefaultStyle
+	s.BackFace = mode
+	g.SetStyle(s)
+	g.DrawGraticule(10.0)
+	g.DrawLandBoundaries()
+	g.CenterOn(51.453349, -2.588323)
+	return g
+}
+
+func TestBackFaceHide(t *testing.T) {
+	AssertSVGMD5(t, BackFaceGlobe(BackFaceHide), "67976405a3a47073d62eb4dcdc2fc9c4")
+}
+
+func TestBackFaceDim(t *testing.T) {
+	AssertSVGMD5(t, BackFaceGlobe(BackFaceDim), "63cc442bb866500610ce4efc35f9d62c")
+}
+
+func TestBackFaceDash(t *testing.T) {
+	AssertSVGMD5(t, BackFaceGlobe(BackFaceDash), "8be530ca0b038c83e5d35a9516350324")
+}
+
+func TestSplitPath(t *testing.T) {
+	v := New().view(DefaultCamera)
+	path := []point{}
+	for lat := -90.0; lat <= 90.0; lat += 10 {
+		path = append(path, cartestianPoint(lat, 0))
+	}
+	pieces := v.splitPath(path)
+	require.Len(t, pieces, 2)
+	assert.False(t, v.visiblePiece(pieces[0]))
+	assert.True(t, v.visiblePiece(pieces[1]))
+	assert.InDelta(t, v.horizon(), pieces[1][0].z, 1e-6)
+}
+
+func TestDashPath(t *testing.T) {
+	path := []point{}
+	for lng := 0.0; lng <= 90.0; lng += 7 {
+		path = append(path, cartestianPoint(0, lng))
+	}
+	dashes := dashPath(path, 0.1)
+	require.NotEmpty(t, dashes)
+	for _, dash := range dashes[:len(dashes)-1] {
+		n := 0.0
+		for i := 0; i+1 < len(dash); i++ {
+			n += distance(dash[i], dash[i+1])
+		}
+		assert.InDelta(t, 0.1, n, 1e-3)
+	}
+	for i := 0; i+1 < len(dashes); i++ {
+		gap := distance(dashes[i][len(dashes[i])-1], dashes[i+1][0])
+		assert.InDelta(t, 0.1, gap, 1e-3)
+	}
+}
@@ -3,104 +3,245 @@
 package main
 
 import (
+	"encoding/csv"
 	"errors"
 	"flag"
 	"fmt"
-	"io"
-	"io/ioutil"
 	"log"
 	"os"
+	"path/filepath"
 	"strings"
 
-	geojson "github.com/paulmach/go.geojson"
+	"github.com/mmcloughlin/globe/geojson"
+	"github.com/mmcloughlin/globe/internal/geodata"
+	"github.com/mmcloughlin/globe/shapefile"
+	"github.com/mmcloughlin/globe/topojson"
 )
 
 var (
 	inputFilepath  string
 	outputFilepath string
-	variableName   string
+	objectName     string
+	features       bool
+	codesFilepath  string
 )
 
 func init() {
-	flag.StringVar(&inputFilepath, "input", "", "Input GeoJSON file")
-	flag.StringVar(&outputFilepath, "output", "", "Output Go file")
-	flag.StringVar(&variableName, "var", "", "Variable name")
+	flag.StringVar(&inputFilepath, "input", "", "Input GeoJSON, TopoJSON or shapefile (.shp) file")
+	flag.StringVar(&objectName, "object", "", "TopoJSON object to extract (input is GeoJSON if empty)")
+	flag.StringVar(&outputFilepath, "output", "", "Output geodata file")
+	flag.BoolVar(&features, "features", false, "Preserve features and their properties")
+	flag.StringVar(&codesFilepath, "codes", "", "ISO 3166 country codes CSV used to fill in missing properties")
 }
 
-func LoadFeatureCollection(filename string) (*geojson.FeatureCollection, error) {
-	b, err := ioutil.ReadFile(inputFilepath)
+// Feature is a set of paths with identifying properties.
+type Feature struct {
+	ID     string
+	Alpha2 string
+	Alpha3 string
+	Name   string
+	Paths  [][][]float64
+}
+
+// LoadFeatureCollection loads a GeoJSON file, or the named object from a
+// TopoJSON file if object is not empty. Files with a .shp extension are loaded
+// as shapefiles, with attributes from the .dbf file alongside.
+func LoadFeatureCollection(filename, object string) (*geojson.FeatureCollection, error) {
+	if strings.EqualFold(filepath.Ext(filename), ".shp") {
+		return shapefile.Open(filename)
+	}
+
+	f, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	if object == "" {
+		return geojson.Decode(f)
+	}
+
+	t, err := topojson.Decode(f)
+	if err != nil {
+		return nil, err
+	}
+	return t.FeatureCollection(object)
+}
+
+// LoadCodes loads a CSV file of ISO 3166 country codes, with columns numeric,
+// alpha2, alpha3 and name. The result is keyed by numeric code.
+func LoadCodes(filename string) (map[string]Feature, error) {
+	f, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	records, err := csv.NewReader(f).ReadAll()
 	if err != nil {
 		return nil, err
 	}
+	if len(records) == 0 {
+		return nil, errors.New("empty codes file")
+	}
 
-	return geojson.UnmarshalFeatureCollection(b)
+	codes := map[string]Feature{}
+	for _, record := range records[1:] {
+		if len(record) != 4 {
+			return nil, fmt.Errorf("expected 4 columns in codes file, got %d", len(record))
+		}
+		codes[record[0]] = Feature{
+			ID:     record[0],
+			Alpha2: record[1],
+			Alpha3: record[2],
+			Name:   record[3],
+		}
+	}
+	return codes, nil
+}
+
+func ExtractPathsFromFeature(feature *geojson.Feature) [][][]float64 {
+	geom := feature.Geometry
+	if geom == nil {
+		return nil
+	}
+	var layer4 [][][][]float64
+	switch geom.Type {
+	case geojson.Polygon:
+		layer4 = [][][][]float64{geom.Polygon}
+	case geojson.MultiPolygon:
+		layer4 = geom.MultiPolygon
+	case geojson.Point, geojson.MultiPoint:
+		log.Printf("discarding point geometry type %s", string(geom.Type))
+	default:
+		log.Fatalf("no handler for geometry type %s", string(geom.Type))
+	}
+
+	paths := [][][]float64{}
+	for _, layer3 := range layer4 {
+		paths = append(paths, layer3...)
+	}
+	return paths
 }
 
 func ExtractPathsFromFeatureCollection(collection *geojson.FeatureCollection) [][][]float64 {
 	paths := [][][]float64{}
 	for _, feature := range collection.Features {
-		geom := feature.Geometry
-		var layer4 [][][][]float64
-		switch geom.Type {
-		case geojson.GeometryPolygon:
-			layer4 = [][][][]float64{geom.Polygon}
-		case geojson.GeometryMultiPolygon:
-			layer4 = geom.MultiPolygon
-		case geojson.GeometryPoint, geojson.GeometryMultiPoint:
-			log.Printf("discarding point geometry type %s", string(geom.Type))
-		default:
-			log.Fatalf("no handler for geometry type %s", string(geom.Type))
-		}
+		paths = append(paths, ExtractPathsFromFeature(feature)...)
+	}
+	return paths
+}
 
-		for _, layer3 := range layer4 {
-			paths = append(paths, layer3...)
+// property returns the first of the given properties of feature that is set.
+func property(feature *geojson.Feature, keys ...string) string {
+	for _, key := range keys {
+		for _, k := range []string{key, strings.ToUpper(key)} {
+			if v, ok := feature.PropertyString(k); ok {
+				return v
+			}
 		}
 	}
-
-	return paths
+	return ""
 }
 
-func WritePathsCode(w io.Writer, varname string, paths [][][]float64) error {
-	fmt.Fprint(w, "// Generated code. DO NOT EDIT.\n")
-	fmt.Fprintf(w, "// Arguments: %s\n\n", strings.Join(os.Args[1:], " "))
-	fmt.Fprint(w, "package globe\n")
-
-	fmt.Fprintf(w, "var %s = [][]struct{\nlat, lng float32\n}{\n", varname)
-	for _, path := range paths {
-		fmt.Fprint(w, "{\n")
-		for _, point := range path {
-			if len(point) != 2 {
-				return errors.New("point must have two coordinates")
+// ExtractFeaturesFromFeatureCollection extracts paths and identifying
+// properties of each feature. Properties missing from a feature are filled in
+// from codes by numeric id, if available.
+func ExtractFeaturesFromFeatureCollection(collection *geojson.FeatureCollection, codes map[string]Feature) []Feature {
+	var fs []Feature
+	for _, feature := range collection.Features {
+		if feature.Geometry == nil {
+			log.Printf("discarding feature %v without geometry", feature.ID)
+			continue
+		}
+		f := Feature{
+			ID:     property(feature, "iso_n3", "id"),
+			Alpha2: property(feature, "iso_a2"),
+			Alpha3: property(feature, "iso_a3"),
+			Name:   property(feature, "name"),
+			Paths:  ExtractPathsFromFeature(feature),
+		}
+		if feature.ID != nil {
+			f.ID = fmt.Sprint(feature.ID)
+		}
+		if c, ok := codes[f.ID]; ok {
+			if f.Alpha2 == "" {
+				f.Alpha2 = c.Alpha2
+			}
+			if f.Alpha3 == "" {
+				f.Alpha3 = c.Alpha3
+			}
+			if f.Name == "" {
+				f.Name = c.Name
 			}
-			fmt.Fprintf(w, "{%v,%v},\n", point[1], point[0])
 		}
-		fmt.Fprint(w, "},\n")
+		fs = append(fs, f)
 	}
-	fmt.Fprint(w, "}\n")
+	return fs
+}
 
-	return nil
+// EncodeFeatures converts fs to geodata features.
+func EncodeFeatures(fs []Feature) []geodata.Feature {
+	out := make([]geodata.Feature, len(fs))
+	for i, f := range fs {
+		out[i] = geodata.Feature{
+			ID:     f.ID,
+			Alpha2: f.Alpha2,
+			Alpha3: f.Alpha3,
+			Name:   f.Name,
+			Rings:  EncodePaths(f.Paths),
+		}
+	}
+	return out
+}
+
+// EncodePaths converts paths of [longitude, latitude] positions to geodata
+// rings.
+func EncodePaths(paths [][][]float64) [][]geodata.Point {
+	rings := make([][]geodata.Point, len(paths))
+	for i, path := range paths {
+		rings[i] = make([]geodata.Point, len(path))
+		for j, p := range path {
+			rings[i][j] = geodata.Point{Lat: float32(p[1]), Lng: float32(p[0])}
+		}
+	}
+	return rings
 }
 
 func main() {
 	flag.Parse()
 
-	collection, err := LoadFeatureCollection(inputFilepath)
+	collection, err := LoadFeatureCollection(inputFilepath, objectName)
 	if err != nil {
 		log.Fatal(err)
 	}
 	log.Printf("loaded %d features", len(collection.Features))
 
-	paths := ExtractPathsFromFeatureCollection(collection)
-	log.Printf("extracted %d paths", len(paths))
-
 	f, err := os.Create(outputFilepath)
 	if err != nil {
 		log.Fatal(err)
 	}
 	defer f.Close()
 
-	err = WritePathsCode(f, variableName, paths)
-	if err != nil {
+	var fs []geodata.Feature
+	if features {
+		codes := map[string]Feature{}
+		if codesFilepath != "" {
+			codes, err = LoadCodes(codesFilepath)
+			if err != nil {
+				log.Fatal(err)
+			}
+		}
+		extracted := ExtractFeaturesFromFeatureCollection(collection, codes)
+		log.Printf("extracted %d features", len(extracted))
+		fs = EncodeFeatures(extracted)
+	} else {
+		paths := ExtractPathsFromFeatureCollection(collection)
+		log.Printf("extracted %d paths", len(paths))
+		fs = []geodata.Feature{{Rings: EncodePaths(paths)}}
+	}
+
+	if err := geodata.Encode(f, fs); err != nil {
 		log.Fatal(err)
 	}
 }
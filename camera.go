@@ -0,0 +1,252 @@
+package globe
+
+import (
+	"image/color"
+	"math"
+
+	"github.com/tidwall/pinhole"
+)
+
+// Camera specifies the viewpoint a globe is rendered from.
+type Camera struct {
+	// Lat and Lng give the point on the globe at the center of the view.
+	Lat, Lng float64
+
+	// Heading is the compass bearing (in degrees) pointing up in the view.
+	Heading float64
+
+	// Zoom magnifies the view. Zero is treated as 1.
+	Zoom float64
+
+	// Distance is the distance of the camera from the center of the globe, in
+	// multiples of the globe radius, and must be greater than 1. Larger values
+	// reduce perspective distortion. Zero uses the reciprocal of the Style
+	// Scale. The scale at the center of the view is the same for all
+	// distances. Distance does not apply to flat map projections.
+	Distance float64
+}
+
+// DefaultCamera looks down on the north pole.
+var DefaultCamera = Camera{Lat: 90, Lng: -90}
+
+// SetCamera sets the camera the globe is rendered from. The camera applies
+// only when rendering, so geometry drawn before and after is treated the same.
+func (g *Globe) SetCamera(c Camera) {
+	g.camera = c
+}
+
+// Camera returns the camera the globe is rendered from.
+func (g *Globe) Camera() Camera {
+	return g.camera
+}
+
+// point is a location in pinhole cartestian space.
+type point struct {
+	x, y, z float64
+}
+
+// pointDot is a point drawn with a radius.
+type pointDot struct {
+	point
+	radius float64
+}
+
+// mesh is a layer transformed to camera space, ready for drawing.
+type mesh struct {
+	color color.Color
+	fill  color.Color
+	paths [][]point
+	dots  []pointDot
+	fills [][]point
+
+	// colors, if set, holds the color of each path, overriding color.
+	colors []color.Color
+}
+
+// pathColor returns the color of the i'th path.
+func (m *mesh) pathColor(i int) color.Color {
+	if m.colors != nil {
+		return m.colors[i]
+	}
+	return m.color
+}
+
+// addPath appends path to m, in color c if m has per-path colors.
+func (m *mesh) addPath(path []point, c color.Color) {
+	m.paths = append(m.paths, path)
+	if m.colors != nil {
+		m.colors = append(m.colors, c)
+	}
+}
+
+// view holds the parameters for rendering from a camera.
+type view struct {
+	Style
+	camera Camera
+
+	// perspective is the pinhole scale: the reciprocal of the camera
+	// distance.
+	perspective float64
+
+	// zoom scales the x and y coordinates of points in camera space.
+	zoom float64
+
+	// tolerance is the distance in camera space that geometry may be moved
+	// by simplification.
+	tolerance float64
+
+	// unit is the length in camera space of a unit of distance in the plane
+	// of a flat map.
+	unit float64
+
+	// precision is the distance in camera space that lines on a flat map may
+	// deviate from the projected curves they approximate.
+	precision float64
+}
+
+// view builds the view from camera c with the globe's style.
+func (g *Globe) view(c Camera) view {
+	v := view{
+		Style:       g.style,
+		camera:      c,
+		perspective: g.style.Scale,
+		zoom:        c.Zoom,
+	}
+	if c.Distance != 0 {
+		v.perspective = 1 / c.Distance
+	}
+	if v.zoom == 0 {
+		v.zoom = 1
+	}
+	v.zoom *= g.style.Scale / v.perspective
+	if p := v.Projection; p != ProjectionGlobe {
+		if p.cut() {
+			v.camera.Lat, v.camera.Heading = 0, 0
+		}
+		// The widest extent of the map spans the globe at the default
+		// distance, where the radius of the globe is Scale/sqrt(1-Scale²)
+		// of the unzoomed camera space.
+		v.unit = 1 / (p.extent() * math.Sqrt(1-g.style.Scale*g.style.Scale))
+	}
+	return v
+}
+
+// render returns the view from camera c and the globe's layers as meshes,
+// ready to be drawn in an image with dimensions (side, side).
+func (g *Globe) render(c Camera, side float64) (view, []*mesh) {
+	v := g.view(c)
+	v.tolerance = v.simplifyTolerance(side)
+	v.precision = v.resamplePrecision(side)
+	meshes := make([]*mesh, len(g.layers))
+	for i, l := range g.layers {
+		meshes[i] = v.mesh(l)
+	}
+	if v.Projection != ProjectionGlobe {
+		// Flat maps are clipped as they are projected, and have no far side.
+		return v, meshes
+	}
+	return v, v.backFace(meshes)
+}
+
+// mesh transforms l to camera space, or to the plane of a flat map. Geometry
+// with a nil color is not drawn, so it is left out.
+func (v view) mesh(l *layer) *mesh {
+	m := &mesh{color: l.color, fill: l.fill}
+	if l.colors != nil {
+		m.colors = []color.Color{}
+	}
+	for i, path := range l.paths {
+		if c := l.pathColor(i); c != nil {
+			for _, piece := range v.meshPath(v.transformPath(path, l.simplify)) {
+				m.addPath(piece, c)
+			}
+		}
+	}
+	if l.color != nil {
+		for _, d := range l.dots {
+			p := v.transform(d.latlng)
+			if v.Projection != ProjectionGlobe {
+				if !v.visible(p) {
+					continue
+				}
+				p = v.planar(frame(p, 0))
+			}
+			m.dots = append(m.dots, pointDot{point: p, radius: d.radius})
+		}
+	}
+	if l.fill != nil {
+		for _, ring := range l.rings {
+			m.fills = append(m.fills, v.meshRing(v.transformPath(ring, l.simplify))...)
+		}
+		// The complement of a region is the view with the region cut out.
+		for _, ring := range l.complements {
+			m.fills = append(m.fills, v.outline())
+			m.fills = append(m.fills, v.meshRing(v.transformPath(ring, l.simplify))...)
+		}
+	}
+	return m
+}
+
+// transformPath maps path to camera space, simplifying it if simplify is set.
+func (v view) transformPath(path []latlng, simplify bool) []point {
+	tpath := make([]point, len(path))
+	for i, p := range path {
+		tpath[i] = v.transform(p)
+	}
+	if simplify {
+		tpath = simplifyPath(tpath, v.tolerance)
+	}
+	return tpath
+}
+
+// transform maps ll to camera space, where the camera looks along the z axis
+// at the center of the view.
+func (v view) transform(ll latlng) point {
+	c := v.camera
+	p := cartestianPoint(ll.lat, ll.lng)
+	p.x, p.y = rotateZ(p.x, p.y, -degToRad(c.Lng)-math.Pi/2)
+	p.y, p.z = rotateX(p.y, p.z, math.Pi/2-degToRad(c.Lat))
+	if c.Heading != 0 {
+		p.x, p.y = rotateZ(p.x, p.y, degToRad(c.Heading))
+	}
+	return p
+}
+
+// horizon returns the depth of the horizon: points on the globe with z less
+// than this are visible. Pinhole places the camera at distance 1/perspective
+// from the center of the globe. Azimuthal maps are clipped to a circle about
+// the center of the view instead.
+func (v view) horizon() float64 {
+	if a := v.Projection.clipAngle(); a != 0 {
+		return -math.Cos(a)
+	}
+	return -v.perspective
+}
+
+// imageOptions builds the pinhole ImageOptions object for this view.
+func (v view) imageOptions() *pinhole.ImageOptions {
+	return &pinhole.ImageOptions{
+		BGColor:   v.Background,
+		LineWidth: v.LineWidth,
+		Scale:     v.perspective,
+	}
+}
+
+// project maps p in camera space to image coordinates for an image with
+// dimensions (side, side), matching the projection used by pinhole.
+func (v view) project(p point, side float64) (float64, float64) {
+	f := side / 2
+	s := v.perspective * f
+	x, y, z := p.x*v.zoom*s, p.y*v.zoom*s, p.z*s
+	zz := z + f
+	if zz == 0 {
+		zz = math.SmallestNonzeroFloat64
+	}
+	return x*(f/zz) + side/2, side/2 - y*(f/zz)
+}
+
+// lineWidthAtZ returns the width of lines at depth z in an image with
+// dimensions (side, side), matching pinhole.
+func (v view) lineWidthAtZ(z, side float64) float64 {
+	return ((1 - z) / 2) * (side / 2) * 0.04 * v.LineWidth
+}
@@ -0,0 +1,57 @@
+package globe
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCameraDistance(t *testing.T) {
+	g := New()
+	g.DrawGraticule(10.0)
+	g.DrawLandBoundaries()
+	g.SetCamera(Camera{Lat: 45, Lng: 10, Heading: 30, Distance: 10})
+	AssertSVGMD5(t, g, "39c4af32caf4f2993dd567bdf906f9bf")
+}
+
+func TestCenterOnAbsolute(t *testing.T) {
+	render := func(g *Globe) string {
+		buf := new(bytes.Buffer)
+		require.NoError(t, g.WriteSVG(buf, 256))
+		return buf.String()
+	}
+
+	once := New()
+	once.DrawLandBoundaries()
+	once.CenterOn(40.645423, -73.903879)
+
+	twice := New()
+	twice.CenterOn(51.453349, -2.588323)
+	twice.DrawLandBoundaries()
+	twice.CenterOn(40.645423, -73.903879)
+
+	assert.Equal(t, render(once), render(twice))
+}
+
+func TestCameraHeading(t *testing.T) {
+	cases := []struct {
+		Heading float64
+		Lat     float64
+		Lng     float64
+	}{
+		{0, 10, 0},
+		{90, 0, 10},
+		{180, -10, 0},
+		{270, 0, -10},
+	}
+	for _, c := range cases {
+		g := New()
+		g.SetCamera(Camera{Heading: c.Heading})
+		v := g.view(g.camera)
+		x, y := v.project(v.transform(latlng{c.Lat, c.Lng}), 100)
+		assert.InDelta(t, 50, x, 1e-6)
+		assert.Less(t, y, 50.0)
+	}
+}
@@ -0,0 +1,181 @@
+package globe
+
+import (
+	"bytes"
+	"fmt"
+	"image/color"
+	"math"
+	"sort"
+	"strconv"
+
+	"github.com/fogleman/gg"
+)
+
+// Legend layout constants, as proportions of the image side unless noted.
+const (
+	// legendMargin is the gap between the legend and the image edges.
+	legendMargin = 0.03
+
+	// legendWidth and legendHeight are the dimensions of the legend color bar.
+	legendWidth  = 0.25
+	legendHeight = 0.02
+
+	// legendLabelGap is the gap (in pixels) between the color bar and its
+	// labels.
+	legendLabelGap = 4
+
+	// legendStops is the number of samples of the color scale used to draw
+	// the legend in SVG output.
+	legendStops = 16
+)
+
+// ColorScale maps values to colors.
+type ColorScale interface {
+	// Color returns the color for value v.
+	Color(v float64) color.Color
+
+	// Domain returns the range of values covered by the scale.
+	Domain() (min, max float64)
+}
+
+// LinearScale is a ColorScale interpolating between Colors, which are evenly
+// spaced over the domain [Min, Max]. Values outside the domain are clamped.
+type LinearScale struct {
+	Min, Max float64
+	Colors   []color.Color
+}
+
+// Color returns the color for value v.
+func (s LinearScale) Color(v float64) color.Color {
+	n := len(s.Colors)
+	if n == 0 {
+		return color.Transparent
+	}
+	t := 0.0
+	if s.Max != s.Min {
+		t = (v - s.Min) / (s.Max - s.Min)
+	}
+	t = math.Max(0, math.Min(1, t)) * float64(n-1)
+	i := int(t)
+	if i >= n-1 {
+		return s.Colors[n-1]
+	}
+	a := color.NRGBAModel.Convert(s.Colors[i]).(color.NRGBA)
+	b := color.NRGBAModel.Convert(s.Colors[i+1]).(color.NRGBA)
+	f := t - float64(i)
+	m := func(x, y uint8) uint8 {
+		return uint8(math.Round(float64(x) + f*(float64(y)-float64(x))))
+	}
+	return color.NRGBA{
+		R: m(a.R, b.R),
+		G: m(a.G, b.G),
+		B: m(a.B, b.B),
+		A: m(a.A, b.A),
+	}
+}
+
+// Domain returns the range of values covered by the scale.
+func (s LinearScale) Domain() (min, max float64) {
+	return s.Min, s.Max
+}
+
+// DrawChoropleth fills countries with colors from scale according to values,
+// which are keyed by ISO 3166 country code or name, as accepted by
+// LookupCountry. Each country is drawn as a separate layer. Countries without a
+// value are not drawn, and keys that do not match a country are ignored. If
+// several keys match the same country, such as "FR" and "FRA", the value of
+// the first key in sorted order is used.
+// Style Options, such as Color or a Resolution, apply to every country; the
+// FillColor is always given by scale.
+func (g *Globe) DrawChoropleth(values map[string]float64, scale ColorScale, style ...Option) {
+	cs := countriesAt(g.geodataScale(style)).features()
+	codes := make([]string, 0, len(values))
+	for code := range values {
+		codes = append(codes, code)
+	}
+	sort.Strings(codes)
+	byCountry := make([]*float64, len(cs))
+	for _, code := range codes {
+		if i := findCountry(cs, code); i >= 0 && byCountry[i] == nil {
+			v := values[code]
+			byCountry[i] = &v
+		}
+	}
+
+	for i, c := range cs {
+		if byCountry[i] == nil {
+			continue
+		}
+		options := append(style[:len(style):len(style)], FillColor(scale.Color(*byCountry[i])))
+		g.drawPreparedPolygons(c.Rings, options...)
+	}
+}
+
+// DrawLegend draws a legend for scale in the corner of rendered images. Only
+// one legend is drawn, so each call replaces the legend from previous calls.
+// Labels use the LineColor of the globe's style.
+func (g *Globe) DrawLegend(scale ColorScale) {
+	g.legend = scale
+}
+
+// legendBox returns the position and dimensions of the legend color bar in an
+// image with dimensions (side, side).
+func legendBox(side float64) (x, y, w, h float64) {
+	w, h = legendWidth*side, legendHeight*side
+	x = side - legendMargin*side - w
+	y = side - legendMargin*side - h
+	return
+}
+
+// legendLabel formats a value for a legend.
+func legendLabel(v float64) string {
+	return strconv.FormatFloat(v, 'g', 4, 64)
+}
+
+// drawLegend draws a legend for scale with ctx in an image with dimensions
+// (side, side).
+func (v view) drawLegend(ctx *gg.Context, scale ColorScale, side float64) {
+	min, max := scale.Domain()
+	x, y, w, h := legendBox(side)
+	n := int(math.Ceil(w))
+	for i := 0; i < n; i++ {
+		t := float64(i) / math.Max(1, float64(n-1))
+		ctx.DrawRectangle(x+float64(i)*w/float64(n), y, w/float64(n)+1, h)
+		ctx.SetColor(scale.Color(min + t*(max-min)))
+		ctx.Fill()
+	}
+
+	ctx.SetColor(v.LineColor)
+	ctx.SetLineWidth(1)
+	ctx.DrawRectangle(x, y, w, h)
+	ctx.Stroke()
+	ctx.DrawStringAnchored(legendLabel(min), x, y-legendLabelGap, 0, 0)
+	ctx.DrawStringAnchored(legendLabel(max), x+w, y-legendLabelGap, 1, 0)
+}
+
+// svgLegend writes SVG elements for a legend for scale to buf, in an image
+// with dimensions (side, side).
+func (v view) svgLegend(buf *bytes.Buffer, scale ColorScale, side float64) {
+	min, max := scale.Domain()
+	x, y, w, h := legendBox(side)
+
+	buf.WriteString("<defs><linearGradient id=\"legend\">")
+	for i := 0; i < legendStops; i++ {
+		t := float64(i) / (legendStops - 1)
+		c := color.NRGBAModel.Convert(scale.Color(min + t*(max-min))).(color.NRGBA)
+		fmt.Fprintf(buf, "<stop offset=\"%s\" stop-color=\"#%02x%02x%02x\"", svgNumber(t), c.R, c.G, c.B)
+		if c.A != 0xff {
+			fmt.Fprintf(buf, " stop-opacity=\"%s\"", svgNumber(float64(c.A)/0xff))
+		}
+		buf.WriteString("/>")
+	}
+	buf.WriteString("</linearGradient></defs>\n")
+
+	fmt.Fprintf(buf, "<rect x=\"%s\" y=\"%s\" width=\"%s\" height=\"%s\" fill=\"url(#legend)\"%s/>\n",
+		svgNumber(x), svgNumber(y), svgNumber(w), svgNumber(h), svgPaint("stroke", v.LineColor))
+	ty := svgNumber(y - legendLabelGap)
+	fmt.Fprintf(buf, "<text x=\"%s\" y=\"%s\" font-family=\"sans-serif\" font-size=\"11\"%s>%s</text>\n",
+		svgNumber(x), ty, svgPaint("fill", v.LineColor), legendLabel(min))
+	fmt.Fprintf(buf, "<text x=\"%s\" y=\"%s\" font-family=\"sans-serif\" font-size=\"11\" text-anchor=\"end\"%s>%s</text>\n",
+		svgNumber(x+w), ty, svgPaint("fill", v.LineColor), legendLabel(max))
+}
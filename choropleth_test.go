@@ -0,0 +1,102 @@
+package globe
+
+import (
+	"bytes"
+	"image/color"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testScale = LinearScale{
+	Min: 0,
+	Max: 100,
+	Colors: []color.Color{
+		color.NRGBA{255, 255, 178, 255},
+		color.NRGBA{240, 59, 32, 255},
+	},
+}
+
+func TestLinearScale(t *testing.T) {
+	s := LinearScale{
+		Min:    10,
+		Max:    20,
+		Colors: []color.Color{color.Black, color.White, color.NRGBA{255, 0, 0, 255}},
+	}
+	assert.Equal(t, color.NRGBA{0, 0, 0, 255}, s.Color(10))
+	assert.Equal(t, color.NRGBA{128, 128, 128, 255}, s.Color(12.5))
+	assert.Equal(t, color.NRGBA{255, 255, 255, 255}, s.Color(15))
+	assert.Equal(t, color.NRGBA{255, 0, 0, 255}, s.Color(20))
+	assert.Equal(t, s.Color(10), s.Color(-5))
+	assert.Equal(t, s.Color(20), s.Color(1000))
+
+	min, max := s.Domain()
+	assert.Equal(t, 10.0, min)
+	assert.Equal(t, 20.0, max)
+}
+
+func TestDrawChoropleth(t *testing.T) {
+	g := New()
+	g.DrawChoropleth(map[string]float64{
+		"FR":  0,
+		"DEU": 50,
+		"724": 100,
+		"XX":  25,
+	}, testScale)
+	require.Len(t, g.layers, 3)
+	// Layers are in data order, regardless of map order.
+	assert.Equal(t, testScale.Color(50), g.layers[0].fill)
+	assert.Equal(t, testScale.Color(100), g.layers[1].fill)
+	assert.Equal(t, testScale.Color(0), g.layers[2].fill)
+}
+
+func TestDrawChoroplethDuplicates(t *testing.T) {
+	// The first of the keys for the same country in sorted order is used.
+	for i := 0; i < 10; i++ {
+		g := New()
+		g.DrawChoropleth(map[string]float64{
+			"France": 25,
+			"FRA":    50,
+			"FR":     100,
+			"250":    0,
+		}, testScale)
+		require.Len(t, g.layers, 1)
+		assert.Equal(t, testScale.Color(0), g.layers[0].fill)
+	}
+}
+
+func TestSVGChoropleth(t *testing.T) {
+	g := New()
+	g.DrawChoropleth(map[string]float64{
+		"FR": 10,
+		"DE": 20,
+		"ES": 40,
+		"IT": 80,
+		"GB": 100,
+	}, testScale)
+	g.DrawCountryBoundaries()
+	g.DrawLegend(testScale)
+	g.CenterOn(45, 5)
+	AssertSVGMD5(t, g, "edb00dd62082647313b4a6eb75e8d924")
+}
+
+func TestLegend(t *testing.T) {
+	g := New()
+	g.DrawLegend(testScale)
+
+	buf := new(bytes.Buffer)
+	require.NoError(t, g.WriteSVG(buf, 400))
+	s := buf.String()
+	assert.Equal(t, 1, strings.Count(s, "<linearGradient "))
+	assert.Contains(t, s, ">0</text>")
+	assert.Contains(t, s, ">100</text>")
+
+	m := g.Image(400)
+	x, y, w, h := legendBox(400)
+	for _, i := range []int{2, 50, 97} {
+		expect := color.RGBAModel.Convert(testScale.Color(100 * float64(i) / (w - 1)))
+		assert.Equal(t, expect, m.At(int(x)+i, int(y+h/2)))
+	}
+}
@@ -0,0 +1,57 @@
+package globe
+
+import (
+	"math"
+
+	"github.com/mmcloughlin/globe/geo"
+)
+
+// DrawCircle draws a circle of the given radius (in km) around (lat, lng),
+// through the points at that distance from the center along the surface of
+// the globe.
+// Uses the default LineColor unless overridden by style Options. Pass a
+// FillColor to fill the circle.
+func (g *Globe) DrawCircle(lat, lng, radius float64, style ...Option) {
+	defer g.styled(Color(g.style.LineColor), style...)()
+	g.drawCircle(latlng{lat, lng}, radius)
+}
+
+// DrawRangeRings draws circles with each of the given radii (in km) around
+// (lat, lng), as in DrawCircle. If filled, the rings are filled together with
+// the even-odd rule, so the regions between consecutive rings alternate.
+// Uses the default LineColor unless overridden by style Options.
+func (g *Globe) DrawRangeRings(lat, lng float64, radii []float64, style ...Option) {
+	defer g.styled(Color(g.style.LineColor), style...)()
+	for _, radius := range radii {
+		g.drawCircle(latlng{lat, lng}, radius)
+	}
+}
+
+// drawCircle records a circle of the given radius around c in the current
+// layer, both as a path and as a filled region.
+func (g *Globe) drawCircle(c latlng, radius float64) {
+	if radius <= 0 {
+		return
+	}
+	ring := circle(c, radius)
+	g.drawPath(ring)
+	// Circles larger than a hemisphere enclose the larger part of the globe.
+	if radius > math.Pi*geo.EarthRadius/2 {
+		g.cur.complements = append(g.cur.complements, ring)
+	} else {
+		g.fillRing(ring)
+	}
+}
+
+// circle returns a closed path through the points at the given distance (in
+// km) from c.
+func circle(c latlng, radius float64) []latlng {
+	n := int(math.Ceil(360 / circleBearingStep))
+	ring := make([]latlng, n+1)
+	for i := 0; i < n; i++ {
+		lat, lng := destination(c.lat, c.lng, radius, 360*float64(i)/float64(n))
+		ring[i] = latlng{lat, lng}
+	}
+	ring[n] = ring[0]
+	return ring
+}
@@ -0,0 +1,107 @@
+package globe
+
+import (
+	"image/color"
+	"math"
+	"testing"
+
+	"github.com/mmcloughlin/globe/geo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCircle(t *testing.T) {
+	cases := []struct {
+		Center latlng
+		Radius float64
+	}{
+		{latlng{51.5, -0.1}, 1000},
+		{latlng{89, 0}, 500},
+		{latlng{-80, 45}, 2000},
+		{latlng{0, 179.5}, 200},
+		{latlng{10, -170}, 15000},
+	}
+	for _, c := range cases {
+		ring := circle(c.Center, c.Radius)
+		require.Len(t, ring, 361)
+		assert.Equal(t, ring[0], ring[360])
+		for _, p := range ring {
+			assert.InDelta(t, c.Radius, haversine(c.Center.lat, c.Center.lng, p.lat, p.lng), 1e-6)
+			assert.True(t, p.lng >= -180 && p.lng < 180)
+		}
+	}
+}
+
+func TestCircleEnclosesPole(t *testing.T) {
+	ring := circle(latlng{89, 0}, 500)
+	points := make([]point, len(ring))
+	for i, p := range ring {
+		points[i] = cartestianPoint(p.lat, p.lng)
+	}
+	assert.True(t, ringContains(points, cartestianPoint(90, 0)))
+	assert.False(t, ringContains(points, cartestianPoint(80, 0)))
+}
+
+func TestDrawCircleFill(t *testing.T) {
+	red := color.NRGBA{255, 0, 0, 255}
+	cases := []struct {
+		Center    latlng
+		Radius    float64
+		CenterOn  latlng
+		Center200 bool // whether the center of the image is filled
+	}{
+		{latlng{0, 0}, 1000, latlng{0, 0}, true},
+		{latlng{0, 0}, 1000, latlng{30, 0}, false},
+		{latlng{90, 0}, 1000, latlng{90, 0}, true},
+		{latlng{0, 0}, 15000, latlng{0, 0}, true},
+		{latlng{0, 0}, 15000, latlng{0, 180}, false},
+		{latlng{0, 0}, 15000, latlng{0, 90}, true},
+	}
+	for _, c := range cases {
+		g := New()
+		g.DrawCircle(c.Center.lat, c.Center.lng, c.Radius, FillColor(red))
+		g.CenterOn(c.CenterOn.lat, c.CenterOn.lng)
+		m := g.Image(200)
+		filled := m.At(100, 100) == color.RGBAModel.Convert(red)
+		assert.Equal(t, c.Center200, filled, "%v", c)
+	}
+
+	// Seen from its antipode, a large circle fills the view outside a disc.
+	g := New()
+	g.DrawCircle(0, 0, 19000, FillColor(red))
+	g.CenterOn(0, 180)
+	m := g.Image(200)
+	assert.NotEqual(t, color.RGBAModel.Convert(red), m.At(100, 100))
+	assert.Equal(t, color.RGBAModel.Convert(red), m.At(100, 40))
+}
+
+func TestDrawCircleUnfilled(t *testing.T) {
+	g := New()
+	g.DrawCircle(0, 0, 1000)
+	g.DrawCircle(0, 0, 0)
+	g.DrawCircle(0, 0, -1)
+	require.Len(t, g.layers, 3)
+	assert.Len(t, g.layers[0].paths, 1)
+	assert.Nil(t, g.layers[0].fill)
+	assert.Empty(t, g.layers[1].paths)
+	assert.Empty(t, g.layers[2].paths)
+}
+
+func TestDrawRangeRings(t *testing.T) {
+	g := New()
+	half := math.Pi * geo.EarthRadius / 2
+	g.DrawRangeRings(40, -100, []float64{1000, 2000, half + 1000}, FillColor(color.Black))
+	require.Len(t, g.layers, 1)
+	l := g.layers[0]
+	assert.Len(t, l.paths, 3)
+	assert.Len(t, l.rings, 2)
+	assert.Len(t, l.complements, 1)
+}
+
+func TestSVGDrawRangeRings(t *testing.T) {
+	g := New()
+	g.DrawRangeRings(51.5, -0.1, []float64{1000, 2000, 3000}, FillColor(color.NRGBA{0, 0, 255, 64}))
+	g.DrawCircle(85, 0, 800, Color(color.NRGBA{255, 0, 0, 255}))
+	g.CenterOn(60, 0)
+	AssertSVGMD5(t, g, "dcfe68a24d7c3c8771b67dc6c2ee471e")
+}
@@ -1,11142 +0,0 @@
-// Generated code. DO NOT EDIT.
-// Arguments: -input countries.world.geojson -output countries.geodata.go -var countries
-
-package globe
-
-var countries = [][]struct {
-	lat, lng float32
-}{
-	{
-		{35.6492456853142, 61.20961209612096},
-		{35.270112558167824, 62.23202232022322},
-		{35.40382468783106, 62.98442984429846},
-		{35.85743039352404, 63.19323193231932},
-		{36.00806810922059, 63.98163981639817},
-		{36.312728657820344, 64.5468454684547},
-		{37.11161631859305, 64.7448474484745},
-		{37.30456799937291, 65.58725587255873},
-		{37.66169786467596, 65.74565745657458},
-		{37.3942736053495, 66.21726217262173},
-		{37.36211499188619, 66.51966519665197},
-		{37.355344757472864, 67.07407074070741},
-		{37.1454674906597, 67.83007830078301},
-		{37.023603271219784, 68.13608136081362},
-		{37.34349684724954, 68.85968859688597},
-		{37.15054516646968, 69.19449194491946},
-		{37.60922854797266, 69.51849518495186},
-		{37.58891784473268, 70.11610116101161},
-		{37.73447788461924, 70.2709027090271},
-		{38.138999390815584, 70.37530375303754},
-		{38.48597390449865, 70.80730807308075},
-		{38.25917105165216, 71.34731347313473},
-		{37.952817944449066, 71.23931239312395},
-		{37.90542630355577, 71.5417154171542},
-		{37.06591723630309, 71.44811448114481},
-		{36.737560867256676, 71.84411844118443},
-		{36.94913069267318, 72.19332193321935},
-		{37.04729909166643, 72.63612636126362},
-		{37.495827121549425, 73.2589325893259},
-		{37.421354543002806, 73.95013950139503},
-		{37.41966198439948, 74.97974979749799},
-		{37.133619580436374, 75.15975159751599},
-		{37.02021815401312, 74.57654576545767},
-		{36.83572926624994, 74.0689406894069},
-		{36.720635281223366, 72.92052920529207},
-		{36.510758014410186, 71.84771847718477},
-		{36.07407789475053, 71.26091260912611},
-		{35.65093824391752, 71.49851498514985},
-		{35.153326014537924, 71.61371613716139},
-		{34.73357148091158, 71.11331113311135},
-		{34.34936067795522, 71.15651156511566},
-		{33.98884569544549, 70.88290882908831},
-		{34.01931175030548, 69.92889928899291},
-		{33.35921389500599, 70.3249032490325},
-		{33.10533010450618, 69.68769687696877},
-		{32.50277924171999, 69.2628926289263},
-		{31.901920937537128, 69.3168931689317},
-		{31.62095620938402, 68.9280892808928},
-		{31.71404693256727, 68.55728557285573},
-		{31.58371992011071, 67.7940779407794},
-		{31.3027551919576, 67.68247682476826},
-		{31.304447750560925, 66.93726937269375},
-		{30.739133177048032, 66.3828638286383},
-		{29.88777619957203, 66.3468634686347},
-		{29.47140678315236, 65.04725047250474},
-		{29.559419830525627, 64.34884348843488},
-		{29.3410797706958, 64.14724147241472},
-		{29.4680216659457, 63.549635496354966},
-		{29.319076508852476, 62.54882548825489},
-		{29.82853664845541, 60.8748087480875},
-		{30.73574805984137, 61.7820178201782},
-		{31.37892032910753, 61.69921699216994},
-		{31.54817618944074, 60.943209432094335},
-		{32.182885665690236, 60.86400864008641},
-		{32.98177332646294, 60.53640536405365},
-		{33.52846975533919, 60.96480964809649},
-		{33.67572235382907, 60.52920529205292},
-		{34.40352255326184, 60.80280802808028},
-		{35.6492456853142, 61.20961209612096},
-	},
-	{
-		{-10.926581961176055, 23.91323913239134},
-		{-11.238012744189149, 24.017640176401784},
-		{-11.7220845047421, 23.902439024390247},
-		{-12.190923237865064, 24.078840788407888},
-		{-12.566671247804777, 23.93123931239313},
-		{-12.910260644281166, 24.017640176401784},
-		{-12.898412734057842, 21.933219332193318},
-		{-16.08042290832202, 21.88641886418864},
-		{-16.89792871373139, 22.56322563225632},
-		{-17.5224828383609, 23.214832148321477},
-		{-17.93038946176391, 21.378813788137876},
-		{-17.78990709768736, 18.955989559895613},
-		{-17.30922045434106, 18.26478264782648},
-		{-17.353226978027692, 14.211142111421111},
-		{-17.42262188076431, 14.05994059940599},
-		{-16.97070873367467, 13.462334623346237},
-		{-16.941935237418022, 12.814328143281443},
-		{-17.111191097751217, 12.21672216722169},
-		{-17.302450219927735, 11.734317343173444},
-		{-16.672818419488223, 11.640716407164092},
-		{-15.794380504358912, 11.777517775177756},
-		{-14.878706299956292, 12.12312123121231},
-		{-14.448796414709975, 12.177121771217713},
-		{-13.54835523773734, 12.50112501125011},
-		{-13.137063497127656, 12.738727387273883},
-		{-12.48373587624151, 13.311133111331117},
-		{-12.03859296356518, 13.635136351363514},
-		{-11.297252295305768, 13.739537395373958},
-		{-10.730245163189537, 13.685536855368554},
-		{-10.373115297886486, 13.386733867338677},
-		{-9.767179317893635, 13.120331203312048},
-		{-9.166321013710771, 12.875528755287547},
-		{-8.959828864104253, 12.929529295292951},
-		{-8.56207759232123, 13.235532355323556},
-		{-7.5973191884219915, 12.933129331293316},
-		{-6.927065981502523, 12.72792727927279},
-		{-6.294049063856349, 12.227522275222753},
-		{-6.099404824473169, 12.321123211232106},
-		{-5.965692694809931, 12.735127351273519},
-		{-5.984310839446593, 13.023130231302332},
-		{-5.864139178610017, 13.375933759337613},
-		{-5.877679647436665, 16.327963279632797},
-		{-6.622405432902752, 16.57276572765727},
-		{-7.221571178482279, 16.860768607686083},
-		{-7.5448498717186965, 17.091170911709128},
-		{-8.067850480148294, 17.472774727747293},
-		{-7.98830022579169, 18.135181351813515},
-		{-7.847817861715129, 18.462784627846275},
-		{-7.98830022579169, 19.017190171901717},
-		{-7.737801552498553, 19.168391683916838},
-		{-7.155561392952336, 19.416794167941674},
-		{-7.116632545075703, 20.036000360003612},
-		{-6.942299008932508, 20.090000900009017},
-		{-6.938913891725846, 20.601206012060118},
-		{-7.299428874235559, 20.514805148051494},
-		{-7.290966081218897, 21.72801728017282},
-		{-7.920597881658409, 21.746017460174613},
-		{-8.306501243218108, 21.947619476194774},
-		{-8.909052106004296, 21.800018000180017},
-		{-9.523450879013822, 21.875618756187578},
-		{-9.894121213143535, 22.21042210422104},
-		{-11.083989911285926, 22.156421564215663},
-		{-10.992591746705997, 22.401224012240135},
-		{-11.017980125755983, 22.836828368283676},
-		{-10.867342410059436, 23.456034560345614},
-		{-10.926581961176055, 23.91323913239134},
-	},
-	{
-		{-5.7896666000633985, 12.180721807218077},
-		{-5.038170580183987, 11.914319143191449},
-		{-4.606568136334332, 12.31752317523177},
-		{-4.437312276001123, 12.61992619926201},
-		{-4.780901672477526, 12.994329943299448},
-		{-4.990778939290692, 12.630726307263075},
-		{-5.248047846997167, 12.46872468724689},
-		{-5.684727966656823, 12.436324363243642},
-		{-5.7896666000633985, 12.180721807218077},
-	},
-	{
-		{41.855858083732684, 20.590405904059054},
-		{41.51565380446294, 20.464404644046454},
-		{41.08574391921661, 20.60480604806048},
-		{40.8420154803368, 21.018810188101895},
-		{40.57966889682034, 21.000810008100075},
-		{40.43580141553713, 20.673206732067314},
-		{40.11083016369737, 20.615606156061574},
-		{39.62506584454108, 20.15120151201512},
-		{39.6944607472777, 19.978399783997844},
-		{39.91449336571087, 19.960399603996052},
-		{40.25131252777393, 19.40599405994061},
-		{40.72692149531022, 19.319593195931958},
-		{41.40902261245303, 19.402394023940246},
-		{41.72045339546612, 19.53919539195394},
-		{41.877861345575994, 19.369993699936998},
-		{42.19606236300241, 19.30519305193053},
-		{42.688596916572024, 19.737197371973735},
-		{42.50072291160218, 19.801998019980203},
-		{42.58873595897545, 20.072000720007196},
-		{42.31961914104565, 20.28440284402845},
-		{42.21806562484572, 20.522005220052222},
-		{41.855858083732684, 20.590405904059054},
-	},
-	{
-		{24.244785816063114, 51.57951579515796},
-		{24.293870015559747, 51.7559175591756},
-		{24.019675521819963, 51.79551795517955},
-		{24.177083471929834, 52.57672576725767},
-		{24.151695092879848, 53.40473404734047},
-		{24.121229038019877, 54.00954009540095},
-		{24.798252479352684, 54.69354693546936},
-		{25.43973219001552, 55.438754387543895},
-		{26.05582352162837, 56.07236072360723},
-		{25.713926683755304, 56.259562595625965},
-		{24.925194374602583, 56.39636396363966},
-		{24.920116698792583, 55.88515885158853},
-		{24.2701741951131, 55.80235802358024},
-		{24.131384389639862, 55.982359823598244},
-		{23.93335503305002, 55.52875528755288},
-		{23.525448409647012, 55.52515525155252},
-		{23.110771551830666, 55.23355233552337},
-		{22.707942604237644, 55.20835208352085},
-		{22.49637277882114, 55.00675006750069},
-		{23.00075524261409, 52.00072000720007},
-		{24.014597846009963, 51.61911619116191},
-		{24.244785816063114, 51.57951579515796},
-	},
-	{
-		{-54.89756191713835, -66.95886958869588},
-		{-54.87048097948504, -67.56367563675636},
-		{-54.8687884208817, -68.63288632886328},
-		{-52.63630362308678, -68.63288632886328},
-		{-53.10006468039975, -68.25128251282513},
-		{-53.84986814167583, -67.75087750877509},
-		{-54.45072644585869, -66.45126451264512},
-		{-54.6995325605485, -65.05085050850508},
-		{-55.20052990713478, -65.50085500855008},
-		{-55.2496141066314, -66.45126451264512},
-		{-54.89756191713835, -66.95886958869588},
-	},
-	{
-		{-22.249799017467204, -62.685626856268556},
-		{-23.881425511079257, -60.84600846008459},
-		{-24.03206322677581, -60.02880028800287},
-		{-24.7717113364319, -58.80838808388083},
-		{-25.16269237380159, -57.77877778777787},
-		{-25.604450169271246, -57.63477634776348},
-		{-27.124367795063392, -58.61758617586176},
-		{-27.395177171596515, -57.60957609576096},
-		{-27.549200004499724, -56.48636486364863},
-		{-27.388406937183184, -55.69435694356943},
-		{-26.621677889873787, -54.78714787147871},
-		{-25.739854857537807, -54.62514625146251},
-		{-25.546903176757958, -54.12834128341282},
-		{-26.124065660494175, -53.627936279362785},
-		{-26.92295332126688, -53.649536495364956},
-		{-27.47472742595312, -54.491944919449196},
-		{-27.882634049356135, -55.161551615516146},
-		{-28.852470129065374, -56.291962919629185},
-		{-30.216672363350973, -57.6239762397624},
-		{-31.017252582727018, -57.8759787597876},
-		{-32.044635654949545, -58.14238142381423},
-		{-33.03986011370877, -58.13158131581315},
-		{-33.26327784934859, -58.35118351183512},
-		{-33.90983523582142, -58.42678426784268},
-		{-34.43114328564768, -58.49518495184951},
-		{-35.28757793893367, -57.22437224372243},
-		{-35.978141849093134, -57.361173611736106},
-		{-36.413129410149466, -56.73836738367383},
-		{-36.90227884651242, -56.78876788767887},
-		{-38.18354570923474, -57.749977499775},
-		{-38.720086786490995, -59.23319233192332},
-		{-38.92827149470083, -61.23841238412383},
-		{-38.82841053710424, -62.33642336423364},
-		{-39.42419116547711, -62.1240212402124},
-		{-40.172302068149854, -62.329223292232925},
-		{-40.6766845319428, -62.145621456214556},
-		{-41.028736721435855, -62.746827468274674},
-		{-41.16752652690908, -63.76923769237692},
-		{-40.801933868589366, -64.73044730447305},
-		{-41.06428045210583, -65.11925119251192},
-		{-42.05781235226171, -64.97884978849788},
-		{-42.359087783654815, -64.3020430204302},
-		{-42.04427188343506, -63.75483754837548},
-		{-42.56388737465799, -63.459634596345964},
-		{-42.87362559906774, -64.37764377643776},
-		{-43.49479460649059, -65.18045180451804},
-		{-44.50186697547314, -65.3280532805328},
-		{-45.03671549412606, -65.56565565655656},
-		{-45.04010061133272, -66.50886508865088},
-		{-45.551253309538986, -67.29367293672937},
-		{-46.301056770815066, -67.58167581675816},
-		{-47.03393464605782, -66.59886598865988},
-		{-47.235349119854334, -65.64125641256412},
-		{-48.13409773822363, -65.98685986859869},
-		{-48.69771975313319, -67.16767167671676},
-		{-49.86897030663894, -67.81567815678156},
-		{-50.265029019818634, -68.73008730087301},
-		{-50.73217519433827, -69.13689136891368},
-		{-51.77140617678412, -68.81648816488165},
-		{-52.35026121912367, -68.15048150481505},
-		{-52.29948446102371, -68.57168571685716},
-		{-52.14207651091383, -69.49689496894969},
-		{-52.0083643812506, -71.9161191611916},
-		{-51.42612422170439, -72.330123301233},
-		{-50.67632076042831, -72.30852308523085},
-		{-50.74063798735493, -72.97452974529745},
-		{-50.37843044624188, -73.32733327333273},
-		{-49.31888876055604, -73.41373413734136},
-		{-48.87882352368972, -72.64692646926468},
-		{-48.24411404744021, -72.330123301233},
-		{-47.73803902504394, -72.44892448924489},
-		{-46.88498948896461, -71.9161191611916},
-		{-45.56140866115898, -71.55251552515524},
-		{-44.97409082580277, -71.66051660516605},
-		{-44.78452426222958, -71.22131221312213},
-		{-44.407083693686545, -71.32931329313293},
-		{-44.20736177849337, -71.79371793717937},
-		{-43.78760724486703, -71.46251462514624},
-		{-43.40847411772066, -71.9161191611916},
-		{-42.25414915024823, -72.15012150121501},
-		{-42.05104211784839, -71.74691746917469},
-		{-40.832399923449344, -71.9161191611916},
-		{-39.80840196843347, -71.68211682116821},
-		{-38.916423584477506, -71.4121141211412},
-		{-38.552523484761124, -70.81450814508145},
-		{-37.577609729241885, -71.11691116911169},
-		{-36.6585504076326, -71.12051120511205},
-		{-36.00522278674645, -70.36450364503645},
-		{-35.169098836700435, -70.38970389703897},
-		{-34.194185081181196, -69.81729817298172},
-		{-33.27343320096858, -69.81369813698137},
-		{-33.09063687180873, -70.07290072900729},
-		{-31.364227096410076, -70.53370533705336},
-		{-30.33684402418755, -69.9180991809918},
-		{-29.368700503081634, -70.01530015300153},
-		{-28.459796533092344, -69.65529655296552},
-		{-27.520426508243084, -69.0000900009},
-		{-26.899257500820234, -68.29448294482944},
-		{-26.50658390484721, -68.59328593285933},
-		{-26.184997770214125, -68.38448384483844},
-		{-24.517827545932093, -68.41688416884169},
-		{-24.02529299236248, -67.32967329673296},
-		{-22.98606200991663, -66.9840698406984},
-		{-22.735563336623493, -67.10647106471065},
-		{-21.831737042444196, -66.27486274862748},
-		{-22.075465481324002, -64.96444964449644},
-		{-22.798188004946773, -64.37764377643776},
-		{-21.994222668364067, -63.98523985239852},
-		{-22.034844074844038, -62.847628476284754},
-		{-22.249799017467204, -62.685626856268556},
-	},
-	{
-		{41.092514153629935, 43.5838358383584},
-		{41.24822954513648, 44.973449734497365},
-		{40.98588296162002, 45.17865178651786},
-		{40.81154942547683, 45.560255602556026},
-		{40.56105075218369, 45.358653586535866},
-		{40.219153914310624, 45.89145891458915},
-		{39.89926033828087, 45.610656106561066},
-		{39.628450961747745, 46.03546035460354},
-		{39.46427277722455, 46.481864818648205},
-		{38.77032374985842, 46.507065070650725},
-		{38.74155025360177, 46.14346143461435},
-		{39.32040529594133, 45.73665736657367},
-		{39.474428128844536, 45.74025740257403},
-		{39.471043011637875, 45.29745297452976},
-		{39.74015982956766, 45.00225002250022},
-		{39.71307889191435, 44.79344793447936},
-		{40.00419897168746, 44.4010440104401},
-		{40.25300508637726, 43.65583655836559},
-		{40.740461964136884, 43.75303753037531},
-		{41.092514153629935, 43.5838358383584},
-	},
-	{
-		{-80.04051996963535, -59.57159571595716},
-		{-80.54998010923829, -59.86679866798667},
-		{-81.00020069772461, -60.158401584015834},
-		{-80.86310345085471, -62.253622536225365},
-		{-80.92234300197133, -64.48924489244892},
-		{-80.58890895711492, -65.7420574205742},
-		{-80.54998010923829, -65.7420574205742},
-		{-80.25547491225852, -66.28926289262893},
-		{-80.29440376013515, -64.03924039240393},
-		{-80.3925721591284, -61.88281882818828},
-		{-79.98128041851874, -61.13761137611375},
-		{-79.62922822902567, -60.60840608406083},
-		{-80.04051996963535, -59.57159571595716},
-	},
-	{
-		{-79.49720865796579, -159.20979209792097},
-		{-79.63430590483567, -161.12861128611286},
-		{-79.28225371534262, -162.4390243902439},
-		{-78.92850896724622, -163.0258302583026},
-		{-78.8692694161296, -163.06543065430654},
-		{-78.59507492238981, -163.71343713437133},
-		{-78.22271202965678, -163.10503105031052},
-		{-78.38011997976665, -161.2438124381244},
-		{-78.69324332138308, -160.24660246602465},
-		{-79.04698806947947, -159.48339483394835},
-		{-79.49720865796579, -159.20979209792097},
-	},
-	{
-		{-78.04668593491024, -45.15345153451534},
-		{-78.47828837875991, -43.92223922239222},
-		{-79.0859169173561, -43.490234902349016},
-		{-79.51582680260243, -43.371433714337144},
-		{-80.02528694220537, -43.33183331833317},
-		{-80.34010284242513, -44.879848798487984},
-		{-80.59398663292492, -46.5070650706507},
-		{-80.82925227878808, -48.38628386283861},
-		{-81.02558907677458, -50.48150481504814},
-		{-80.96634952565796, -52.85032850328503},
-		{-80.63291548080156, -54.164341643416435},
-		{-80.22162374019187, -53.987939879398795},
-		{-79.94742924645209, -51.853118531185316},
-		{-79.61399520159569, -50.99270992709927},
-		{-79.18408531634935, -50.36630366303663},
-		{-78.81172242361632, -49.91269912699127},
-		{-78.45797767551993, -49.30789307893079},
-		{-78.04668593491024, -48.659886598865995},
-		{-78.04668593491024, -48.15228152281523},
-		{-77.83173099228708, -46.66186661866618},
-		{-78.04668593491024, -45.15345153451534},
-	},
-	{
-		{-73.50047352636047, -121.21141211412115},
-		{-73.65788147647035, -119.9189991899919},
-		{-73.48185538172382, -118.72378723787239},
-		{-73.83390757121687, -119.29259292592926},
-		{-74.08948392032, -120.23220232202323},
-		{-74.0099336659634, -121.62181621816218},
-		{-73.65788147647035, -122.62262622626227},
-		{-73.32444743161393, -122.40662406624065},
-		{-73.50047352636047, -121.21141211412115},
-	},
-	{
-		{-73.48185538172382, -125.56025560255603},
-		{-73.87283641909352, -124.03024030240303},
-		{-73.83390757121687, -124.62064620646206},
-		{-73.73573917222362, -125.91305913059131},
-		{-73.46154467848383, -127.28467284672845},
-		{-73.24658973586067, -126.55746557465574},
-		{-73.48185538172382, -125.56025560255603},
-	},
-	{
-		{-71.93316425967502, -98.98118981189812},
-		{-72.07026150654492, -97.88317883178831},
-		{-71.95347496291501, -96.78876788767887},
-		{-72.52048209503124, -96.2019620196202},
-		{-72.44262439927796, -96.98316983169832},
-		{-72.4815532471546, -98.19638196381963},
-		{-72.44262439927796, -99.43119431194312},
-		{-72.50186395039458, -100.78480784807847},
-		{-72.30552715240808, -101.80361803618035},
-		{-71.89423541179839, -102.32922329223292},
-		{-71.71820931705186, -101.70281702817027},
-		{-71.85530656392176, -100.43200432004319},
-		{-71.93316425967502, -98.98118981189812},
-	},
-	{
-		{-70.95655794555246, -68.45288452884529},
-		{-71.40677853403876, -68.3340833408334},
-		{-71.79775957140846, -68.51048510485104},
-		{-72.17012246414151, -68.7840878408784},
-		{-72.3072197110114, -69.95769957699576},
-		{-72.50355650899792, -71.07731077310773},
-		{-72.48493836436126, -72.38772387723877},
-		{-72.09226476838823, -71.89811898118981},
-		{-72.22936201525813, -73.07533075330753},
-		{-72.36645926212802, -74.19134191341914},
-		{-72.07195406514825, -74.95454954549545},
-		{-71.66066232453858, -75.01215012150121},
-		{-71.26968128716888, -73.91413914139142},
-		{-71.15120218493564, -73.23013230132301},
-		{-71.19013103281227, -72.07452074520745},
-		{-70.68067089320934, -71.77931779317792},
-		{-70.31000055907963, -71.72171721717217},
-		{-69.50603522249692, -71.74331743317433},
-		{-69.03550393077063, -71.17451174511744},
-		{-68.87809598066075, -70.25290252902529},
-		{-69.2504588733938, -69.72369723697237},
-		{-69.62282176612683, -69.48969489694896},
-		{-70.07473491321647, -69.05769057690577},
-		{-70.5046447984628, -68.72648726487265},
-		{-70.95655794555246, -68.45288452884529},
-	},
-	{
-		{-84.71367427343503, -180},
-		{-84.7221370664517, -179.94239942399423},
-		{-84.13989690690548, -179.06039060390603},
-		{-84.45302024852191, -177.25677256772568},
-		{-84.41747651785194, -177.14157141571417},
-		{-84.09927550042552, -176.08316083160832},
-		{-84.11112341064884, -175.94635946359463},
-		{-84.11789364506217, -175.83115831158312},
-		{-84.53426306148184, -174.3839438394384},
-		{-84.11789364506217, -173.11673116731168},
-		{-84.06034665254887, -172.889928899289},
-		{-83.88432055780235, -169.95229952299522},
-		{-84.11789364506217, -168.99828998289982},
-		{-84.23806530589874, -168.53028530285303},
-		{-84.56980679215181, -167.0218702187022},
-		{-84.82538314125495, -164.18144181441815},
-		{-85.13850648287136, -161.93141931419314},
-		{-85.37377212873452, -158.07218072180723},
-		{-85.09957763499473, -155.1921519215192},
-		{-85.29591443298125, -150.94050940509405},
-		{-85.60903777459767, -148.5320853208532},
-		{-85.3145325776179, -145.88965889658897},
-		{-85.04033808387811, -143.10683106831067},
-		{-84.56980679215181, -142.89082890828908},
-		{-84.53087794427518, -146.8292682926829},
-		{-84.29561229841202, -150.0621006210062},
-		{-83.90463126104233, -150.90450904509044},
-		{-83.68798375981584, -153.58653586535866},
-		{-83.23776317132952, -153.41013410134101},
-		{-82.82647143071985, -153.03933039330394},
-		{-82.4541085379868, -152.66492664926648},
-		{-82.04281679737713, -152.8629286292863},
-		{-81.76862230363733, -154.5261452614526},
-		{-81.41487755554094, -155.28935289352893},
-		{-81.10175421392452, -156.83736837368374},
-		{-81.16099376504114, -154.40734407344073},
-		{-81.00358581493127, -152.0961209612096},
-		{-81.33701985978767, -150.6489064890649},
-		{-81.04420722141124, -148.8668886688867},
-		{-80.67184432867819, -147.22167221672217},
-		{-80.33841028382179, -146.4188641886419},
-		{-79.9271185432121, -146.77166771667717},
-		{-79.65292404947232, -148.0640806408064},
-		{-79.35841885249255, -149.5328953289533},
-		{-79.29917930137593, -151.58851588515884},
-		{-79.16208205450604, -153.38853388533886},
-		{-79.06391365551278, -155.3289532895329},
-		{-78.69155076277974, -155.9769597695977},
-		{-78.37842742116332, -157.26937269372695},
-		{-78.02637523167026, -158.05058050580504},
-		{-76.88897585023115, -158.36378363783638},
-		{-76.9871442492244, -157.87417874178743},
-		{-77.30026759084083, -156.9741697416974},
-		{-77.20209919184757, -155.3289532895329},
-		{-77.06500194497768, -153.74133741337414},
-		{-77.49660438882734, -152.92052920529204},
-		{-77.39843598983408, -151.3329133291333},
-		{-77.18348104721092, -150.00090000900008},
-		{-76.90928655347113, -148.7480874808748},
-		{-76.57585250861473, -147.6140761407614},
-		{-76.47768410962148, -146.10566105661056},
-		{-76.10532121688843, -146.14526145261453},
-		{-75.73295832415539, -146.49446494464945},
-		{-75.38090613466233, -146.2028620286203},
-		{-75.2048800399158, -144.91044910449105},
-		{-75.53662152616887, -144.32364323643236},
-		{-75.34197728678569, -142.79362793627936},
-		{-75.08640093768255, -141.6380163801638},
-		{-75.06609023444258, -140.2088020880209},
-		{-74.96961439405266, -138.85878858788587},
-		{-74.7343487481895, -137.5051750517505},
-		{-74.517701246963, -136.42876428764288},
-		{-74.30274630433983, -135.21555215552155},
-		{-74.36198585545645, -134.43074430744306},
-		{-74.43984355120973, -133.74673746737466},
-		{-74.30274630433983, -132.25632256322564},
-		{-74.47877239908637, -130.92430924309244},
-		{-74.46015425444972, -129.55269552695526},
-		{-74.32305700757982, -128.24228242282422},
-		{-74.41953284796975, -126.89226892268923},
-		{-74.517701246963, -125.40185401854018},
-		{-74.47877239908637, -124.01224012240122},
-		{-74.49908310232635, -122.56142561425614},
-		{-74.517701246963, -121.07461074610745},
-		{-74.47877239908637, -119.7029970299703},
-		{-74.1842672021066, -118.68418684186841},
-		{-74.02855181060005, -117.4709747097471},
-		{-74.24350675322322, -116.21456214562146},
-		{-74.06748065847668, -115.0229502295023},
-		{-73.71542846898363, -113.94293942939429},
-		{-74.02855181060005, -113.29853298532986},
-		{-74.38060400009311, -112.94572945729458},
-		{-74.71403804494952, -112.29772297722977},
-		{-74.41953284796975, -111.2609126091261},
-		{-74.79189574070278, -110.06570065700657},
-		{-74.91037484293604, -108.71568715687157},
-		{-75.18456933667582, -107.56007560075601},
-		{-75.1253297855592, -106.14886148861488},
-		{-74.94930369081267, -104.87444874448744},
-		{-74.9882325386893, -103.36963369633696},
-		{-75.1253297855592, -102.0160201602016},
-		{-75.30135588030572, -100.64440644406444},
-		{-74.87144599505939, -100.11520115201152},
-		{-74.53801195020299, -100.76320763207632},
-		{-74.1842672021066, -101.25281252812528},
-		{-74.10640950635333, -102.54522545225451},
-		{-73.73404661362028, -103.1140311403114},
-		{-73.36168372088724, -103.330033300333},
-		{-72.61695793542115, -103.68283682836828},
-		{-72.75405518229105, -102.9160291602916},
-		{-72.81329473340767, -101.60561605616056},
-		{-72.75405518229105, -100.31320313203132},
-		{-72.91146313240093, -99.13599135991359},
-		{-73.2059683293807, -98.11718117181171},
-		{-73.55802051887376, -97.68877688776888},
-		{-73.61726006999038, -96.33516335163351},
-		{-73.48016282312048, -95.04275042750427},
-		{-73.28382602513398, -93.67113671136711},
-		{-73.16534692290072, -92.43992439924399},
-		{-73.40061256876388, -91.42111421114211},
-		{-73.32275487301061, -90.08910089100891},
-		{-72.55941094290787, -89.22869228692286},
-		{-73.00963153139418, -88.42228422284222},
-		{-73.18565762614071, -87.26667266672666},
-		{-73.08748922714746, -86.01386013860139},
-		{-73.48016282312048, -85.1930519305193},
-		{-73.51909167099711, -83.8790387903879},
-		{-73.63587821462703, -82.66582665826658},
-		{-73.85252571585353, -81.47061470614706},
-		{-73.48016282312048, -80.68580685806857},
-		{-73.12641807502409, -80.2970029700297},
-		{-73.51909167099711, -79.29619296192962},
-		{-73.42092327200386, -77.92457924579246},
-		{-73.63587821462703, -76.90576905769058},
-		{-73.96931225948343, -76.22176221762217},
-		{-73.87114386049018, -74.88974889748897},
-		{-73.65618891786701, -73.85293852938528},
-		{-73.40061256876388, -72.8341283412834},
-		{-73.26351532189399, -71.62091620916209},
-		{-73.14672877826408, -70.20970209702097},
-		{-73.00963153139418, -68.93528935289352},
-		{-72.79467658877101, -67.9560795607956},
-		{-72.47986068855127, -67.36927369273693},
-		{-72.04995080330494, -67.13527135271352},
-		{-71.63696650409193, -67.25047250472504},
-		{-71.24598546672223, -67.56367563675636},
-		{-70.85331187074921, -67.91647916479164},
-		{-70.46233083337951, -68.22968229682296},
-		{-70.10858608528312, -68.48528485284852},
-		{-69.71760504791342, -68.54288542885429},
-		{-69.3249314519404, -68.44568445684456},
-		{-68.95256855920735, -67.97767977679776},
-		{-68.54127681859768, -67.58527585275853},
-		{-68.15029578122798, -67.42687426874268},
-		{-67.71869333737831, -67.62487624876249},
-		{-67.32601974140529, -67.740077400774},
-		{-66.87579915291897, -67.25047250472504},
-		{-66.58298651454254, -66.70326703267033},
-		{-66.2106236218095, -66.05526055260552},
-		{-65.89580772158975, -65.37125371253713},
-		{-65.6029950832133, -64.56844568445685},
-		{-65.17139263936365, -64.1760417604176},
-		{-64.89719814562386, -63.628836288362876},
-		{-64.64162179652072, -63.00243002430024},
-		{-64.58407480400744, -62.04122041220411},
-		{-64.26925890378769, -61.414814148141474},
-		{-64.0746146644045, -60.70920709207091},
-		{-63.95613556217126, -59.88839888398884},
-		{-63.702251771671456, -59.16119161191611},
-		{-63.38743587145171, -58.59598595985959},
-		{-63.2706493278218, -57.81117811178112},
-		{-63.52622567692493, -57.22437224372243},
-		{-63.857967163178, -57.595175951759515},
-		{-64.15247236015777, -58.613986139861396},
-		{-64.36742730278094, -59.0459904599046},
-		{-64.21171191127439, -59.78759787597876},
-		{-64.30988031026766, -60.612006120061196},
-		{-64.5451459561308, -61.2960129601296},
-		{-64.7990297466306, -62.02322023220232},
-		{-65.09353494361036, -62.51282512825128},
-		{-65.48451598098006, -62.64962649626496},
-		{-65.85687887371311, -62.58842588425884},
-		{-66.19031291856952, -62.120421204212036},
-		{-66.42557856443267, -62.80442804428044},
-		{-66.50343626018594, -63.7440374403744},
-		{-66.83687030504234, -64.29484294842948},
-		{-67.14999364665877, -64.88164881648817},
-		{-67.58159609050843, -65.5080550805508},
-		{-67.95395898324148, -65.66645666456664},
-		{-68.36525072385115, -65.31365313653136},
-		{-68.67837406546757, -64.78444784447844},
-		{-68.91363971133072, -63.960039600396},
-		{-69.22676305294715, -63.196831968319685},
-		{-69.61943664892017, -62.786427864278636},
-		{-69.99179954165321, -62.57042570425703},
-		{-70.38447313762623, -62.27522275222752},
-		{-70.71621462387931, -61.80721807218072},
-		{-71.08857751661235, -61.51201512015119},
-		{-72.00932939682497, -61.37521375213751},
-		{-72.381692289558, -61.08361083610836},
-		{-72.77436588553104, -61.00441004410044},
-		{-73.16534692290072, -60.69120691206912},
-		{-73.69511776574365, -60.8280082800828},
-		{-74.10640950635333, -61.37521375213751},
-		{-74.43984355120973, -61.9620196201962},
-		{-74.57694079807962, -63.2940329403294},
-		{-74.92899298757268, -63.7440374403744},
-		{-75.26242703242909, -64.35244352443524},
-		{-75.63478992516212, -65.86085860858608},
-		{-75.79219787527201, -67.19287192871928},
-		{-76.00715281789518, -68.44568445684456},
-		{-76.22380031912166, -69.79929799297993},
-		{-76.63509205973135, -70.60210602106021},
-		{-76.67402090760798, -72.20772207722077},
-		{-76.63509205973135, -73.9681396813968},
-		{-76.71294975548463, -75.55575555755557},
-		{-76.71294975548463, -77.24057240572405},
-		{-77.10562335145765, -76.92736927369273},
-		{-77.28164944620417, -75.40095400954009},
-		{-77.55584393994397, -74.28134281342813},
-		{-77.90789612943702, -73.65493654936549},
-		{-78.22101947105344, -74.77094770947708},
-		{-78.12285107206019, -76.49536495364953},
-		{-78.37842742116332, -77.92457924579246},
-		{-78.789719161773, -77.98577985779858},
-		{-79.18239275774603, -78.02538025380254},
-		{-79.5141342439991, -76.84816848168481},
-		{-79.88649713673215, -76.6321663216632},
-		{-80.25886002946518, -75.36135361353614},
-		{-80.41626797957505, -73.24453244532445},
-		{-80.69046247331485, -71.44451444514445},
-		{-81.00358581493127, -70.01170011700117},
-		{-81.31840171515103, -68.19008190081901},
-		{-81.47411710665756, -65.7060570605706},
-		{-81.74831160039736, -63.25443254432544},
-		{-82.04281679737713, -61.55161551615515},
-		{-82.37625084223353, -59.69039690396903},
-		{-82.84678213395982, -58.71118711187111},
-		{-83.21914502669287, -58.22158221582215},
-		{-82.86540027859648, -57.00837008370084},
-		{-82.57258764022004, -55.363153631536306},
-		{-82.2577717400003, -53.62073620736207},
-		{-82.00388794950048, -51.54351543515435},
-		{-81.7296934557607, -49.76149761497615},
-		{-81.70938275252071, -47.27387273872739},
-		{-81.84647999939061, -44.82584825848258},
-		{-82.08174564525376, -42.809828098280974},
-		{-81.65014320140409, -42.16182161821618},
-		{-81.35733056302766, -40.77220772207721},
-		{-81.33701985978767, -38.244982449824505},
-		{-81.12206491716451, -36.26496264962648},
-		{-80.90541741593802, -34.38574385743857},
-		{-80.76832016906812, -32.30852308523086},
-		{-80.59229407432159, -30.098100981009793},
-		{-80.33841028382179, -28.550085500855005},
-		{-79.9846655357254, -29.255692556925567},
-		{-79.63261334623233, -29.684096840968408},
-		{-79.2602504534993, -29.684096840968408},
-		{-79.29917930137593, -31.624516245162454},
-		{-79.45658725148581, -33.68013680136801},
-		{-79.45658725148581, -35.638556385563845},
-		{-79.08422435875276, -35.915759157591566},
-		{-78.33949857328669, -35.77535775357754},
-		{-78.12285107206019, -35.32535325353254},
-		{-77.88927798480037, -33.896138961389596},
-		{-77.65401233893722, -32.211322113221115},
-		{-77.35950714195745, -30.998109981099816},
-		{-77.06500194497768, -29.78489784897849},
-		{-76.67402090760798, -28.88128881288813},
-		{-76.49799481286146, -27.513275132751318},
-		{-76.36089756599156, -26.159661596615962},
-		{-76.28134731163496, -25.475654756547556},
-		{-76.24241846375833, -23.927639276392767},
-		{-76.10532121688843, -22.458824588245875},
-		{-75.90898441890192, -21.22401224012239},
-		{-75.67371877303877, -20.010800108001064},
-		{-75.43845312717562, -18.912789127891273},
-		{-75.1253297855592, -17.523175231752305},
-		{-74.79189574070278, -16.641166411664102},
-		{-74.49908310232635, -15.70155701557016},
-		{-74.10640950635333, -15.406354063540618},
-		{-73.87114386049018, -16.46476464764646},
-		{-73.4598521198805, -16.11196111961118},
-		{-73.14672877826408, -15.445954459544595},
-		{-72.95039198027756, -14.409144091440908},
-		{-72.7151263344144, -13.311133111331117},
-		{-72.40200299279799, -12.292322923229221},
-		{-72.00932939682497, -11.5111151111511},
-		{-71.540490663702, -11.021510215102154},
-		{-71.26460361135888, -10.294302943029436},
-		{-71.3238431624755, -9.102691026910264},
-		{-71.6572772073319, -8.61308613086129},
-		{-71.69620605520855, -7.4178741787417835},
-		{-71.3238431624755, -7.378273782737807},
-		{-70.93286212510581, -6.867068670686706},
-		{-71.03103052409907, -5.790657906579071},
-		{-71.4033934168321, -5.535055350553506},
-		{-71.4609404093454, -4.3434434344343344},
-		{-71.28491431459886, -3.047430474304747},
-		{-71.16812777096895, -1.794617946179443},
-		{-71.22567476348225, -0.660606606066068},
-		{-71.63696650409193, -0.2286022860228627},
-		{-71.30522501783885, 0.8694086940869568},
-		{-71.127506364489, 1.8882188821888235},
-		{-70.9904091176191, 3.022230222302227},
-		{-70.85331187074921, 4.138241382413838},
-		{-70.61804622488606, 5.157051570515705},
-		{-70.46233083337951, 6.273062730627316},
-		{-70.245683332153, 7.137071370713727},
-		{-69.89363114265996, 7.74187741877418},
-		{-70.1492074917631, 8.487084870848719},
-		{-70.0121102448932, 9.523895238952406},
-		{-70.48094897801616, 10.251102511025124},
-		{-70.83469372611255, 10.81630816308163},
-		{-70.63835692812604, 11.953919539195397},
-		{-70.245683332153, 12.403924039240394},
-		{-69.97148883841322, 13.42273422734229},
-		{-70.03072838952984, 14.736747367473669},
-		{-70.40309128226289, 15.125551255512562},
-		{-70.03072838952984, 15.949959499594996},
-		{-69.91394184589994, 17.02637026370263},
-		{-69.8750129980233, 18.19998199982001},
-		{-69.89363114265996, 19.258392583925854},
-		{-70.0121102448932, 20.374403744037437},
-		{-70.06965723740649, 21.454414544145436},
-		{-70.40309128226289, 21.922419224192254},
-		{-70.69759647924266, 22.570425704257048},
-		{-70.52157038449613, 23.664836648366503},
-		{-70.48094897801616, 24.84204842048422},
-		{-70.48094897801616, 25.97605976059762},
-		{-70.46233083337951, 27.092070920709205},
-		{-70.32523358650961, 28.092880928809308},
-		{-70.20675448427637, 29.151291512915122},
-		{-69.93255999053659, 30.033300333003325},
-		{-69.75653389579006, 30.972909729097296},
-		{-69.6583654967968, 31.99171991719919},
-		{-69.38417100305702, 32.75492754927549},
-		{-68.83578201557745, 33.302133021330235},
-		{-68.50234797072105, 33.870938709387104},
-		{-68.65975592083092, 34.90774907749079},
-		{-69.01180811032398, 35.30015300153002},
-		{-69.24707375618712, 36.16056160561607},
-		{-69.16921606043385, 37.20097200972012},
-		{-69.52126824992692, 37.90657906579065},
-		{-69.77684459903004, 38.648186481864826},
-		{-69.54157895316689, 39.66699666996672},
-		{-69.10997650931724, 40.019800198001974},
-		{-68.9339504145707, 40.919809198092},
-		{-68.6005163697143, 41.96021960219602},
-		{-68.46341912284441, 42.93942939429394},
-		{-68.2670823248579, 44.11304113041132},
-		{-68.05212738223473, 44.897848978489804},
-		{-67.81686173637158, 45.718657186571875},
-		{-67.60190679374841, 46.50346503465036},
-		{-67.71869333737831, 47.4430744307443},
-		{-67.36664114788526, 48.34308343083433},
-		{-67.09244665414548, 48.99108991089912},
-		{-67.11106479878212, 49.93069930699309},
-		{-66.87579915291897, 50.755107551075525},
-		{-66.52374696342592, 50.94950949509496},
-		{-66.24955246968614, 51.79191791917921},
-		{-66.05321567169962, 52.61272612726128},
-		{-65.89580772158975, 53.61353613536136},
-		{-65.81795002583647, 54.53514535145354},
-		{-65.8771895769531, 55.413554135541375},
-		{-65.97535797594635, 56.35676356763568},
-		{-66.24955246968614, 57.15957159571596},
-		{-66.67946235493247, 57.256772567725676},
-		{-67.01289639978887, 58.13878138781388},
-		{-67.28709089352866, 58.74358743587436},
-		{-67.4055699957619, 59.938799387993896},
-		{-67.67976448950168, 60.60480604806048},
-		{-67.95395898324148, 61.429214292142916},
-		{-68.01319853435808, 62.38682386823868},
-		{-67.81686173637158, 63.189631896318986},
-		{-67.4055699957619, 64.05364053640537},
-		{-67.62052493838506, 64.99324993249934},
-		{-67.73900404061831, 65.97245972459726},
-		{-67.85579058424821, 66.91206912069123},
-		{-67.93364828000148, 67.89127891278915},
-		{-67.93364828000148, 68.88848888488886},
-		{-68.97287926244734, 69.71289712897129},
-		{-69.22676305294715, 69.67329673296734},
-		{-69.67867620003679, 69.55449554495547},
-		{-69.93255999053659, 68.59688596885971},
-		{-70.30492288326963, 67.81207812078122},
-		{-70.69759647924266, 67.94887948879489},
-		{-70.67728577600268, 69.0648906489065},
-		{-71.0699593719757, 68.9280892808928},
-		{-71.44232226470874, 68.42048420484207},
-		{-71.85361400531842, 67.94887948879489},
-		{-72.16673734693484, 68.71208712087122},
-		{-72.2649057459281, 69.86769867698678},
-		{-72.08887965118157, 71.02331023310234},
-		{-71.69620605520855, 71.57411574115741},
-		{-71.3238431624755, 71.90531905319054},
-		{-71.01071982085908, 72.45612456124562},
-		{-70.71621462387931, 73.08253082530825},
-		{-70.36416243438626, 73.33453334533345},
-		{-69.8750129980233, 73.86373863738638},
-		{-69.77684459903004, 74.49014490144901},
-		{-69.73622319255007, 75.62775627756278},
-		{-69.61943664892017, 76.62496624966252},
-		{-69.46202869881029, 77.64377643776436},
-		{-69.07104766144059, 78.13338133381336},
-		{-68.69868476870755, 78.42858428584287},
-		{-68.32632187597451, 79.11259112591125},
-		{-68.07074552687138, 80.0918009180092},
-		{-67.8761012874882, 80.93420934209342},
-		{-67.5426672426318, 81.4850148501485},
-		{-67.36664114788526, 82.05022050220504},
-		{-67.20923319777539, 82.77742777427773},
-		{-67.30740159676864, 83.7746377463775},
-		{-67.20923319777539, 84.67464674646749},
-		{-67.09244665414548, 85.65385653856538},
-		{-67.14999364665877, 86.75186751867517},
-		{-66.87579915291897, 87.47547475474755},
-		{-66.2106236218095, 87.98667986679868},
-		{-66.48481811554929, 88.35748357483578},
-		{-66.95534940727558, 88.82908829088291},
-		{-67.14999364665877, 89.67149671496713},
-		{-67.22954390101538, 90.6291062910629},
-		{-67.11106479878212, 91.59031590315902},
-		{-67.1889224945354, 92.60912609126092},
-		{-67.20923319777539, 93.54873548735486},
-		{-67.11106479878212, 94.17514175141753},
-		{-67.17030434989874, 95.01755017550175},
-		{-67.38525929252191, 95.78075780757808},
-		{-67.24816204565202, 96.68076680766808},
-		{-67.24816204565202, 97.7607776077761},
-		{-67.11106479878212, 98.67878678786786},
-		{-67.24816204565202, 99.71919719197194},
-		{-66.91472800079562, 100.38520385203856},
-		{-66.58298651454254, 100.89280892808927},
-		{-66.30709946219943, 101.58041580415806},
-		{-65.56406623533667, 102.83322833228334},
-		{-65.70116348220657, 103.47763477634777},
-		{-65.97535797594635, 104.2408424084241},
-		{-66.3274101654394, 104.90684906849071},
-		{-66.9350387040356, 106.18126181261812},
-		{-66.95534940727558, 107.16047160471607},
-		{-66.95534940727558, 108.08208082080824},
-		{-66.83687030504234, 109.15849158491585},
-		{-66.69977305817245, 110.23490234902351},
-		{-66.42557856443267, 111.05931059310592},
-		{-66.1310733674529, 111.74331743317435},
-		{-66.09214451957627, 112.85932859328597},
-		{-65.8771895769531, 113.60453604536048},
-		{-66.0735263749396, 114.38934389343893},
-		{-66.38664971655604, 114.8969489694897},
-		{-66.69977305817245, 115.60255602556026},
-		{-66.6608442102958, 116.70056700567005},
-		{-66.91472800079562, 117.38457384573849},
-		{-67.17030434989874, 118.579785797858},
-		{-67.26847274889201, 119.83259832598327},
-		{-67.1889224945354, 120.86940869408693},
-		{-66.87579915291897, 121.65421654216544},
-		{-66.56267581130255, 122.32022320223206},
-		{-66.48481811554929, 123.22023220232205},
-		{-66.62191536241917, 124.12384123841241},
-		{-66.72008376141244, 125.16065160651607},
-		{-66.56267581130255, 126.10026100261001},
-		{-66.56267581130255, 127.00027000270006},
-		{-66.6608442102958, 127.88227882278824},
-		{-66.75901260928907, 128.80388803888042},
-		{-66.58298651454254, 129.7038970389704},
-		{-66.42557856443267, 130.78030780307802},
-		{-66.38664971655604, 131.7991179911799},
-		{-66.38664971655604, 132.9367293672937},
-		{-66.28848131756277, 133.85473854738547},
-		{-66.2106236218095, 134.75834758347582},
-		{-65.71978162684321, 135.0319503195032},
-		{-65.30848988623353, 135.07155071550716},
-		{-65.58268437997333, 135.69795697956982},
-		{-66.03290496845963, 135.87435874358744},
-		{-66.44588926767264, 136.2055620556206},
-		{-66.77763075392572, 136.61956619566195},
-		{-66.95534940727558, 137.46197461974623},
-		{-66.89610985615897, 138.5959859598596},
-		{-66.87579915291897, 139.909999099991},
-		{-66.81655960180237, 140.810008100081},
-		{-66.81655960180237, 142.12042120421205},
-		{-66.7979414571657, 143.06363063630636},
-		{-66.83687030504234, 144.3740437404374},
-		{-66.91472800079562, 145.490054900549},
-		{-67.22954390101538, 146.19566195661957},
-		{-67.60190679374841, 146.00126001260014},
-		{-67.89471943212484, 146.64566645666457},
-		{-68.129985077988, 147.72207722077223},
-		{-68.38556142709113, 148.83808838088385},
-		{-68.56158752183767, 150.13410134101343},
-		{-68.71730291334421, 151.48411484114843},
-		{-68.87471086345408, 152.50292502925032},
-		{-68.89502156669407, 153.6369363693637},
-		{-68.56158752183767, 154.2849428494285},
-		{-68.83578201557745, 155.16695166951672},
-		{-69.14890535719387, 155.93015930159305},
-		{-69.38417100305702, 156.81216812168122},
-		{-69.48233940205027, 158.02538025380255},
-		{-69.59912594568019, 159.1809918099181},
-		{-69.99179954165321, 159.67059670596706},
-		{-70.22706518751636, 160.80820808208085},
-		{-70.57911737700942, 161.57141571415713},
-		{-70.7365253271193, 162.68742687426874},
-		{-70.71621462387931, 163.8430384303843},
-		{-70.77545417499593, 164.91944919449196},
-		{-70.75514347175594, 166.11466114661147},
-		{-70.83469372611255, 167.30987309873098},
-		{-70.97179097298245, 168.4258842588426},
-		{-71.2070566188456, 169.4626946269463},
-		{-71.4033934168321, 170.50310503105032},
-		{-71.69620605520855, 171.20511205112052},
-		{-72.08887965118157, 171.089910899109},
-		{-72.44093184067464, 170.5607056070561},
-		{-72.89115242916094, 170.1107011070111},
-		{-73.24489717725733, 169.75789757897581},
-		{-73.65618891786701, 169.28629286292863},
-		{-73.81359686797688, 167.9758797587976},
-		{-74.16564905746995, 167.38907389073893},
-		{-74.38060400009311, 166.09306093060934},
-		{-74.77327759606614, 165.64305643056434},
-		{-75.14564048879919, 164.9590495904959},
-		{-75.4587638304156, 164.23544235442358},
-		{-75.87005557102528, 163.82143821438217},
-		{-76.24241846375833, 163.56943569435697},
-		{-76.69263905224464, 163.4686346863469},
-		{-77.06500194497768, 163.49023490234902},
-		{-77.4576755409507, 164.0590405904059},
-		{-77.83003843368375, 164.27504275042753},
-		{-78.1820906231768, 164.7430474304743},
-		{-78.3191878700467, 166.6042660426604},
-		{-78.75079031389636, 166.9966699666997},
-		{-78.90819826400624, 165.19305193051935},
-		{-79.12315320662941, 163.66663666636668},
-		{-79.16208205450604, 161.76581765817662},
-		{-79.7307817452256, 160.92340923409233},
-		{-80.20131303695189, 160.74700747007472},
-		{-80.57367592968494, 160.31860318603185},
-		{-80.94603882241798, 159.78939789397896},
-		{-81.27778030867105, 161.12141121411213},
-		{-81.69076460788406, 161.6290162901629},
-		{-82.06143494201378, 162.48942489424894},
-		{-82.39486898687018, 163.70623706237063},
-		{-82.70968488708994, 165.09585095850957},
-		{-83.02280822870635, 166.6042660426604},
-		{-83.33593157032277, 168.8938889388894},
-		{-83.82508100668572, 169.40509405094053},
-		{-84.04172850791223, 172.28512285122855},
-		{-84.11789364506217, 172.47592475924762},
-		{-84.41409140064526, 173.2247322473225},
-		{-84.15851505154214, 175.9859598595986},
-		{-84.4733309517619, 178.27558275582754},
-		{-84.71367427343503, -180},
-	},
-	{
-		{-48.62493973318991, 68.93528935289353},
-		{-48.93975563340967, 69.57969579695799},
-		{-49.065004970056236, 70.52650526505266},
-		{-49.254571533629424, 70.55890558905591},
-		{-49.709869797925734, 70.28170281702819},
-		{-49.77418702485235, 68.74448744487447},
-		{-49.2427236234061, 68.71928719287195},
-		{-48.82973932419309, 68.8668886688867},
-		{-48.62493973318991, 68.93528935289353},
-	},
-	{
-		{-40.79177851696937, 145.39645396453966},
-		{-41.1370604720491, 146.36486364863651},
-		{-40.99996322517921, 146.90846908469086},
-		{-40.80870410300269, 147.68967689676896},
-		{-40.87471388853264, 148.2872828728287},
-		{-42.06289002807171, 148.35928359283594},
-		{-42.40647942454811, 148.01728017280175},
-		{-43.21213731973415, 147.9128791287913},
-		{-42.93794282599436, 147.5636756367564},
-		{-43.63527697056715, 146.86886868868692},
-		{-43.58111509526053, 146.6636666366664},
-		{-43.54895648179722, 146.04806048060482},
-		{-42.69421438711455, 145.43245432454324},
-		{-42.034116531815066, 145.29565295652958},
-		{-41.162448851099086, 144.71964719647195},
-		{-40.70376546959611, 144.7448474484745},
-		{-40.79177851696937, 145.39645396453966},
-	},
-	{
-		{-13.763310180360506, 143.56043560435603},
-		{-14.54865737230655, 143.92043920439204},
-		{-14.171216803763514, 144.56484564845647},
-		{-14.594356454596522, 144.89604896048962},
-		{-14.98533749196622, 145.37485374853748},
-		{-15.4287878460392, 145.27045270452703},
-		{-16.2852224993252, 145.48645486454865},
-		{-16.784527287308137, 145.63765637656377},
-		{-16.90639150674805, 145.88965889658897},
-		{-17.761133601430714, 146.159661596616},
-		{-18.280749092653636, 146.06246062460627},
-		{-18.957772533986443, 146.38646386463864},
-		{-19.48077314241604, 147.47007470074703},
-		{-19.95638210995233, 148.17928179281796},
-		{-20.39136967100866, 148.84888848888488},
-		{-20.633405551285136, 148.71568715687158},
-		{-21.26134479312131, 149.28809288092884},
-		{-22.342889740650463, 149.6768967689677},
-		{-22.122857122217304, 150.07650076500767},
-		{-22.556152124670298, 150.48330483304835},
-		{-22.402129291767082, 150.72810728107282},
-		{-23.461670977452926, 150.90090900909013},
-		{-24.076069750462445, 151.610116101161},
-		{-24.458587994815474, 152.07452074520745},
-		{-25.267631007208173, 152.8557285572856},
-		{-26.07159634379088, 153.13653136531366},
-		{-26.641988593113766, 153.1617316173162},
-		{-27.259772483329954, 153.09333093330935},
-		{-28.109436902202624, 153.5685356853569},
-		{-28.994645051745266, 153.51093510935112},
-		{-29.45840610905823, 153.33813338133382},
-		{-30.350384493014204, 153.0681306813068},
-		{-30.92416185954375, 153.08973089730898},
-		{-31.64011414875319, 152.89172891728919},
-		{-32.55071067734582, 152.44892448924492},
-		{-33.0415526723121, 151.71091710917108},
-		{-33.81674451263816, 151.3437134371344},
-		{-34.31097162481111, 151.00891008910088},
-		{-35.17417651251043, 150.71370713707137},
-		{-35.67178874189004, 150.32850328503287},
-		{-36.41989964456279, 150.07650076500767},
-		{-37.10877099611892, 149.94689946899473},
-		{-37.42527945494201, 149.99729997299977},
-		{-37.772253968625066, 149.4248942489425},
-		{-37.80949025789837, 148.30528305283053},
-		{-38.21908943990472, 147.38007380073805},
-		{-38.60668536006775, 146.92286922869232},
-		{-39.036595245314075, 146.31806318063184},
-		{-38.59314489124109, 145.490054900549},
-		{-38.41711879649456, 144.8780487804878},
-		{-37.8958107466683, 145.0328503285033},
-		{-38.08537731024149, 144.48564485644857},
-		{-38.80979239246759, 143.61083610836107},
-		{-38.53898301593447, 142.74682746827472},
-		{-38.37988250722126, 142.17802178021782},
-		{-38.30879504588131, 141.60561605616056},
-		{-38.01936752471154, 140.63720637206376},
-		{-37.40327619309869, 139.99279992799927},
-		{-36.64331738020262, 139.80559805598057},
-		{-36.138934916409674, 139.57519575195755},
-		{-35.73272085161, 139.08199081990819},
-		{-35.61254919077342, 138.12078120781212},
-		{-35.12678487161713, 138.44838448384485},
-		{-34.385444203357714, 138.20718207182074},
-		{-35.076008113517176, 137.7175771757718},
-		{-35.26049700128036, 136.82836828368283},
-		{-34.707030337990794, 137.35397353973542},
-		{-34.129867854254584, 137.50517505175054},
-		{-33.640718417891634, 137.89037890378904},
-		{-32.89937774963221, 137.81117811178115},
-		{-33.75242728571154, 136.99756997569978},
-		{-34.094324123584606, 136.3711637116371},
-		{-34.88982666715065, 135.98955989559897},
-		{-34.47853492654097, 135.20835208352082},
-		{-33.94876408369806, 135.2407524075241},
-		{-33.222656442868626, 134.61434614346143},
-		{-32.84860099153225, 134.08514085140854},
-		{-32.61672046287576, 134.27234272342724},
-		{-32.010784482882904, 132.9907299072991},
-		{-31.982010986626264, 132.28872288722886},
-		{-31.496246667469975, 131.3275132751328},
-		{-31.591029949256566, 129.53469534695347},
-		{-31.948159814559624, 128.24228242282425},
-		{-32.28159385941603, 127.10107101071014},
-		{-32.21558407388608, 126.14706147061474},
-		{-32.728429330695676, 125.0886508865089},
-		{-32.960309859352165, 124.22104221042213},
-		{-33.483310467781756, 124.03024030240306},
-		{-33.88952453258143, 123.65943659436596},
-		{-33.91491291163142, 122.809828098281},
-		{-34.00292595900468, 122.18342183421834},
-		{-33.82182218844815, 121.2978129781298},
-		{-33.930145939061404, 120.58140581405814},
-		{-33.97584502135137, 119.8937989379894},
-		{-34.50900098140095, 119.29979299793001},
-		{-34.46499445771432, 119.0081900819008},
-		{-34.747651744470765, 118.5041850418504},
-		{-35.06416020329385, 118.02538025380255},
-		{-35.02523135541721, 117.29457294572944},
-		{-35.02523135541721, 116.62496624966252},
-		{-34.387136761961045, 115.56295562955631},
-		{-34.19587763978453, 115.02655026550269},
-		{-33.62379283185831, 115.04815048150482},
-		{-33.48669558498842, 115.5449554495545},
-		{-33.25989273214193, 115.71415714157143},
-		{-32.90107030823554, 115.67815678156785},
-		{-32.205428722266085, 115.80055800558006},
-		{-31.613033211099882, 115.68895688956889},
-		{-30.60088316630734, 115.15975159751599},
-		{-30.030490916984455, 114.99774997749978},
-		{-29.4617912262649, 115.04095040950409},
-		{-28.81015616398207, 114.64134641346413},
-		{-28.5156509670023, 114.61614616146164},
-		{-28.11789969521928, 114.17334173341732},
-		{-27.33424506187656, 114.04734047340474},
-		{-26.543820194120514, 113.47853478534785},
-		{-26.117295426080844, 113.33813338133382},
-		{-26.548897869930506, 113.77733777337772},
-		{-25.62137575530457, 113.44253442534426},
-		{-25.91080327647434, 113.93573935739357},
-		{-26.29839919663737, 114.23454234542345},
-		{-25.78555393982777, 114.21654216542169},
-		{-24.99851418927839, 113.71973719737201},
-		{-24.683698289058633, 113.6261362613626},
-		{-24.38411541626887, 113.39213392133922},
-		{-23.806952932532653, 113.5037350373504},
-		{-23.559839376446178, 113.70533705337056},
-		{-23.060534588463234, 113.84213842138422},
-		{-22.474909311710363, 113.73773737737378},
-		{-21.755571905294254, 114.14814148141483},
-		{-22.517223276793658, 114.22374223742236},
-		{-21.830044483840865, 114.64854648546486},
-		{-21.494917880381124, 115.45855458554587},
-		{-21.068393112341454, 115.94815948159481},
-		{-20.701107895418417, 116.71136711367114},
-		{-20.623250199665137, 117.1649716497165},
-		{-20.746806977708374, 117.44217442174426},
-		{-20.374444084975337, 118.23058230582308},
-		{-20.262735217155424, 118.83538835388356},
-		{-20.044395157325596, 118.98658986589868},
-		{-19.952996992745668, 119.25299252992534},
-		{-19.976692813192315, 119.80379803798041},
-		{-19.68388017481587, 120.85500855008553},
-		{-19.24042982074289, 121.39861398613988},
-		{-18.705581302089968, 121.65421654216544},
-		{-18.19781372109037, 122.2410224102241},
-		{-17.79836989070401, 122.28782287822878},
-		{-17.25505857903444, 122.31302313023133},
-		{-16.405394160161777, 123.01143011430116},
-		{-17.268599047861102, 123.43263432634325},
-		{-17.06887713266792, 123.86103861038612},
-		{-16.596653282338295, 123.50463504635047},
-		{-16.110888963182006, 123.8178381783818},
-		{-16.327536464408496, 124.25704257042571},
-		{-15.567577651512423, 124.37944379443798},
-		{-15.07504309794281, 124.92664926649269},
-		{-14.68067694336645, 125.1678516785168},
-		{-14.509728524429917, 125.6718567185672},
-		{-14.230456354880133, 125.68625686256865},
-		{-14.347242898510046, 126.12546125461256},
-		{-14.09674422521691, 126.14346143461438},
-		{-13.952876743933686, 126.58266582665829},
-		{-13.817472055667125, 127.0650706507065},
-		{-14.276155437170104, 127.80307803078034},
-		{-14.868550948336306, 128.36108361083615},
-		{-14.87532118274963, 128.9838898388984},
-		{-14.970104464536234, 129.6210962109621},
-		{-14.420022918453327, 129.4086940869409},
-		{-13.619442699077283, 129.88749887498875},
-		{-13.357096115560822, 130.3411034110341},
-		{-13.108290000871023, 130.18270182701826},
-		{-12.536205192944792, 130.61830618306186},
-		{-12.18415300345174, 131.22311223112234},
-		{-12.302632105684978, 131.73431734317342},
-		{-12.114758100715122, 132.5767257672577},
-		{-11.603605402508862, 132.55872558725588},
-		{-11.27355647485912, 131.82431824318246},
-		{-11.127996434972559, 132.35712357123572},
-		{-11.376802549662372, 133.01953019530197},
-		{-11.786401731668718, 133.55233552335523},
-		{-12.041978080771855, 134.3947439474395},
-		{-11.940424564571927, 134.67914679146793},
-		{-12.24847023037836, 135.29835298352987},
-		{-11.96242782641525, 135.88155881558816},
-		{-12.048748315185179, 136.259562595626},
-		{-11.85748919300866, 136.49356493564937},
-		{-12.351716305181611, 136.9507695076951},
-		{-12.886564823834519, 136.68436684366844},
-		{-13.291086330030879, 136.30636306363067},
-		{-13.324937502097512, 135.96075960759606},
-		{-13.724381332483873, 136.0759607596076},
-		{-14.223686120466809, 135.78435784357845},
-		{-14.716220674036421, 135.4279542795428},
-		{-14.997185402189544, 135.49995499955003},
-		{-15.550652065479113, 136.29556295562958},
-		{-15.870545641508855, 137.06597065970664},
-		{-16.215827596588582, 137.58077580775807},
-		{-16.808223107754785, 138.30438304383046},
-		{-16.80653054915146, 138.5851858518585},
-		{-17.062106898254598, 139.10719107191073},
-		{-17.371845122664354, 139.26199261992622},
-		{-17.710356843330757, 140.21600216002162},
-		{-17.368460005457692, 140.8748087480875},
-		{-16.831918928201432, 141.0728107281073},
-		{-16.388468574128453, 141.27441274412746},
-		{-15.840079586648883, 141.39681396813967},
-		{-15.044577043082839, 141.70281702817027},
-		{-14.560505282529874, 141.56241562415624},
-		{-14.271077761360104, 141.63441634416347},
-		{-13.697300394830549, 141.51921519215193},
-		{-12.944111816347814, 141.65241652416523},
-		{-12.741004783947972, 141.84321843218436},
-		{-12.407570739091568, 141.68841688416887},
-		{-11.877799896248646, 141.92961929619298},
-		{-11.327718350165739, 142.1168211682117},
-		{-11.043368504805969, 142.14202142021423},
-		{-10.667620494866256, 142.51642516425164},
-		{-11.156769931229206, 142.79722797227976},
-		{-11.78470917306538, 142.86562865628656},
-		{-11.904880833901956, 143.11763117631176},
-		{-12.326327926131626, 143.1572315723157},
-		{-12.834095507131238, 143.52083520835208},
-		{-13.401102639247455, 143.59643596435967},
-		{-13.763310180360506, 143.56043560435603},
-	},
-	{
-		{48.12340259187111, 16.979569795697955},
-		{47.71549596846809, 16.903969039690395},
-		{47.71211085126144, 16.342363423634254},
-		{47.49546335003494, 16.533165331653322},
-		{46.85229108076878, 16.201962019620197},
-		{46.68303522043557, 16.01116011160113},
-		{46.65933939998892, 15.136351363513654},
-		{46.43253654714242, 14.632346323463253},
-		{46.508701684292376, 13.80793807938079},
-		{46.76766315060216, 12.37512375123751},
-		{47.11463766428524, 12.151921519215193},
-		{46.941996686745355, 11.165511655116546},
-		{46.75073756456884, 11.050310503105038},
-		{46.89291248724875, 10.441904419044192},
-		{46.919993424902046, 9.930699306993063},
-		{47.1027897540619, 9.480694806948065},
-		{47.34821075154504, 9.631896318963186},
-		{47.52423684629157, 9.595895958959602},
-		{47.58009128020153, 9.894698946989479},
-		{47.30251166925508, 10.402304023040244},
-		{47.56655081137488, 10.542705427054273},
-		{47.52423684629157, 11.424714247142475},
-		{47.70364805824478, 12.14112141121413},
-		{47.67318200338481, 12.61992619926201},
-		{47.468382412381615, 12.933129331293316},
-		{47.637638272714824, 13.026730267302668},
-		{48.289273334997645, 12.882728827288275},
-		{48.416215230247545, 13.242732427324285},
-		{48.87659117035385, 13.595535955359566},
-		{48.55500503572078, 14.337143371433712},
-		{48.964604217727114, 14.902349023490245},
-		{49.03907679627373, 15.255152551525526},
-		{48.73441624767396, 16.02916029160292},
-		{48.785193005773934, 16.500765007650074},
-		{48.59731900080406, 16.961569615696163},
-		{48.470377105554164, 16.878768787687875},
-		{48.12340259187111, 16.979569795697955},
-	},
-	{
-		{38.74155025360177, 46.14346143461435},
-		{38.87356982466167, 45.459454594545946},
-		{39.33563832337131, 44.95184951849518},
-		{39.71307889191435, 44.79344793447936},
-		{39.74015982956766, 45.00225002250022},
-		{39.471043011637875, 45.29745297452976},
-		{39.474428128844536, 45.74025740257403},
-		{39.32040529594133, 45.73665736657367},
-		{38.74155025360177, 46.14346143461435},
-	},
-	{
-		{41.808466442839375, 48.584285842858435},
-		{41.28208071720313, 49.10989109891099},
-		{40.57289866240701, 49.61749617496176},
-		{40.52550702151372, 50.08550085500855},
-		{40.25639020358393, 50.39150391503915},
-		{40.17683994922733, 49.57069570695708},
-		{39.39995555029793, 49.39429394293944},
-		{39.049595919408205, 49.221492214922165},
-		{38.81602283214839, 48.85788857888579},
-		{38.3201031613721, 48.88308883088831},
-		{38.271018961875484, 48.634686346863475},
-		{38.79401957030507, 48.0118801188012},
-		{39.28824668247802, 48.35388353883539},
-		{39.58275187945779, 48.05868058680588},
-		{39.508279300911184, 47.68427684276844},
-		{38.77032374985842, 46.507065070650725},
-		{39.46427277722455, 46.481864818648205},
-		{39.628450961747745, 46.03546035460354},
-		{39.89926033828087, 45.610656106561066},
-		{40.219153914310624, 45.89145891458915},
-		{40.56105075218369, 45.358653586535866},
-		{40.81154942547683, 45.560255602556026},
-		{40.98588296162002, 45.17865178651786},
-		{41.24822954513648, 44.973449734497365},
-		{41.41071517105635, 45.21825218252184},
-		{41.124672767093244, 45.96345963459635},
-		{41.0637406573733, 46.49986499865},
-		{41.18221975960654, 46.63666636666366},
-		{41.72214595406945, 46.147061470614716},
-		{41.86093575954267, 46.406264062640645},
-		{41.82708458747604, 46.6870668706687},
-		{41.21945604887985, 47.37467374673747},
-		{41.15175370474657, 47.813878138781405},
-		{41.40563749524637, 47.98667986679868},
-		{41.808466442839375, 48.584285842858435},
-	},
-	{
-		{-4.499936944324418, 29.338493384933855},
-		{-3.293142660148689, 29.277292772927723},
-		{-2.83953695445571, 29.02529025290255},
-		{-2.9173946502089905, 29.633696336963368},
-		{-2.3486949594894355, 29.939699396993973},
-		{-2.41301218641604, 30.468904689046894},
-		{-2.8073783409924005, 30.526505265052663},
-		{-3.03418119383889, 30.74250742507425},
-		{-3.3591524456786317, 30.753307533075343},
-		{-3.569029712491812, 30.504905049050507},
-		{-4.090337762318072, 30.116101161011613},
-		{-4.452545303431123, 29.75249752497527},
-		{-4.499936944324418, 29.338493384933855},
-	},
-	{
-		{51.34603417261526, 3.313833138331404},
-		{51.26648391825866, 4.048240482404822},
-		{51.4746686264685, 4.973449734497365},
-		{51.037988506808844, 5.607056070560702},
-		{50.80441541954902, 6.15786157861578},
-		{50.12739197821621, 6.042660426604272},
-		{50.090155688942914, 5.783457834578343},
-		{49.52991879124001, 5.675456754567563},
-		{49.985217055536324, 4.80064800648006},
-		{49.90735935978306, 4.285842858428595},
-		{50.37958321011267, 3.5874358743587607},
-		{50.78071959910237, 3.123031230312307},
-		{50.79764518513569, 2.658626586265882},
-		{51.14800481602542, 2.5146251462514613},
-		{51.34603417261526, 3.313833138331404},
-	},
-	{
-		{6.259658097057169, 2.691026910269102},
-		{6.14287155342727, 1.8666186661866675},
-		{6.8317429049834, 1.6182161821618308},
-		{9.12854492970493, 1.665016650166507},
-		{9.335037079311434, 1.4634146341463463},
-		{9.825879074277722, 1.4238142381423984},
-		{10.17623870516745, 1.078210782107817},
-		{10.47074390214722, 0.7722077220772405},
-		{10.997129627783465, 0.8982089820898409},
-		{11.110531054206717, 1.2438124381243938},
-		{11.547211173866373, 1.4454144541445544},
-		{11.641994455652963, 1.9350193501934996},
-		{11.939884769839395, 2.1546215462154805},
-		{12.232697408215842, 2.489424894248941},
-		{12.236082525422503, 2.8494284942849504},
-		{11.660612600289625, 3.6126361263612807},
-		{11.327178555433207, 3.5730357303573044},
-		{10.734783044267004, 3.796237962379621},
-		{10.331954096673996, 3.6018360183601885},
-		{10.062837278744198, 3.706237062370633},
-		{9.443360829924686, 3.2202322023220233},
-		{9.137007722721592, 2.9106291062910827},
-		{8.50737592228208, 2.72342723427235},
-		{7.870973887429244, 2.74862748627487},
-		{6.259658097057169, 2.691026910269102},
-	},
-	{
-		{9.643082745117866, -2.827828278282766},
-		{9.900351652824327, -3.511835118351172},
-		{9.863115363551017, -3.9798397983979896},
-		{9.610924131654556, -4.329043290432907},
-		{9.82249395707106, -4.779047790477904},
-		{10.152542884720802, -4.955449554495544},
-		{10.37088294455063, -5.405454054540542},
-		{10.951430545493508, -5.47025470254701},
-		{11.374570196326502, -5.196651966519653},
-		{11.713081916992905, -5.221852218522173},
-		{12.542435632625597, -4.426244262442623},
-		{13.227921866975066, -4.278642786427866},
-		{13.471650305854865, -4.00504005040051},
-		{13.337938176191642, -3.5226352263522642},
-		{13.541045208591484, -3.1050310503104868},
-		{13.798314116297945, -2.9682296822968226},
-		{14.246842146180924, -2.1906219062190644},
-		{14.558272929194018, -1.9998199981999676},
-		{14.974642345613688, -1.0674106741067249},
-		{15.116817268293588, -0.5166051660516473},
-		{14.923865587513731, -0.26460264602644656},
-		{14.928943263323731, 0.37620376203761907},
-		{14.44487150277078, 0.2970029700296948},
-		{13.989573238474463, 0.4302043020430233},
-		{13.336245617588304, 0.991809918099193},
-		{12.852173857035353, 1.0242102421024413},
-		{12.625371004188864, 2.1762217622176365},
-		{11.939884769839395, 2.1546215462154805},
-		{11.641994455652963, 1.9350193501934996},
-		{11.547211173866373, 1.4454144541445544},
-		{11.110531054206717, 1.2438124381243938},
-		{10.997129627783465, 0.8982089820898409},
-		{11.019132889626789, 0.02340023400233804},
-		{11.098683143983394, -0.437404374043723},
-		{10.936197518063523, -0.7614076140761483},
-		{11.00897753800679, -1.2042120421204174},
-		{10.963278455716832, -2.9394293942939385},
-		{10.394578764997277, -2.9646296462964585},
-		{9.643082745117866, -2.827828278282766},
-	},
-	{
-		{22.041074514524837, 92.67392673926742},
-		{21.32342966671206, 92.65232652326523},
-		{21.475759941011944, 92.30312303123031},
-		{20.670102045825914, 92.36792367923681},
-		{21.191410095652174, 92.08352083520839},
-		{21.700870235255096, 92.02592025920262},
-		{22.183249437204722, 91.8351183511835},
-		{22.76548959675094, 91.41751417514178},
-		{22.804418444627572, 90.4959049590496},
-		{22.393126704017888, 90.58590585905858},
-		{21.836274923521657, 90.27270272702725},
-		{22.0393819559215, 89.8478984789848},
-		{21.856585626761643, 89.70029700297005},
-		{21.966601935978233, 89.41949419494193},
-		{22.056307541954823, 89.03069030690307},
-		{22.878891023174177, 88.87588875888758},
-		{23.630387043053588, 88.53028530285303},
-		{24.23293790583979, 88.69948699486997},
-		{24.502054723769575, 88.0838808388084},
-		{24.865954823485964, 88.30708307083074},
-		{25.238317716219, 88.92988929889299},
-		{25.768088559061923, 88.20988209882097},
-		{26.446804558998053, 88.56268562685625},
-		{26.015202115148398, 89.3546935469355},
-		{25.96442535704844, 89.83349833498335},
-		{25.27047632968231, 89.91989919899203},
-		{25.133379082812425, 90.87390873908743},
-		{25.146919551639073, 91.79911799117991},
-		{24.97597113270254, 92.37512375123754},
-		{24.12969183103654, 91.91431914319145},
-		{24.072144838523243, 91.46791467914682},
-		{23.50344514780369, 91.15831158311585},
-		{22.98552221518409, 91.70551705517056},
-		{23.623616808640264, 91.87111871118714},
-		{23.627001925846926, 92.14472144721447},
-		{22.041074514524837, 92.67392673926742},
-	},
-	{
-		{44.235595480017494, 22.6568265682657},
-		{43.824303739407796, 22.944829448294485},
-		{43.89708375935108, 23.333633336333378},
-		{43.741368367844544, 24.100441004410044},
-		{43.688899051141235, 25.569255692556936},
-		{43.94278284164103, 26.06606066060661},
-		{44.17635592890086, 27.243272432724325},
-		{43.812455829184486, 27.970479704797043},
-		{43.707517195777896, 28.557285572855733},
-		{43.29284033796155, 28.038880388803904},
-		{42.57858060735545, 27.67527675276753},
-		{42.008188358032555, 27.995679956799563},
-		{42.14190048769579, 27.135271352713545},
-		{41.82708458747604, 26.11646116461165},
-		{41.329472358096425, 26.105661056610586},
-		{41.234689076309834, 25.198451984519863},
-		{41.58335614859622, 24.4928449284493},
-		{41.30916165485644, 23.69363693636936},
-		{41.337935151113086, 22.952029520295213},
-		{41.99972556501589, 22.880028800288017},
-		{42.31961914104565, 22.37962379623798},
-		{42.46179406372555, 22.545225452254527},
-		{42.580273165958786, 22.43722437224372},
-		{42.898474183385204, 22.60642606426066},
-		{43.21159752500161, 22.98442984429846},
-		{43.64319996885128, 22.49842498424985},
-		{44.00879262717099, 22.4120241202412},
-		{44.235595480017494, 22.6568265682657},
-	},
-	{
-		{23.759021496906826, -77.53577535775358},
-		{23.709937297410193, -77.78057780577805},
-		{24.285407222543085, -78.03258032580325},
-		{24.574834743712856, -78.40698406984069},
-		{25.209544219962353, -78.1909819098191},
-		{25.17061537208572, -77.88857888578886},
-		{24.339569097849704, -77.53937539375393},
-		{23.759021496906826, -77.53577535775358},
-	},
-	{
-		{26.58051668866129, -77.82017820178201},
-		{26.419723621344744, -78.91098910989109},
-		{26.790393955474457, -78.97938979389794},
-		{26.86994420983106, -78.51138511385113},
-		{26.83947815497109, -77.8489784897849},
-		{26.58051668866129, -77.82017820178201},
-	},
-	{
-		{26.590672040281277, -76.99936999369993},
-		{25.879797426881836, -77.17217172171722},
-		{26.006739322131736, -77.35577355773557},
-		{26.52973993056132, -77.34137341373413},
-		{26.925798643741018, -77.78777787777878},
-		{27.039200070164256, -77.79137791377913},
-		{26.590672040281277, -76.99936999369993},
-	},
-	{
-		{44.86014960464699, 19.006390063900653},
-		{44.86353472185367, 19.366393663936634},
-		{44.423469484987336, 19.117991179911797},
-		{44.03925868203096, 19.600396003960043},
-		{43.56872739030466, 19.452794527945287},
-		{43.5230283080147, 19.218792187921878},
-		{43.4333227020381, 19.031590315903173},
-		{43.1997496147783, 18.707587075870777},
-		{42.64966806869539, 18.55998559985599},
-		{43.02880119584175, 17.674376743767453},
-		{43.44686317086477, 17.296372963729652},
-		{43.666895789297925, 16.914769147691487},
-		{44.0409512406343, 16.457564575645762},
-		{44.350689465044056, 16.23796237962381},
-		{44.81952819816702, 15.748357483574836},
-		{45.23420505598337, 15.960759607596088},
-		{45.004017085930215, 16.317163171631734},
-		{45.21220179414006, 16.533165331653322},
-		{45.23420505598337, 17.00117001170011},
-		{45.068334312856834, 17.861578615786158},
-		{45.08187478168348, 18.552785527855292},
-		{44.86014960464699, 19.006390063900653},
-	},
-	{
-		{53.911953015266576, 23.4848348483485},
-		{53.90518278085325, 24.44964449644496},
-		{54.2826233493963, 25.536855368553688},
-		{54.84624536430586, 25.767257672576733},
-		{55.167831498938924, 26.588065880658803},
-		{55.61466697021858, 26.49446494464945},
-		{55.78392283055179, 27.102871028710297},
-		{56.1698261921115, 28.175681756817568},
-		{55.91763496021501, 29.230492304923047},
-		{55.670521404128536, 29.370893708937103},
-		{55.78900050636177, 29.89649896498966},
-		{55.55034974329196, 30.87570875708758},
-		{55.081511010168995, 30.972909729097296},
-		{54.81239419223921, 30.756907569075707},
-		{54.15737401274971, 31.383313833138345},
-		{53.97457768358986, 31.79011790117903},
-		{53.79347391303334, 31.732517325173262},
-		{53.617447818286806, 32.405724057240576},
-		{53.3517161175637, 32.69372693726939},
-		{53.133376057733855, 32.304923049230496},
-		{53.1672272298005, 31.498514985149853},
-		{53.07413650661725, 31.30411304113042},
-		{52.74239502036416, 31.541715417154165},
-		{52.100915309701335, 31.786517865178666},
-		{52.0416757585847, 30.92610926109262},
-		{51.82333569875489, 30.620106201062015},
-		{51.31895323496194, 30.555305553055547},
-		{51.41542907535187, 30.15570155701559},
-		{51.36803743445857, 29.255692556925567},
-		{51.6016105217184, 28.992889928899302},
-		{51.427276985575205, 28.618486184861865},
-		{51.57283702546174, 28.240482404824064},
-		{51.59314772870174, 27.455674556745578},
-		{51.83179849177155, 26.339663396633966},
-		{51.911348746128155, 25.3280532805328},
-		{51.88765292568151, 24.554045540455405},
-		{51.616843549148385, 24.00684006840069},
-		{51.57791470127175, 23.52803528035281},
-		{52.02305761394807, 23.506435064350654},
-		{52.48681867126102, 23.20043200432005},
-		{52.691618262264214, 23.798037980379803},
-		{53.089369534047236, 23.80523805238053},
-		{53.470195219796935, 23.52803528035281},
-		{53.911953015266576, 23.4848348483485},
-	},
-	{
-		{17.807985447591477, -89.14229142291423},
-		{17.955238046081362, -89.14949149491494},
-		{18.000937128371334, -89.03069030690307},
-		{17.88245802613808, -88.8470884708847},
-		{18.486701447527608, -88.49068490684907},
-		{18.50024191635427, -88.29988299882999},
-		{18.352989317864385, -88.29628296282962},
-		{18.347911642054385, -88.10548105481054},
-		{18.077102265521262, -88.12348123481235},
-		{17.64380726306827, -88.28548285482854},
-		{17.48978443016506, -88.1990819908199},
-		{17.13096200625867, -88.30348303483035},
-		{17.03617872447208, -88.23868238682387},
-		{16.530103702075806, -88.35388353883539},
-		{16.26606455995602, -88.55188551885519},
-		{16.233905946492712, -88.73188731887319},
-		{15.886931432809646, -88.92988929889299},
-		{15.886931432809646, -89.22869228692286},
-		{17.015868021232095, -89.14949149491494},
-		{17.807985447591477, -89.14229142291423},
-	},
-	{
-		{-22.735563336623493, -67.10647106471065},
-		{-22.872660583493385, -67.82647826478265},
-		{-21.494917880381124, -68.21888218882188},
-		{-20.372751526372, -68.75888758887588},
-		{-19.4046080052661, -68.44208442084421},
-		{-18.98146835443309, -68.96768967689677},
-		{-18.26043838941365, -69.10089100891008},
-		{-17.58002983087418, -69.59049590495904},
-		{-16.500177441948367, -68.96048960489604},
-		{-15.660668374695689, -69.38889388893888},
-		{-15.323849212632624, -69.1620916209162},
-		{-14.95317887850291, -69.33849338493384},
-		{-14.45387409051996, -68.94968949689496},
-		{-13.602517113043959, -68.9280892808928},
-		{-12.90010529266118, -68.88128881288813},
-		{-12.561593571994777, -68.66528665286653},
-		{-10.95197034022604, -69.52929529295292},
-		{-11.03659827039263, -68.78768787687876},
-		{-11.014595008549321, -68.27288272882728},
-		{-10.711627018552889, -68.04968049680497},
-		{-10.307105512356543, -67.17487174871748},
-		{-9.93135750241683, -66.64566645666456},
-		{-9.762101642083636, -65.33885338853388},
-		{-10.511905103359709, -65.44325443254432},
-		{-10.896115906316084, -65.32085320853209},
-		{-11.566369113235552, -65.40365403654036},
-		{-12.461732614398187, -64.31644316443165},
-		{-12.62760335752472, -63.196831968319685},
-		{-12.99996625025777, -62.80442804428044},
-		{-13.197995606847613, -62.127621276212764},
-		{-13.489115686620721, -61.71361713617135},
-		{-13.478960335000721, -61.08361083610836},
-		{-13.77515809058383, -60.5040050400504},
-		{-14.35401313292337, -60.460804608046075},
-		{-14.645133212696479, -60.26280262802628},
-		{-15.076735656546148, -60.2520025200252},
-		{-15.093661242579458, -60.54360543605435},
-		{-16.25814156167189, -60.158401584015834},
-		{-16.29876296815185, -58.23958239582396},
-		{-16.877618010491403, -58.387183871838715},
-		{-17.271984165067764, -58.27918279182792},
-		{-17.552948893220872, -57.73557735577356},
-		{-18.174117900643722, -57.4979749797498},
-		{-18.961157651193105, -57.67437674376744},
-		{-19.3995303294561, -57.95157951579516},
-		{-19.96992257877899, -57.85437854378543},
-		{-20.176414728385495, -58.16758167581675},
-		{-19.868369062579063, -58.18198181981819},
-		{-19.357216364372803, -59.11439114391143},
-		{-19.341983336942803, -60.04320043200431},
-		{-19.63310341671591, -61.78561785617856},
-		{-20.51323389044856, -62.26442264422644},
-		{-21.051467526308144, -62.28962289622896},
-		{-22.249799017467204, -62.685626856268556},
-		{-22.034844074844038, -62.847628476284754},
-		{-21.994222668364067, -63.98523985239852},
-		{-22.798188004946773, -64.37764377643776},
-		{-22.075465481324002, -64.96444964449644},
-		{-21.831737042444196, -66.27486274862748},
-		{-22.735563336623493, -67.10647106471065},
-	},
-	{
-		{-30.216672363350973, -57.6239762397624},
-		{-28.852470129065374, -56.291962919629185},
-		{-27.882634049356135, -55.161551615516146},
-		{-27.47472742595312, -54.491944919449196},
-		{-26.92295332126688, -53.649536495364956},
-		{-26.124065660494175, -53.627936279362785},
-		{-25.546903176757958, -54.12834128341282},
-		{-25.739854857537807, -54.62514625146251},
-		{-25.16269237380159, -54.430744307443064},
-		{-24.570296862635388, -54.293942939429385},
-		{-24.020215316552488, -54.293942939429385},
-		{-23.839111545995962, -54.653946539465394},
-		{-24.001597171915833, -55.02835028350283},
-		{-23.9575906482292, -55.399153991539904},
-		{-23.5716872866695, -55.51795517955179},
-		{-22.656013082266888, -55.61155611556116},
-		{-22.356430209477118, -55.798757987579876},
-		{-22.085620832943995, -56.47196471964719},
-		{-22.281957630930513, -56.88236882368824},
-		{-22.090698508753995, -57.93717937179372},
-		{-20.733266508881727, -57.872378723787236},
-		{-20.176414728385495, -58.16758167581675},
-		{-19.96992257877899, -57.85437854378543},
-		{-19.3995303294561, -57.95157951579516},
-		{-18.961157651193105, -57.67437674376744},
-		{-18.174117900643722, -57.4979749797498},
-		{-17.552948893220872, -57.73557735577356},
-		{-17.271984165067764, -58.27918279182792},
-		{-16.877618010491403, -58.387183871838715},
-		{-16.29876296815185, -58.23958239582396},
-		{-16.25814156167189, -60.158401584015834},
-		{-15.093661242579458, -60.54360543605435},
-		{-15.076735656546148, -60.2520025200252},
-		{-14.645133212696479, -60.26280262802628},
-		{-14.35401313292337, -60.460804608046075},
-		{-13.77515809058383, -60.5040050400504},
-		{-13.478960335000721, -61.08361083610836},
-		{-13.489115686620721, -61.71361713617135},
-		{-13.197995606847613, -62.127621276212764},
-		{-12.99996625025777, -62.80442804428044},
-		{-12.62760335752472, -63.196831968319685},
-		{-12.461732614398187, -64.31644316443165},
-		{-11.566369113235552, -65.40365403654036},
-		{-10.896115906316084, -65.32085320853209},
-		{-10.511905103359709, -65.44325443254432},
-		{-9.762101642083636, -65.33885338853388},
-		{-9.93135750241683, -66.64566645666456},
-		{-10.307105512356543, -67.17487174871748},
-		{-10.711627018552889, -68.04968049680497},
-		{-11.014595008549321, -68.27288272882728},
-		{-11.03659827039263, -68.78768787687876},
-		{-10.95197034022604, -69.52929529295292},
-		{-11.124611317765897, -70.09450094500944},
-		{-11.009517332739321, -70.5481054810548},
-		{-9.489599706947175, -70.48330483304832},
-		{-10.078610100906715, -71.3041130411304},
-		{-10.053221721856744, -72.18612186121861},
-		{-9.52006576180716, -72.56412564125641},
-		{-9.462518769293865, -73.22653226532265},
-		{-9.032608884047534, -73.01413014130141},
-		{-8.424980345451345, -73.57213572135721},
-		{-7.524539168478711, -73.98613986139861},
-		{-7.341742839318854, -73.72333723337233},
-		{-6.918603188485861, -73.72333723337233},
-		{-6.629175667316076, -73.11853118531185},
-		{-6.089249472853169, -73.21933219332193},
-		{-5.74058240056678, -72.96372963729637},
-		{-5.275128784650477, -72.89172891728917},
-		{-4.594720226111008, -71.74691746917469},
-		{-4.4017685453311515, -70.92970929709297},
-		{-4.251130829634604, -70.79650796507964},
-		{-4.2985224705278995, -69.89289892898928},
-		{-1.5565775331300529, -69.44289442894429},
-		{-1.1232825306770593, -69.42129421294213},
-		{-0.5495051641475044, -69.5760957609576},
-		{-0.18560506443111535, -70.01890018900188},
-		{0.5421951350016485, -70.01530015300153},
-		{0.7063733195248432, -69.45369453694536},
-		{0.6031272447215912, -69.2520925209252},
-		{0.9856454890746278, -69.21969219692197},
-		{1.0888915638778798, -69.80289802898028},
-		{1.7151382471107297, -69.81729817298172},
-		{1.693134985267406, -67.86967869678696},
-		{2.0367243817438094, -67.53847538475384},
-		{1.7202159229207297, -67.26127261272612},
-		{1.129512970357851, -67.06327063270632},
-		{1.2530697484010886, -66.8760687606876},
-		{0.724991464161505, -66.32526325263252},
-		{0.7893086910881237, -65.54765547655477},
-		{1.0956617982912178, -65.35325353253532},
-		{1.3292348855510312, -64.61164611646116},
-		{1.49341307007424, -64.19764197641976},
-		{1.9165527209072337, -64.08244082440824},
-		{2.2009025662670183, -63.36963369633696},
-		{2.410779833080184, -63.423634236342366},
-		{2.4971003218501124, -64.26964269642696},
-		{3.1267321222896243, -64.410044100441},
-		{3.796985329209093, -64.36684366843669},
-		{4.055946795518892, -64.81684816848168},
-		{4.149037518702158, -64.62964629646297},
-		{4.020403064848921, -63.888038880388805},
-		{3.7699043915557837, -63.09243092430924},
-		{4.006862596022273, -62.80442804428044},
-		{4.16257798752882, -62.08442084420844},
-		{4.536633438865195, -60.96840968409684},
-		{4.9174591246148935, -60.60120601206012},
-		{5.20011641137134, -60.73440734407343},
-		{5.244122935057973, -60.21240212402124},
-		{5.013934965004822, -59.981999819998194},
-		{4.575562286741828, -60.11160111601116},
-		{4.423232012441943, -59.76599765997659},
-		{3.959470955128978, -59.53919539195391},
-		{3.605726207032589, -59.81639816398163},
-		{2.7560617881599114, -59.97479974799748},
-		{2.249986765763637, -59.719197191971915},
-		{1.7862257084506723, -59.64719647196472},
-		{1.3173869753277074, -59.03159031590316},
-		{1.2683027758310743, -58.538385383853836},
-		{1.4646395738175926, -58.43038430384303},
-		{1.5069535389008877, -58.11358113581136},
-		{1.6829796336474203, -57.659976599766},
-		{1.9487113343705431, -57.335973359733586},
-		{1.8640834042039387, -56.78156781567816},
-		{1.89962713487391, -56.540365403654036},
-		{1.8183843219139817, -55.99675996759967},
-		{2.0214913543138238, -55.90675906759067},
-		{2.221213269507004, -56.07236072360723},
-		{2.5106407906767743, -55.97155971559715},
-		{2.42093518470017, -55.56835568355683},
-		{2.524181259503422, -55.096750967509664},
-		{2.312611434086932, -54.52434524345243},
-		{2.106119284480428, -54.088740887408875},
-		{2.3769286610135367, -53.779137791377906},
-		{2.3346146959302416, -53.55593555935559},
-		{2.053649967777133, -53.41913419134191},
-		{2.1247374291170757, -52.94032940329403},
-		{2.5038705562634505, -52.555125551255514},
-		{3.2418261073162, -52.24912249122491},
-		{4.155807753115482, -51.658716587165856},
-		{4.203199394008777, -51.31671316713167},
-		{3.649732730719208, -51.06831068310683},
-		{1.901319693477248, -50.510305103051024},
-		{1.7371415089540392, -49.973899738997375},
-		{1.0465775987945847, -49.948699486994855},
-		{0.22230155897189263, -50.69750697506976},
-		{-0.07897387242120146, -50.38790387903879},
-		{-0.23468926392774847, -48.62028620286202},
-		{-1.238376515703635, -48.584285842858435},
-		{-0.5816637776108138, -47.82467824678247},
-		{-0.9404862015172029, -46.56826568265683},
-		{-1.551499857320053, -44.905049050490504},
-		{-2.1371251340729316, -44.419044190441895},
-		{-2.690591797362501, -44.58104581045811},
-		{-2.3825461315560688, -43.41823418234182},
-		{-2.9123169743989905, -41.47421474214741},
-		{-2.8733881265223573, -39.980199801998026},
-		{-3.7010492835517113, -38.50058500585004},
-		{-4.821523078957497, -37.222572225722246},
-		{-5.109258041523944, -36.452164521645216},
-		{-5.149879448003901, -35.5989559895599},
-		{-5.464695348223657, -35.235352353523524},
-		{-6.737499417929328, -34.8969489694897},
-		{-7.3434353979221925, -34.73134731347312},
-		{-8.997065153377562, -35.127351273512744},
-		{-9.648700215660384, -35.638556385563845},
-		{-11.039983387599307, -37.046170461704605},
-		{-12.170612534625079, -37.683376833768335},
-		{-13.038895098134404, -38.42498424984248},
-		{-13.057513242771051, -38.673386733867346},
-		{-13.793776235220477, -38.9541895418954},
-		{-15.667438609109013, -38.88218882188821},
-		{-17.207666938141145, -39.15939159391593},
-		{-17.867764793440628, -39.267392673926736},
-		{-18.26213094801699, -39.584195841958405},
-		{-19.59925224464928, -39.760597605976045},
-		{-20.90421492781826, -40.775807758077576},
-		{-21.93667567585078, -40.94500945009449},
-		{-22.369970678303773, -41.755017550175495},
-		{-22.970828982486637, -41.989019890198904},
-		{-22.967443865279975, -43.07623076230763},
-		{-23.351654668236343, -44.64944649446494},
-		{-23.79679758091266, -45.35145351453514},
-		{-24.0896102192891, -46.47106471064711},
-		{-24.885112762855144, -47.64827648276483},
-		{-25.8769521044077, -48.49428494284942},
-		{-26.623370448477118, -48.641886418864175},
-		{-27.17514455316335, -48.47628476284763},
-		{-28.18560203935256, -48.659886598865995},
-		{-28.67475147571551, -48.886688866888676},
-		{-29.224833021798418, -49.588695886958874},
-		{-30.98509396926371, -50.69750697506976},
-		{-31.777211395623084, -51.575915759157596},
-		{-32.246050128746056, -52.25632256322562},
-		{-33.19726806381864, -52.71352713527135},
-		{-33.76766031314153, -53.372333723337235},
-		{-33.20234573962864, -53.649536495364956},
-		{-32.728429330695676, -53.21033210332102},
-		{-32.04802077215621, -53.786337863378634},
-		{-31.494554108866645, -54.571145711457106},
-		{-30.85307439820381, -55.600756007560065},
-		{-30.883540453063787, -55.97155971559715},
-		{-30.11004117134106, -56.97596975969759},
-		{-30.216672363350973, -57.6239762397624},
-	},
-	{
-		{4.526478087245195, 114.2057420574206},
-		{4.90053353858157, 114.60174601746019},
-		{5.447229967457815, 115.45135451354514},
-		{4.954695413888189, 115.40455404554046},
-		{4.316600820432029, 115.3469534695347},
-		{4.348759433895339, 114.86814868148684},
-		{4.006862596022273, 114.65934659346595},
-		{4.526478087245195, 114.2057420574206},
-	},
-	{
-		{27.77207794540702, 91.69831698316983},
-		{27.452184369377278, 92.10512105121052},
-		{26.837785596367752, 92.03312033120335},
-		{26.809012100111104, 91.21591215912161},
-		{26.87502188564106, 90.37350373503733},
-		{26.719306494134514, 89.74349743497436},
-		{27.09843962128089, 88.83628836288364},
-		{27.299854095077393, 88.81468814688145},
-		{28.042887321940142, 89.4770947709477},
-		{28.29677111243994, 90.01710017100174},
-		{28.064890583783466, 90.72990729907298},
-		{28.04119476333682, 91.25911259112593},
-		{27.77207794540702, 91.69831698316983},
-	},
-	{
-		{-22.090698508753995, 29.432094320943207},
-		{-22.82696150120342, 28.017280172801748},
-		{-23.575072403876163, 27.12087120871209},
-		{-24.240247934985646, 26.786067860678628},
-		{-24.615995944925352, 26.487264872648723},
-		{-24.695546199281956, 25.94005940059401},
-		{-25.174540284024914, 25.767257672576733},
-		{-25.485971067038008, 25.666456664566653},
-		{-25.71954415429782, 25.02565025650256},
-		{-25.670459954801196, 24.212042120421216},
-		{-25.38949522664808, 23.733237332373335},
-		{-25.26932356581151, 23.312033120331222},
-		{-25.501204094467994, 22.826028260282612},
-		{-25.98019817921095, 22.58122581225814},
-		{-26.279781052000722, 22.106021060210622},
-		{-26.72661652328037, 21.605616056160557},
-		{-26.82817003948029, 20.88920889208893},
-		{-26.477810408590564, 20.666006660066614},
-		{-25.868489311391038, 20.759607596075966},
-		{-24.917271376318453, 20.165601656016577},
-		{-24.768326219225237, 19.895598955989556},
-		{-21.84866262847752, 19.895598955989556},
-		{-21.81481145641088, 20.882008820088203},
-		{-18.25197559639699, 20.910809108091087},
-		{-18.219816982933693, 21.656016560165597},
-		{-17.869457352043966, 23.196831968319685},
-		{-18.280749092653636, 23.57843578435785},
-		{-17.88976805528395, 24.21564215642158},
-		{-17.886382938077276, 24.521645216452185},
-		{-17.661272643834124, 25.083250832508327},
-		{-17.73574522238073, 25.26325263252633},
-		{-18.536325441756773, 25.64845648456486},
-		{-18.71404409510663, 25.85005850058502},
-		{-19.292899137446184, 26.163261632616326},
-		{-20.39136967100866, 27.29727297272973},
-		{-20.4996934216219, 27.72567725677257},
-		{-20.851745611114964, 27.72567725677257},
-		{-21.48645508736446, 28.020880208802083},
-		{-21.638785361664347, 28.794887948879506},
-		{-22.090698508753995, 29.432094320943207},
-	},
-	{
-		{7.422445857546265, 15.280352803528046},
-		{7.496918436092869, 16.10476104761048},
-		{7.7541873437993445, 16.291962919629214},
-		{7.735569199162683, 16.457564575645762},
-		{7.508766346316193, 16.7059670596706},
-		{7.89128459066923, 17.965979659796602},
-		{8.28057306943559, 18.39078390783908},
-		{8.630932700325317, 18.912789127891273},
-		{8.982984889818383, 18.811988119881192},
-		{9.07438305439831, 19.092790927909277},
-		{9.013450944678354, 20.061200612006132},
-		{9.475519443387995, 21.000810008100075},
-		{10.567219742537134, 21.724417244172457},
-		{10.971741248733494, 22.232022320223223},
-		{11.142689667670027, 22.86562865628656},
-		{10.714472341027019, 22.977229772297733},
-		{10.089918216397507, 23.55323553235533},
-		{9.682011592994499, 23.556835568355694},
-		{9.26564217657483, 23.394833948339482},
-		{8.954211393561735, 23.45963459634598},
-		{8.666476430995289, 23.80523805238053},
-		{8.229796311335633, 24.56844568445686},
-		{7.825274805139287, 25.115651156511575},
-		{7.500303553299531, 25.122851228512303},
-		{6.978995503473286, 25.796057960579617},
-		{6.547393059623616, 26.213662136621366},
-		{5.9465347554407515, 26.465664656646567},
-		{5.550476042261067, 27.21447214472144},
-		{5.233967583437973, 27.37287372873729},
-		{5.127336391428059, 27.04527045270453},
-		{5.151032211874707, 26.404464044640463},
-		{5.255970845281297, 25.652056520565225},
-		{5.1696503565113545, 25.27765277652776},
-		{4.927614476234879, 25.130051300513003},
-		{4.897148421374908, 24.806048060480606},
-		{5.108718246791412, 24.410044100441013},
-		{4.609413458808461, 23.297632976329766},
-		{4.71096697500839, 22.84042840428404},
-		{4.633109279255109, 22.703627036270376},
-		{4.028865857865583, 22.4048240482405},
-		{4.223510097248763, 21.65961659616596},
-		{4.323371054845353, 20.92880928809288},
-		{4.692348830371728, 20.291602916029177},
-		{5.030860551038131, 19.467194671946714},
-		{4.7092744164050515, 18.930789307893093},
-		{4.201506835405453, 18.5419854198542},
-		{3.504172690832661, 18.45198451984521},
-		{3.560027124742618, 17.811178111781118},
-		{3.7275904264724886, 17.13437134371344},
-		{3.197819583629567, 16.536765367653686},
-		{2.266912351796961, 16.01116011160113},
-		{2.5580324315700693, 15.906759067590684},
-		{3.0133306958663724, 15.863558635586372},
-		{3.3349168304994663, 15.406354063540647},
-		{3.8511472045157262, 15.035550355503574},
-		{4.209969628422115, 14.952749527495286},
-		{4.732970236851699, 14.477544775447768},
-		{5.030860551038131, 14.560345603456028},
-		{5.452307643267801, 14.459544595445948},
-		{6.22749948359386, 14.535145351453508},
-		{6.408603254150393, 14.776347763477645},
-		{7.422445857546265, 15.280352803528046},
-	},
-	{
-		{46.54932309077235, -63.664836648366474},
-		{46.415610961109124, -62.937629376293756},
-		{46.44269189876242, -62.01242012420124},
-		{46.03309271675609, -62.505625056250565},
-		{45.96877548982947, -62.872828728287274},
-		{46.391915140662476, -64.14364143641436},
-		{46.72704174412219, -64.3920439204392},
-		{47.036779968531974, -64.0140401404014},
-		{46.54932309077235, -63.664836648366474},
-	},
-	{
-		{49.10508658180369, -61.80721807218072},
-		{49.08646843716703, -62.29322293222931},
-		{49.40128433738677, -63.589235892358914},
-		{49.87350818771641, -64.51804518045181},
-		{49.95644355927969, -64.17244172441724},
-		{49.70594488598654, -62.85842858428583},
-		{49.287882910963546, -61.8360183601836},
-		{49.10508658180369, -61.80721807218072},
-	},
-	{
-		{48.5093059534308, -123.50823508235082},
-		{48.37051614795759, -124.01224012240122},
-		{48.825814412253905, -125.65385653856538},
-		{49.17955916035028, -125.95625956259562},
-		{49.52991879124001, -126.84906849068491},
-		{49.81426863659978, -127.0290702907029},
-		{49.995372407156324, -128.05868058680585},
-		{50.53868371882588, -128.44388443884438},
-		{50.77056424748237, -128.35748357483575},
-		{50.552224187652556, -127.30987309873098},
-		{50.40158647195601, -126.69426694266943},
-		{50.29495527994608, -125.75465754657546},
-		{49.94967332486634, -125.41625416254163},
-		{49.47575691593339, -124.91944919449195},
-		{49.06277261672038, -123.92223922239222},
-		{48.5093059534308, -123.50823508235082},
-	},
-	{
-		{50.68762887591912, -56.13356133561335},
-		{49.81257607799647, -56.79596795967959},
-		{50.14939524005952, -56.14436144361443},
-		{49.93613285603969, -55.471154711547115},
-		{49.5874657837533, -55.823958239582396},
-		{49.3132712900135, -54.934749347493465},
-		{49.55699972889333, -54.47394473944739},
-		{49.248954063086885, -53.476734767347665},
-		{48.51607618784415, -53.786337863378634},
-		{48.68702460678067, -53.087930879308786},
-		{48.15725376393776, -52.95832958329582},
-		{47.53608475651491, -52.64872648726487},
-		{46.65595428278225, -53.069930699306994},
-		{46.61871799350895, -53.51993519935199},
-		{46.806591998478794, -54.17874178741786},
-		{47.625790362491486, -53.962739627396274},
-		{47.752732257741414, -54.239942399423995},
-		{46.88444969423209, -55.399153991539904},
-		{46.919993424902046, -55.99675996759967},
-		{47.38883215802501, -55.29115291152911},
-		{47.63256059690484, -56.25236252362524},
-		{47.573321045788205, -57.32517325173251},
-		{47.60378710064818, -59.265592655926554},
-		{47.899984856231285, -59.42039420394204},
-		{48.25203704572435, -58.79758797587975},
-		{48.52284642225747, -59.23319233192332},
-		{49.12539728504366, -58.39078390783908},
-		{50.71809493077909, -57.357573575735756},
-		{51.28679462149863, -56.73836738367383},
-		{51.63207657657837, -55.87075870758707},
-		{51.58807005289172, -55.40635406354063},
-		{51.3172606763586, -55.600756007560065},
-		{50.68762887591912, -56.13356133561335},
-	},
-	{
-		{54.16922192297305, -133.18153181531815},
-		{54.040587469119814, -132.709927099271},
-		{54.12013772347642, -131.74871748717487},
-		{52.984430900640646, -132.0511205112051},
-		{52.18046556405794, -131.17991179911797},
-		{52.18215812266128, -131.57951579515796},
-		{52.639148945560905, -132.18072180721808},
-		{53.09952488566721, -132.55152551525515},
-		{53.4109556686803, -133.05553055530555},
-		{53.85102090554663, -133.23913239132392},
-		{54.16922192297305, -133.18153181531815},
-	},
-	{
-		{62.158098530700144, -79.26739267392674},
-		{61.63340536366722, -79.65619656196561},
-		{61.71803329383381, -80.0990009900099},
-		{62.01592360802026, -80.36180361803618},
-		{62.08531851075686, -80.3150031500315},
-		{62.38490138354662, -79.92979929799297},
-		{62.36289812170331, -79.51939519395194},
-		{62.158098530700144, -79.26739267392674},
-	},
-	{
-		{62.7115651939897, -81.8990189901899},
-		{62.15979108930348, -83.0690306903069},
-		{62.18179435114679, -83.77463774637746},
-		{62.452603727679914, -83.99423994239942},
-		{62.914672226389555, -83.2490324903249},
-		{62.904516874769556, -81.87741877418773},
-		{62.7115651939897, -81.8990189901899},
-	},
-	{
-		{65.6566171637874, -85.16065160651605},
-		{65.21824448552441, -84.9770497704977},
-		{65.37226731842763, -84.46224462244622},
-		{65.10992073491117, -83.88263882638826},
-		{64.76633133843477, -82.78822788227882},
-		{64.45490055542167, -81.64341643416434},
-		{63.979291587885385, -81.55341553415533},
-		{64.05714928363865, -80.8190081900819},
-		{63.725407797385586, -80.10260102601026},
-		{63.410591897165816, -80.99180991809918},
-		{63.65093521883897, -82.5470254702547},
-		{64.1011558073253, -83.10863108631087},
-		{63.569692405879024, -84.09864098640986},
-		{63.05176947325943, -85.52425524255243},
-		{63.63739475001232, -85.86625866258662},
-		{63.54091890962239, -87.22347223472235},
-		{64.03514602179534, -86.35226352263523},
-		{64.82218577234472, -86.22626226262263},
-		{65.73955253535068, -85.88425884258842},
-		{65.6566171637874, -85.16065160651605},
-	},
-	{
-		{67.14945385192622, -75.86535865358653},
-		{67.09867709382628, -76.98856988569885},
-		{67.58782653018922, -77.23697236972369},
-		{68.14806342789213, -76.81216812168121},
-		{68.28685323336533, -75.89415894158941},
-		{68.01096618102223, -75.11295112951129},
-		{67.58274885437923, -75.10215102151021},
-		{67.443959048906, -75.21735217352173},
-		{67.14945385192622, -75.86535865358653},
-	},
-	{
-		{69.10774415598136, -95.64755647556476},
-		{68.75738452509164, -96.27036270362703},
-		{69.06035251508807, -97.61677616776167},
-		{68.9503362058715, -98.43038430384304},
-		{69.4005567943578, -99.79839798397984},
-		{69.71029501876755, -98.91638916389164},
-		{70.14359002122056, -98.21798217982179},
-		{69.85924017586079, -97.1559715597156},
-		{69.67982896390758, -96.55836558365583},
-		{69.4902624003344, -96.25596255962559},
-		{69.10774415598136, -95.64755647556476},
-	},
-	{
-		{45.13772921559344, -67.13887138871388},
-		{45.70304378910633, -67.79047790477904},
-		{47.06555346478861, -67.79047790477904},
-		{47.35498098595839, -68.23328233282332},
-		{47.18572512562518, -68.90648906489065},
-		{47.448071709141644, -69.23769237692376},
-		{46.69319057205557, -70.00090000900009},
-		{45.91461361452285, -70.30330303303033},
-		{45.45931535022653, -70.65970659706596},
-		{45.30529251732331, -71.08451084510845},
-		{45.254515759223366, -71.40491404914049},
-		{45.00740220313689, -71.50571505715057},
-		{45.00740220313689, -73.34893348933488},
-		{45.00063196872354, -74.86814868148682},
-		{44.81614308096037, -75.31815318153181},
-		{44.09680567454426, -76.37656376563766},
-		{44.01894797879099, -76.49896498964989},
-		{43.62796694142129, -76.81936819368194},
-		{43.62965950002463, -77.73737737377374},
-		{43.624581824214616, -78.72018720187201},
-		{43.467173874104745, -79.17019170191702},
-		{43.27083707611824, -79.01179011790117},
-		{42.96448396891513, -78.92178921789217},
-		{42.86293045271522, -78.93978939789397},
-		{42.367010781938944, -80.24660246602465},
-		{42.20960283182907, -81.27621276212761},
-		{41.67475431317615, -82.4390243902439},
-		{41.67475431317615, -82.6910269102691},
-		{41.83216226328602, -83.02943029430294},
-		{41.976029744569246, -83.1410314103141},
-		{42.0792758193725, -83.11943119431194},
-		{42.42963545026224, -82.89982899828998},
-		{42.97971699634512, -82.42822428224282},
-		{43.570419948908, -82.13662136621366},
-		{44.44039507102066, -82.33822338223382},
-		{45.34760648240662, -82.55062550625506},
-		{45.81644521552958, -83.59463594635946},
-		{45.994163868879454, -83.46863468634686},
-		{46.11772064692268, -83.61623616236162},
-		{46.11772064692268, -83.88983889838899},
-		{46.27512859703255, -84.09144091440913},
-		{46.512086801499024, -84.14184141841417},
-		{46.40884072669577, -84.33624336243362},
-		{46.43930678155577, -84.60624606246063},
-		{46.53916773915235, -84.5450454504545},
-		{46.63733613814561, -84.7790477904779},
-		{46.899682721662074, -84.87624876248762},
-		{47.21957629769183, -85.65385653856538},
-		{47.553010342548234, -86.46026460264602},
-		{47.940606262711256, -87.43947439474394},
-		{48.30281380382429, -88.37908379083791},
-		{48.02015651706786, -89.27189271892719},
-		{48.01000116544786, -89.59949599495995},
-		{48.27065519036098, -90.83070830708307},
-		{48.140328177904436, -91.64071640716406},
-		{48.45006640231419, -92.6091260912609},
-		{48.6091669110274, -93.63153631536315},
-		{48.670099020747344, -94.329943299433},
-		{48.83935488108055, -94.63954639546395},
-		{49.38943642716346, -94.8159481594816},
-		{49.384358751353446, -95.15435154351543},
-		{49.0001479483971, -95.1579515795158},
-		{49.0001479483971, -97.22797227972279},
-		{49.0001479483971, -100.65160651606516},
-		{49.0001479483971, -104.050040500405},
-		{49.0001479483971, -107.04887048870488},
-		{49.0001479483971, -110.05130051300513},
-		{49.0001479483971, -112.99972999729997},
-		{49.0001479483971, -116.0489604896049},
-		{49.0001479483971, -117.03177031770318},
-		{49.0001479483971, -120.00180001800018},
-		{49.0001479483971, -122.83862838628386},
-		{49.00184050700044, -122.97542975429755},
-		{49.985217055536324, -124.90864908649087},
-		{50.416819499385994, -125.6250562505625},
-		{50.829803798599, -127.43587435874358},
-		{51.71501194814162, -127.99387993879938},
-		{52.32941072115115, -127.84987849878499},
-		{52.75593548919083, -129.13149131491315},
-		{53.56159338437685, -129.30429304293042},
-		{54.28770102520629, -130.5139051390514},
-		{54.80223884061921, -130.53550535505354},
-		{55.28461804256885, -129.9810998109981},
-		{55.91594240161167, -130.00630006300062},
-		{56.55234443646451, -131.70911709117092},
-		{57.693128935110295, -132.73152731527315},
-		{58.41077378292307, -133.35433354333543},
-		{58.860994371409376, -134.27234272342724},
-		{59.270593553415736, -134.94554945549456},
-		{59.788516486035334, -135.47475474754748},
-		{59.463545234195564, -136.47916479164792},
-		{58.90500089509602, -137.4511745117451},
-		{59.56171363318883, -138.34038340383404},
-		{60.000086311451824, -139.03879038790387},
-		{60.27766592239827, -140.01440014400146},
-		{60.30643941865493, -140.9972099720997},
-		{66.0002065602638, -140.99360993609935},
-		{69.71198757737089, -140.98640986409865},
-		{69.47164425569774, -139.12159121591216},
-		{68.98926505374813, -137.5447754477545},
-		{68.89786688916821, -136.50436504365044},
-		{69.3159288641912, -135.6259562595626},
-		{69.6273596472043, -134.41634416344164},
-		{69.50549542776439, -132.92952929529295},
-		{69.94386810602738, -131.43191431914317},
-		{70.19436677932053, -129.7938979389794},
-		{69.77968992150419, -129.10629106291063},
-		{70.01326300876399, -128.3610836108361},
-		{70.48379430049029, -128.1378813788138},
-		{70.37716310848039, -127.44667446674467},
-		{69.4801070487144, -125.75465754657546},
-		{70.15882304865055, -124.42624426244262},
-		{69.39886423575449, -124.28944289442894},
-		{69.56304242027768, -123.06183061830617},
-		{69.85585505865411, -122.68382683826837},
-		{69.79830806614082, -121.47061470614706},
-		{69.37855353251449, -119.94419944199441},
-		{69.01126831559144, -117.60417604176041},
-		{68.84201245525826, -116.22536225362254},
-		{68.90632968218488, -115.24615246152462},
-		{68.39856210118525, -113.89973899738997},
-		{67.90264243040899, -115.30375303753037},
-		{67.68768748778582, -113.49653496534965},
-		{67.80616659001906, -110.79650796507966},
-		{67.98050012616226, -109.94689946899469},
-		{67.38133438058271, -108.88128881288813},
-		{67.887409402979, -107.7940779407794},
-		{68.31224161241532, -108.81288812888128},
-		{68.65413845028839, -108.16848168481684},
-		{68.69983753257834, -106.95166951669516},
-		{68.79969849017495, -106.14886148861488},
-		{68.56104772710513, -105.34245342453424},
-		{68.01773641543555, -104.3380433804338},
-		{68.09728666979215, -103.2220322203222},
-		{67.64706608130585, -101.45441454414544},
-		{67.80616659001906, -99.90279902799028},
-		{67.78247076957241, -98.44478444784447},
-		{68.40363977699526, -98.55998559985599},
-		{68.57797331313846, -97.67077670776708},
-		{68.23946159247204, -96.11916119161191},
-		{67.29332133320945, -96.12636126361264},
-		{68.09051643537883, -95.4891548915489},
-		{68.06343549772551, -94.68634686346863},
-		{69.06881530810473, -94.23274232742327},
-		{69.6849066397176, -95.30555305553055},
-		{70.08942814591394, -96.47196471964719},
-		{71.19466891388974, -96.39276392763928},
-		{71.92077655471918, -95.20835208352084},
-		{71.75998348740264, -93.89073890738906},
-		{71.31822569193297, -92.87912879128791},
-		{70.19098166211386, -91.51831518315183},
-		{69.70013966714758, -92.40752407524074},
-		{69.49703263474773, -90.54630546305462},
-		{68.4747272383352, -90.54990549905499},
-		{69.25838187167791, -89.21429214292142},
-		{68.61520960241175, -88.0190801908019},
-		{67.87386893415233, -88.31788317883178},
-		{67.19853805142286, -87.34947349473494},
-		{67.92126057504562, -86.30546305463054},
-		{68.78446546274496, -85.57825578255782},
-		{69.88293599630744, -85.52065520655206},
-		{69.80507830055417, -84.10224102241023},
-		{69.65782570206427, -82.62262622626226},
-		{69.16190603128798, -81.27981279812798},
-		{68.66598636051172, -81.21861218612186},
-		{68.13283040046214, -81.96381963819638},
-		{67.59798188180922, -81.25821258212582},
-		{67.11052500404959, -81.38781387813877},
-		{66.41149830087348, -83.34623346233462},
-		{66.25747546797025, -84.73584735847358},
-		{66.55875089936337, -85.7690576905769},
-		{66.05606099417376, -86.06786067860678},
-		{65.21316680971442, -87.03267032670327},
-		{64.77479413145143, -87.32427324273242},
-		{64.09946324872196, -88.48348483484834},
-		{64.033453463192, -89.91269912699127},
-		{63.610313812358996, -90.70470704707047},
-		{62.96037130867951, -90.76950769507695},
-		{62.83512197203295, -91.93231932319323},
-		{62.02438640103692, -93.15633156331563},
-		{60.89883492982112, -94.239942399424},
-		{60.1101026206684, -94.62874628746287},
-		{58.94900741878264, -94.68634686346863},
-		{58.78144411705277, -93.21393213932139},
-		{57.84545920941018, -92.76392763927639},
-		{57.08719295511743, -92.29592295922959},
-		{57.28522231170727, -90.8991089910899},
-		{56.85192730925429, -89.03789037890378},
-		{56.471101623504595, -88.04068040680407},
-		{55.998877773174954, -87.32427324273242},
-		{55.722990720831845, -86.07146071460714},
-		{55.303236187205485, -85.0130501305013},
-		{55.24568919469222, -83.36063360633607},
-		{55.14752079569895, -82.27342273422734},
-		{54.2826233493963, -82.43542435424354},
-		{53.27724353901708, -82.12582125821258},
-		{52.15846230221463, -81.40221402214021},
-		{51.20893692574536, -79.91179911799118},
-		{51.533908177585104, -79.14139141391414},
-		{52.56129124980764, -78.60138601386014},
-		{54.14214098531973, -79.12339123391233},
-		{54.66852671095599, -79.82899828998289},
-		{55.135672885475614, -78.2269822698227},
-		{55.83808470585841, -77.09657096570966},
-		{56.533726291827875, -76.54216542165422},
-		{57.20228694014402, -76.62496624966249},
-		{58.051951359016684, -77.30177301773017},
-		{58.80513993749942, -78.51858518585186},
-		{59.85283371296195, -77.33777337773377},
-		{60.75835256574457, -77.77337773377734},
-		{62.31889159801669, -78.10818108181081},
-		{62.55077212667315, -77.40977409774098},
-		{62.27827019153672, -75.69615696156961},
-		{62.18179435114679, -74.66654666546665},
-		{62.44414093466325, -73.83853838538386},
-		{62.105629213996835, -72.90972909729096},
-		{61.525081613053956, -71.67851678516784},
-		{61.137485692890934, -71.37251372513725},
-		{61.061320555741005, -69.59049590495904},
-		{60.221811488488314, -69.61929619296193},
-		{58.957470211799304, -69.2880928809288},
-		{58.80175482029277, -68.37368373683736},
-		{58.21274442633323, -67.65007650076501},
-		{58.767903648226124, -66.20286202862029},
-		{59.871451857598586, -65.24525245252453},
-		{60.335212914911565, -64.58284582845828},
-		{59.44323453095559, -63.80523805238052},
-		{58.167045344043245, -62.5020250202502},
-		{56.967021294280855, -61.39681396813968},
-		{56.33908205244468, -61.80001800018},
-		{55.775460037535126, -60.46800468004679},
-		{55.20337522960891, -59.567995679956795},
-		{54.946106321902434, -57.97677976779768},
-		{54.62621274587271, -57.332373323733236},
-		{53.77993344420669, -56.93636936369363},
-		{53.647913873146805, -56.15876158761587},
-		{53.270473304603755, -55.75555755557555},
-		{52.14661439199129, -55.68355683556835},
-		{51.77086638205158, -56.41076410764107},
-		{51.42050675116185, -57.12717127171271},
-		{51.06506944446214, -58.775987759877594},
-		{50.2424859632428, -60.032400324003234},
-		{50.080000337322915, -61.72441724417244},
-		{50.291570162739404, -63.862838628386285},
-		{50.29834039715276, -65.3640536405364},
-		{50.22894549441612, -66.39726397263972},
-		{49.511300646603345, -67.2360723607236},
-		{49.067850292530366, -68.51048510485104},
-		{47.74426946472475, -69.9540995409954},
-		{46.82182502590881, -71.10611106111061},
-		{46.986003210432, -70.25650256502564},
-		{48.299428686617645, -68.65088650886509},
-		{49.13386007806032, -66.5520655206552},
-		{49.23202847705359, -65.0580505805058},
-		{48.742879040690624, -64.17244172441724},
-		{48.07093327516783, -65.11565115651156},
-		{46.99277344484533, -64.79884798847988},
-		{46.23789230775925, -64.47124471244712},
-		{45.73858751977632, -63.171631716317165},
-		{45.88414755966285, -61.51921519215192},
-		{47.00800647227531, -60.51840518405183},
-		{46.28189883144587, -60.450004500045},
-		{45.919691290332835, -59.80199801998019},
-		{45.26467111084334, -61.04041040410404},
-		{44.67058304107381, -63.25443254432544},
-		{44.266061534877466, -64.2480424804248},
-		{43.54503156985801, -65.3640536405364},
-		{43.61950414840463, -66.12366123661236},
-		{44.465783450070646, -66.16326163261633},
-		{45.29175204849666, -64.42444424444244},
-		{45.25959343503335, -66.02646026460265},
-		{45.13772921559344, -67.13887138871388},
-	},
-	{
-		{73.12080060448157, -114.16614166141662},
-		{72.65196187135861, -114.66654666546665},
-		{72.95492986135504, -112.44172441724417},
-		{72.45054739756209, -111.0521105211052},
-		{72.96170009576836, -109.92169921699217},
-		{72.63334372672195, -109.00729007290073},
-		{71.65165973678937, -108.19008190081901},
-		{72.0646440360024, -107.68607686076861},
-		{73.0903345496216, -108.39528395283952},
-		{73.23589458950813, -107.51687516875168},
-		{73.07679408079493, -106.52326523265232},
-		{72.67227257459858, -105.40365403654036},
-		{71.69905137768266, -104.77364773647736},
-		{70.99325444009322, -104.4640446404464},
-		{70.49733476931696, -102.78642786427864},
-		{70.02511091898732, -100.97920979209792},
-		{69.58504568212099, -101.09081090810908},
-		{69.50380286916105, -102.73242732427325},
-		{69.1195920662047, -102.09162091620917},
-		{68.75230684928165, -102.430024300243},
-		{68.90971479939152, -104.24084240842409},
-		{69.18052417592465, -105.96165961659617},
-		{69.1195920662047, -107.12087120871209},
-		{68.77938778693495, -109.0000900009},
-		{68.63044262984174, -111.53451534515345},
-		{68.53565934805515, -113.31293312933128},
-		{69.00788319838479, -113.85653856538565},
-		{69.28038513352125, -115.2209522095221},
-		{69.16867626570134, -116.10656106561066},
-		{69.9607936920607, -117.34137341373413},
-		{70.0657323254673, -116.67536675366753},
-		{70.23668074440381, -115.1309513095131},
-		{70.1926742207172, -113.71973719737197},
-		{70.36700775686039, -112.41652416524165},
-		{70.60058084412022, -114.34974349743497},
-		{70.52103058976361, -116.48816488164881},
-		{70.54134129300358, -117.90657906579065},
-		{70.90862650992663, -118.43218432184321},
-		{71.3097628989163, -116.11376113761138},
-		{71.29452987148632, -117.65457654576545},
-		{71.55856901360612, -119.40059400594006},
-		{72.3083724748822, -118.56178561785617},
-		{72.70612374666523, -117.8669786697867},
-		{73.31375228526143, -115.18855188551885},
-		{73.12080060448157, -114.16614166141662},
-	},
-	{
-		{73.42038347727133, -104.50004500045},
-		{72.76028562197185, -105.37845378453784},
-		{73.45931232514796, -106.94086940869408},
-		{73.59979468922454, -106.59886598865988},
-		{73.64041609570448, -105.25965259652597},
-		{73.42038347727133, -104.50004500045},
-	},
-	{
-		{73.10218245984491, -76.34056340563406},
-		{72.8262954075018, -76.25056250562506},
-		{72.85506890375844, -77.31617316173161},
-		{72.87707216560175, -78.39258392583926},
-		{72.74166747733518, -79.4869948699487},
-		{72.80259958705516, -79.7749977499775},
-		{73.33237042989806, -80.87660876608766},
-		{73.69288541240779, -80.83340833408333},
-		{73.75889519793775, -80.35460354603546},
-		{73.65226400592782, -78.0649806498065},
-		{73.10218245984491, -76.34056340563406},
-	},
-	{
-		{73.15803689375487, -86.5610656106561},
-		{72.53348276912537, -85.77265772657726},
-		{73.34083322291472, -84.8510485104851},
-		{73.75043240492109, -82.31662316623166},
-		{72.71627909828523, -80.59940599405994},
-		{72.06125891879573, -80.75060750607506},
-		{72.35237899856882, -78.77058770587705},
-		{72.75013027035185, -77.82377823778238},
-		{72.24405524795559, -75.60615606156061},
-		{71.76675372181596, -74.22734227342274},
-		{71.3300736021563, -74.09774097740977},
-		{71.55687645500278, -72.24372243722436},
-		{70.92047442014994, -71.19971199711996},
-		{70.52441570697026, -68.78768787687876},
-		{70.12158675937725, -67.91647916479164},
-		{69.18560185173463, -66.96966969669697},
-		{68.72014823581834, -68.80568805688057},
-		{68.06682061493218, -66.45126451264512},
-		{67.84678799649903, -64.86364863648636},
-		{66.92772867488974, -63.423634236342366},
-		{66.8617188893598, -61.85041850418504},
-		{66.16099962758035, -62.16362163621636},
-		{64.99821186709126, -63.916839168391675},
-		{65.42642919373425, -65.1480514805148},
-		{66.38780248042683, -66.72126721267212},
-		{66.26255314378027, -68.01368013680137},
-		{65.69046833585405, -68.13968139681397},
-		{65.10822817630783, -67.08847088470884},
-		{64.64785223620153, -65.73125731257312},
-		{64.38212053547839, -65.32085320853209},
-		{63.39366631113249, -64.66924669246693},
-		{62.674328904716404, -65.01485014850148},
-		{62.94513828124953, -66.27486274862748},
-		{63.74571850062556, -68.7840878408784},
-		{62.884206171529584, -67.36927369273693},
-		{62.27996275014003, -66.32886328863289},
-		{61.93129567785364, -66.16686166861668},
-		{62.33073950824, -68.87768877688777},
-		{62.91128710918288, -71.02331023310232},
-		{63.39705142833917, -72.23652236522365},
-		{63.67970871509563, -71.88731887318873},
-		{64.19424653050855, -73.37773377733777},
-		{64.6783182910615, -74.83574835748357},
-		{64.38889076989172, -74.81774817748177},
-		{64.22979026117851, -77.70857708577086},
-		{64.57337965765491, -78.55458554585546},
-		{65.30964265010435, -77.89577895778957},
-		{65.32656823613767, -76.01656016560165},
-		{65.45520268999088, -73.96093960939609},
-		{65.81233255529393, -74.29214292142922},
-		{66.30994478467356, -73.94653946539465},
-		{67.28485854019279, -72.65052650526505},
-		{67.72661633566246, -72.92772927729277},
-		{68.07020573213886, -73.31293312933128},
-		{68.55427749269181, -74.8429484294843},
-		{68.89448177196154, -76.86976869768698},
-		{69.14836556246134, -76.2289622896229},
-		{69.76953456988419, -77.28737287372873},
-		{69.82708156239748, -78.16938169381693},
-		{70.16728584166721, -78.95778957789578},
-		{69.8710880860841, -79.49419494194942},
-		{69.74245363223086, -81.3050130501305},
-		{69.96587136787069, -84.94464944649447},
-		{70.26037656485046, -87.06147061470614},
-		{70.41101428054701, -88.68148681486815},
-		{70.76137391143673, -89.5130951309513},
-		{71.21836473433639, -88.4690846908469},
-		{71.22174985154304, -89.88749887498875},
-		{72.23559245493892, -90.20430204302043},
-		{73.12926339749824, -89.43749437494374},
-		{73.53717002090123, -88.40788407884078},
-		{73.8045942802277, -85.82665826658267},
-		{73.15803689375487, -86.5610656106561},
-	},
-	{
-		{73.84352312810434, -100.35640356403563},
-		{73.63364586129116, -99.16479164791647},
-		{73.76058775654106, -97.37917379173791},
-		{73.46946767676796, -97.119971199712},
-		{72.990473592025, -98.05238052380524},
-		{72.56056370677867, -96.54036540365404},
-		{71.66012252980603, -96.72036720367204},
-		{71.27252660964301, -98.35838358383583},
-		{71.3571545398096, -99.32319323193232},
-		{71.73798022555933, -100.01440014400144},
-		{72.50978694867872, -102.49842498424984},
-		{72.82968052470845, -102.48042480424805},
-		{72.70612374666523, -100.43920439204392},
-		{73.35945136755139, -101.54081540815407},
-		{73.84352312810434, -100.35640356403563},
-	},
-	{
-		{72.77213353219518, -93.1959319593196},
-		{72.02402262952243, -94.26874268742687},
-		{72.06125891879573, -95.409954099541},
-		{72.93969683392505, -96.03276032760327},
-		{73.43730906330465, -96.01836018360183},
-		{73.862141272741, -95.49635496354963},
-		{74.13464320787743, -94.50274502745027},
-		{74.10079203581081, -92.41832418324184},
-		{73.85706359693098, -90.51030510305102},
-		{72.96677777157835, -92.00432004320042},
-		{72.77213353219518, -93.1959319593196},
-	},
-	{
-		{71.38423547746292, -120.45900459004591},
-		{70.90185627551331, -123.09063090630906},
-		{71.3402289537763, -123.61983619836198},
-		{71.86830723801587, -125.92745927459274},
-		{72.29144688884888, -125.4990549905499},
-		{73.0226322054883, -124.80784807848079},
-		{73.67934494358114, -123.94023940239401},
-		{74.29205115798733, -124.91944919449195},
-		{74.4494591080972, -121.5390153901539},
-		{74.24127439988736, -120.10980109801098},
-		{74.1854199659774, -117.55737557375573},
-		{73.89599244480762, -116.58536585365854},
-		{73.47454535257795, -115.51255512555126},
-		{73.22235412068149, -116.76896768967688},
-		{72.5199423002987, -119.22059220592206},
-		{71.81922303851924, -120.45900459004591},
-		{71.38423547746292, -120.45900459004591},
-	},
-	{
-		{74.97922995094011, -93.61353613536136},
-		{74.59163403077709, -94.1571415714157},
-		{74.6661066093237, -95.6079560795608},
-		{74.92845319284017, -96.82116821168212},
-		{75.37867378132647, -96.28836288362884},
-		{75.64779059925625, -94.85194851948519},
-		{75.29573840976319, -93.97713977139772},
-		{74.97922995094011, -93.61353613536136},
-	},
-	{
-		{76.7191801951654, -98.49878498784987},
-		{76.2571116964558, -97.73557735577356},
-		{75.74426643964618, -97.70317703177031},
-		{74.99954065418011, -98.16038160381603},
-		{74.89798713798017, -99.80919809198092},
-		{75.05708764669338, -100.8820088200882},
-		{75.64102036484293, -100.8640086400864},
-		{75.56316266908966, -102.5020250202502},
-		{76.3366619508124, -102.56682566825668},
-		{76.3061958959524, -101.49041490414903},
-		{76.64640017522215, -99.9819998199982},
-		{76.58885318270886, -98.5779857798578},
-		{76.7191801951654, -98.49878498784987},
-	},
-	{
-		{76.20125726254584, -108.21168211682117},
-		{75.8458199558461, -107.81927819278192},
-		{76.01338325757597, -106.930069300693},
-		{75.96937673388935, -105.88245882458824},
-		{75.48022729752638, -105.7060570605706},
-		{75.0046183299901, -106.31446314463145},
-		{74.85059549708687, -109.69849698496985},
-		{74.41730049463389, -112.22212222122221},
-		{74.39360467418724, -113.74493744937449},
-		{74.72026848463032, -113.87093870938709},
-		{75.16202628009997, -111.79371793717937},
-		{75.04354717786673, -116.31176311763117},
-		{75.22295838981994, -117.71217712177122},
-		{76.1995647039425, -116.34776347763477},
-		{76.47883687349228, -115.40455404554045},
-		{76.1420177114292, -112.58932589325893},
-		{75.54962220026299, -110.81450814508145},
-		{75.47345706311306, -109.06849068490685},
-		{76.42975267399565, -110.49770497704976},
-		{76.79365277371203, -109.57969579695796},
-		{76.67855878868546, -108.550085500855},
-		{76.20125726254584, -108.21168211682117},
-	},
-	{
-		{77.0983133223118, -94.68274682746828},
-		{76.7767271876787, -93.5739357393574},
-		{76.77841974628204, -91.60471604716047},
-		{76.45006337723562, -90.74070740707407},
-		{76.07431536729594, -90.97110971109711},
-		{75.84751251444943, -89.82269822698227},
-		{75.61055430998296, -89.18549185491855},
-		{75.56654778629631, -87.83907839078391},
-		{75.48191985612972, -86.37746377463775},
-		{75.69856735735623, -84.78984789847898},
-		{75.78488784612615, -82.75222752227522},
-		{75.71380038478621, -81.12861128611286},
-		{75.33635981624316, -80.05580055800557},
-		{74.92337551703015, -79.83259832598326},
-		{74.65764381630704, -80.4590045900459},
-		{74.44268887368388, -81.94941949419494},
-		{74.56455309312376, -83.22743227432274},
-		{74.41053026022057, -86.09666096660966},
-		{74.3919121155839, -88.14868148681487},
-		{74.51546889362713, -89.7650976509765},
-		{74.83705502826022, -92.42192421924219},
-		{75.38713657434313, -92.76752767527675},
-		{75.88305624511942, -92.88992889928899},
-		{76.31973636477908, -93.89433894338943},
-		{76.44160058421896, -95.96075960759607},
-		{76.75133880862872, -97.119971199712},
-		{77.16093799063508, -96.74556745567456},
-		{77.0983133223118, -94.68274682746828},
-	},
-	{
-		{77.64500975118803, -116.20016200162001},
-		{76.8765881452753, -116.3369633696337},
-		{76.52961363159223, -117.10737107371074},
-		{76.4805294320956, -118.03978039780398},
-		{76.05400466405594, -119.9009990099901},
-		{75.89998183115272, -121.49941499414993},
-		{76.11662933237922, -122.85662856628566},
-		{76.86474023505197, -121.15741157411574},
-		{77.51299018012814, -119.10539105391054},
-		{77.49775715269816, -117.57177571775718},
-		{77.64500975118803, -116.20016200162001},
-	},
-	{
-		{77.51976041454147, -93.84033840338402},
-		{77.4909869182848, -94.29394293942939},
-		{77.55530414521142, -96.16956169561695},
-		{77.83457631476121, -96.43596435964359},
-		{77.81934328733122, -94.42354423544235},
-		{77.63485439956803, -93.72153721537215},
-		{77.51976041454147, -93.84033840338402},
-	},
-	{
-		{77.69747906789131, -110.18810188101881},
-		{77.40974410532489, -112.0529205292053},
-		{77.7330227985613, -113.53253532535325},
-		{78.05122381598771, -112.72612726127261},
-		{78.15277733218763, -111.26451264512644},
-		{77.99706194068109, -109.85329853298532},
-		{77.69747906789131, -110.18810188101881},
-	},
-	{
-		{78.60130536207062, -109.66249662496625},
-		{78.40666112268742, -110.8829088290883},
-		{78.40835368129076, -112.54252542525425},
-		{78.55052860397065, -112.52452524525245},
-		{78.85011147676043, -111.49851498514985},
-		{78.80441239447045, -110.9621096210962},
-		{78.60130536207062, -109.66249662496625},
-	},
-	{
-		{78.0563014917977, -95.83115831158311},
-		{77.8498093421912, -97.31077310773108},
-		{78.08338242945102, -98.12438124381244},
-		{78.4574378807874, -98.55278552785528},
-		{78.87211473860374, -98.6319863198632},
-		{78.83149333212377, -97.3359733597336},
-		{78.76548354659381, -96.75276752767527},
-		{78.41850903291076, -95.55755557555575},
-		{78.0563014917977, -95.83115831158311},
-	},
-	{
-		{78.32541830972751, -100.06120061200612},
-		{77.90735633470449, -99.67239672396724},
-		{78.0190652025244, -101.30321303213032},
-		{78.34403645436414, -102.94842948429483},
-		{78.37958018503413, -105.17685176851768},
-		{78.67747049922055, -104.2120421204212},
-		{78.9178138208937, -105.4180541805418},
-		{79.30202462385007, -105.49365493654936},
-		{79.16492737698017, -103.5280352803528},
-		{78.8010272772638, -100.82440824408243},
-		{78.32541830972751, -100.06120061200612},
-	},
-	{
-		{79.65915448915312, -87.01827018270183},
-		{79.33756835452003, -85.81585815858158},
-		{79.03967804033361, -87.18747187471874},
-		{78.28648946185085, -89.03429034290343},
-		{78.2154020005109, -90.80550805508055},
-		{78.34403645436414, -92.87552875528755},
-		{78.75025051916383, -93.9519395193952},
-		{79.1141506188802, -93.93753937539375},
-		{79.37988231960334, -93.14553145531455},
-		{79.37311208519002, -94.97434974349743},
-		{79.70485357144308, -96.0759607596076},
-		{80.15845927713606, -96.70956709567095},
-		{80.60190963120904, -96.01476014760148},
-		{80.90657017980881, -95.32355323553236},
-		{80.97765764114875, -94.29754297542975},
-		{81.20615305259857, -94.73674736747367},
-		{81.25692981069854, -92.41112411124111},
-		{80.72377385064895, -91.13311133111331},
-		{80.50881890802579, -89.44829448294483},
-		{80.31925234445261, -87.81027810278103},
-		{79.65915448915312, -87.01827018270183},
-	},
-	{
-		{83.10689636414044, -68.49968499684996},
-		{83.02734610978384, -65.82845828458284},
-		{82.9004042145339, -63.67923679236792},
-		{82.62790227939747, -61.85041850418504},
-		{82.36217057867434, -61.893618936189355},
-		{81.92718301761802, -64.33444334443344},
-		{81.7257685438215, -66.75366753667537},
-		{81.50065824957834, -67.65727657276572},
-		{81.50573592538835, -65.47925479254792},
-		{80.89979994539549, -67.84087840878408},
-		{80.61714265863903, -69.4680946809468},
-		{79.79963685322967, -71.18171181711817},
-		{79.63376611010314, -73.24453244532445},
-		{79.43065907770331, -73.88173881738817},
-		{79.32233532709004, -76.90936909369093},
-		{79.19708599044348, -75.53055530555305},
-		{79.01936733709361, -76.22176221762217},
-		{78.52514022492066, -75.39375393753937},
-		{78.1832433870476, -76.34416344163441},
-		{77.90058610029116, -77.88857888578886},
-		{77.50791250431813, -78.36378363783638},
-		{77.21002219013171, -79.76059760597606},
-		{76.9832193372852, -79.62019620196202},
-		{77.02214818516184, -77.91017910179102},
-		{76.77841974628204, -77.88857888578886},
-		{76.17756144209919, -80.55980559805597},
-		{76.4534484944423, -83.17343173431733},
-		{76.29942566153908, -86.1110611106111},
-		{76.41959732237565, -87.60147601476014},
-		{76.47206663907896, -89.49149491494914},
-		{76.95275328242523, -89.61749617496174},
-		{77.1778635766684, -87.7670776707767},
-		{77.90058610029116, -88.26028260282602},
-		{77.96998100302777, -87.64827648276483},
-		{77.5383785591781, -84.9770497704977},
-		{78.17985826984095, -86.34146341463415},
-		{78.37111739201747, -87.96147961479615},
-		{78.75871331218049, -87.15147151471514},
-		{78.9973640752503, -85.38025380253802},
-		{79.34603114753669, -85.09585095850959},
-		{79.73701218490639, -86.5070650706507},
-		{80.25155000031931, -86.93186931869319},
-		{80.2075434766327, -84.19944199441994},
-		{80.09921972601946, -83.40743407434074},
-		{80.46481238433917, -81.84861848618486},
-		{80.57990636936573, -84.09864098640986},
-		{80.51558914243911, -87.59787597875979},
-		{80.85579342170887, -89.36549365493654},
-		{81.26031492790521, -90.20070200702007},
-		{81.55312756628165, -91.3671136711367},
-		{81.89502440415471, -91.58671586715867},
-		{82.08459096772789, -90.09990099900999},
-		{82.1167495811912, -88.93348933489335},
-		{82.27923520711109, -86.97146971469715},
-		{82.65159809984412, -85.49905499054991},
-		{82.60082134174415, -84.26064260642606},
-		{82.31985661359103, -83.18063180631806},
-		{82.85978280805396, -82.4210242102421},
-		{83.02057587537048, -81.09981099810997},
-		{83.13059218458709, -79.3069930699307},
-		{83.17121359106703, -76.25056250562506},
-		{83.06458239905713, -75.71775717757177},
-		{83.23383825939034, -72.83052830528305},
-		{83.16952103246372, -70.66690666906669},
-		{83.10689636414044, -68.49968499684996},
-	},
-	{
-		{47.52423684629157, 9.595895958959602},
-		{47.34821075154504, 9.631896318963186},
-		{47.1027897540619, 9.480694806948065},
-		{46.919993424902046, 9.930699306993063},
-		{46.89291248724875, 10.441904419044192},
-		{46.48331330524239, 10.362703627036268},
-		{46.31405744490918, 9.923499234992363},
-		{46.44099934015908, 9.181891818918189},
-		{46.036477833962735, 8.9658896588966},
-		{46.004319220499426, 8.490684906849083},
-		{46.163419729212634, 8.317883178831806},
-		{45.824908008546245, 7.756277562775637},
-		{45.77751636765295, 7.273872738727391},
-		{45.99077875167278, 6.841868418684186},
-		{46.42915142993577, 6.499864998649997},
-		{46.27343603842921, 6.021060210602116},
-		{46.72534918551888, 6.0390603906039075},
-		{47.2872786418251, 6.76986769867699},
-		{47.541162432324896, 6.7374673746737415},
-		{47.44976426774498, 7.191071910719103},
-		{47.6207126866815, 7.468274682746824},
-		{47.61394245226818, 8.317883178831806},
-		{47.83058995349468, 8.523085230852303},
-		{47.52423684629157, 9.595895958959602},
-	},
-	{
-		{-52.63630362308678, -68.63288632886328},
-		{-54.8687884208817, -68.63288632886328},
-		{-54.87048097948504, -67.56367563675636},
-		{-54.89756191713835, -66.95886958869588},
-		{-55.30208342333469, -67.290072900729},
-		{-55.61182164774445, -68.14688146881468},
-		{-55.57966303428114, -68.640086400864},
-		{-55.49842022132121, -69.23049230492305},
-		{-55.19883734853144, -69.95769957699576},
-		{-55.053277308644894, -71.00531005310053},
-		{-54.494732969545325, -72.26532265322653},
-		{-53.95819189228908, -73.28413284132841},
-		{-52.837718096883286, -74.66294662946629},
-		{-53.04759536369646, -73.83853838538386},
-		{-53.716156012012604, -72.43452434524345},
-		{-54.074978435918986, -71.10611106111061},
-		{-53.616295054416014, -70.59130591305913},
-		{-52.93080882006655, -70.26730267302672},
-		{-52.51782452085354, -69.34569345693457},
-		{-52.63630362308678, -68.63288632886328},
-	},
-	{
-		{-22.735563336623493, -67.10647106471065},
-		{-22.98606200991663, -66.9840698406984},
-		{-24.02529299236248, -67.32967329673296},
-		{-24.517827545932093, -68.41688416884169},
-		{-26.184997770214125, -68.38448384483844},
-		{-26.50658390484721, -68.59328593285933},
-		{-26.899257500820234, -68.29448294482944},
-		{-27.520426508243084, -69.0000900009},
-		{-28.459796533092344, -69.65529655296552},
-		{-29.368700503081634, -70.01530015300153},
-		{-30.33684402418755, -69.9180991809918},
-		{-31.364227096410076, -70.53370533705336},
-		{-33.09063687180873, -70.07290072900729},
-		{-33.27343320096858, -69.81369813698137},
-		{-34.194185081181196, -69.81729817298172},
-		{-35.169098836700435, -70.38970389703897},
-		{-36.00522278674645, -70.36450364503645},
-		{-36.6585504076326, -71.12051120511205},
-		{-37.577609729241885, -71.11691116911169},
-		{-38.552523484761124, -70.81450814508145},
-		{-38.916423584477506, -71.4121141211412},
-		{-39.80840196843347, -71.68211682116821},
-		{-40.832399923449344, -71.9161191611916},
-		{-42.05104211784839, -71.74691746917469},
-		{-42.25414915024823, -72.15012150121501},
-		{-43.40847411772066, -71.9161191611916},
-		{-43.78760724486703, -71.46251462514624},
-		{-44.20736177849337, -71.79371793717937},
-		{-44.407083693686545, -71.32931329313293},
-		{-44.78452426222958, -71.22131221312213},
-		{-44.97409082580277, -71.66051660516605},
-		{-45.56140866115898, -71.55251552515524},
-		{-46.88498948896461, -71.9161191611916},
-		{-47.73803902504394, -72.44892448924489},
-		{-48.24411404744021, -72.330123301233},
-		{-48.87882352368972, -72.64692646926468},
-		{-49.31888876055604, -73.41373413734136},
-		{-50.37843044624188, -73.32733327333273},
-		{-50.74063798735493, -72.97452974529745},
-		{-50.67632076042831, -72.30852308523085},
-		{-51.42612422170439, -72.330123301233},
-		{-52.0083643812506, -71.9161191611916},
-		{-52.14207651091383, -69.49689496894969},
-		{-52.29948446102371, -68.57168571685716},
-		{-52.29271422661038, -69.46089460894609},
-		{-52.53813522409352, -69.94329943299432},
-		{-52.89865020660324, -70.84330843308433},
-		{-53.83294255564251, -71.00531005310053},
-		{-53.85663837608916, -71.430114301143},
-		{-53.53166712424941, -72.55692556925568},
-		{-52.834332979676624, -73.70173701737016},
-		{-52.26224817175041, -74.94734947349473},
-		{-51.62923125410423, -75.26055260552606},
-		{-51.043605977351355, -74.97614976149761},
-		{-50.37843044624188, -75.48015480154801},
-		{-48.674023932686545, -75.60975609756098},
-		{-47.71265064599396, -75.18135181351813},
-		{-46.93915136427123, -74.12654126541265},
-		{-46.648031284498124, -75.64575645756457},
-		{-45.76451569355882, -74.69174691746917},
-		{-44.10242314508678, -74.35334353343534},
-		{-44.45447533457984, -73.24093240932409},
-		{-42.38278360410146, -72.7189271892719},
-		{-42.11705190337834, -73.38853388533884},
-		{-43.36616015263736, -73.70173701737016},
-		{-43.2256777885608, -74.33174331743317},
-		{-41.79546576874525, -74.01854018540185},
-		{-39.9421140980967, -73.67653676536764},
-		{-39.25832042235057, -73.21933219332193},
-		{-38.28340666683133, -73.50733507335073},
-		{-37.156162637012216, -73.58653586535866},
-		{-37.124004023548906, -73.16533165331653},
-		{-35.50930311597017, -72.55332553325533},
-		{-33.90983523582142, -71.86211862118621},
-		{-32.41869110628592, -71.43731437314372},
-		{-30.92077674233709, -71.66771667716677},
-		{-30.096500702514405, -71.36891368913689},
-		{-28.860932922082036, -71.49131491314913},
-		{-27.640598169079652, -70.90450904509045},
-		{-25.706003685471167, -70.72450724507245},
-		{-23.62923427918279, -70.40410404104041},
-		{-21.39336436418121, -70.09090090900908},
-		{-19.75666019475915, -70.16290162901629},
-		{-18.348451436786917, -70.37170371703716},
-		{-18.09287508768378, -69.85689856898568},
-		{-17.58002983087418, -69.59049590495904},
-		{-18.26043838941365, -69.10089100891008},
-		{-18.98146835443309, -68.96768967689677},
-		{-19.4046080052661, -68.44208442084421},
-		{-20.372751526372, -68.75888758887588},
-		{-21.494917880381124, -68.21888218882188},
-		{-22.872660583493385, -67.82647826478265},
-		{-22.735563336623493, -67.10647106471065},
-	},
-	{
-		{18.677960569704126, 110.33930339303396},
-		{18.197273926357838, 109.47529475294755},
-		{18.507012150767594, 108.65448654486545},
-		{19.368524479863595, 108.6256862568626},
-		{19.820437626953236, 109.1188911889119},
-		{20.10140235510636, 110.21330213302133},
-		{20.07770653465971, 110.78570785707859},
-		{19.695188290306675, 111.00891008910088},
-		{19.255123053440343, 110.56970569705697},
-		{18.677960569704126, 110.33930339303396},
-	},
-	{
-		{42.39578427819559, 130.639906399064},
-		{42.98479467215513, 129.99549995499956},
-		{42.424557774452225, 129.5958959589596},
-		{41.99464788920591, 128.05148051480518},
-		{41.46656960496631, 128.20988209882103},
-		{41.50380589423962, 127.34227342273425},
-		{41.81692923585604, 126.87066870668707},
-		{41.107747181059935, 126.18306183061833},
-		{40.56951354520035, 125.08145081450817},
-		{39.928033834537516, 124.26424264242644},
-		{39.638606313367745, 122.86742867428677},
-		{39.16976758024478, 122.1330213302133},
-		{38.89726564510832, 121.05301053010533},
-		{39.3610267024213, 121.58581585815858},
-		{39.75031518118766, 121.3770137701377},
-		{40.422260946710466, 122.16902169021694},
-		{40.94695411374339, 121.63981639816399},
-		{40.593209365647, 120.76860768607685},
-		{39.897567779677544, 119.63819638196384},
-		{39.25270295180805, 119.02259022590226},
-		{39.203618752311414, 118.04338043380437},
-		{38.73816513639511, 117.53217532175324},
-		{38.06114169506232, 118.06138061380614},
-		{37.89696351053911, 118.87858878588787},
-		{37.44843548065613, 118.91098910989109},
-		{37.15562284227968, 119.70299702997033},
-		{37.8698825728858, 120.82260822608225},
-		{37.480594094119425, 121.71181711817121},
-		{37.455205715069454, 122.35622356223564},
-		{36.93051254803653, 122.51822518225185},
-		{36.65124037848675, 121.10341103411037},
-		{36.11131418402384, 120.63540635406355},
-		{35.610316837437566, 119.66339663396633},
-		{34.90959757565811, 119.15219152191526},
-		{34.359516029575204, 120.22860228602286},
-		{33.3761394810393, 120.62100621006209},
-		{32.4604652766367, 121.22941229412294},
-		{31.69204367072396, 121.90981909819101},
-		{30.949010443861198, 121.89181891818919},
-		{30.67650850872475, 121.26541265412658},
-		{30.143352548675168, 121.50301503015032},
-		{29.831921765662074, 122.09342093420935},
-		{29.01780107745938, 121.93861938619386},
-		{28.2256836511, 121.6830168301683},
-		{28.13597804512341, 121.1250112501125},
-		{27.052740538990918, 120.39420394203944},
-		{25.741007621408613, 119.58419584195843},
-		{24.547753806059546, 118.65538655386553},
-		{23.625309367243602, 117.28017280172804},
-		{22.782415182784263, 115.89055890558905},
-		{22.667321197757673, 114.7637476374764},
-		{22.223870843684693, 114.15174151741519},
-		{22.548842095524435, 113.80613806138064},
-		{22.051229866144823, 113.2409324093241},
-		{21.55023251955855, 111.84411844118443},
-		{21.397902245258678, 110.78570785707859},
-		{20.341745676779496, 110.4437044370444},
-		{20.282506125662877, 109.88929889298896},
-		{21.008613766492303, 109.62649626496267},
-		{21.394517128052, 109.86409864098641},
-		{21.714410704081757, 108.52128521285215},
-		{21.551925078161887, 108.04968049680497},
-		{21.81257910307501, 107.04167041670416},
-		{22.218793167874693, 106.56646566465668},
-		{22.794263093007586, 106.72486724867252},
-		{22.977059422167443, 105.81045810458107},
-		{23.35280743210714, 105.3280532805328},
-		{22.819651472057558, 104.47844478444784},
-		{22.704557487030982, 103.50283502835032},
-		{22.709635162840982, 102.70722707227071},
-		{22.464214165357845, 102.17082170821709},
-		{22.318654125471284, 101.65241652416523},
-		{21.17448450961885, 101.80361803618035},
-		{21.20156544727216, 101.2708127081271},
-		{21.43683109313531, 101.18081180811811},
-		{21.84981539234832, 101.14841148411483},
-		{21.55869531257521, 100.41760417604178},
-		{21.743184200338405, 99.98199981999824},
-		{22.118932210278103, 99.24039240392403},
-		{22.948285925910795, 99.53199531995318},
-		{23.142930165293976, 98.89838898388984},
-		{24.06368204550658, 98.6607866078661},
-		{23.89781130238005, 97.60597605976062},
-		{25.084294883315792, 97.72477724777247},
-		{25.91872627475847, 98.67158671586719},
-		{26.74300231458116, 98.71118711187114},
-		{27.508038803287235, 98.68238682386823},
-		{27.74668956635705, 98.24678246782469},
-		{28.335699960316575, 97.91197911979123},
-		{28.26122738176997, 97.32877328773287},
-		{28.411865097466517, 96.2487624876249},
-		{28.831619631092863, 96.58716587165873},
-		{29.452788638515713, 96.11916119161191},
-		{29.031341546286043, 95.40635406354062},
-		{29.27676254376918, 94.56754567545676},
-		{28.640360508916345, 93.4119341193412},
-		{27.897327282053595, 92.50472504725047},
-		{27.77207794540702, 91.69831698316983},
-		{28.04119476333682, 91.25911259112593},
-		{28.064890583783466, 90.72990729907298},
-		{28.29677111243994, 90.01710017100174},
-		{28.042887321940142, 89.4770947709477},
-		{27.299854095077393, 88.81468814688145},
-		{28.086893845626776, 88.73188731887319},
-		{27.87701657881361, 88.11988119881198},
-		{27.973492419203538, 86.95346953469533},
-		{28.20368038925669, 85.82305823058232},
-		{28.64205306751967, 85.01305013050131},
-		{28.840082424109525, 84.23544235442353},
-		{29.320769067455814, 83.90063900639007},
-		{29.4629439901357, 83.33543335433353},
-		{30.11457905241852, 82.32742327423273},
-		{30.422624718224952, 81.52461524615245},
-		{30.18397395515514, 81.1106111061111},
-		{30.883000658331255, 79.7209972099721},
-		{31.51601757597743, 78.73818738187384},
-		{32.61787322674657, 78.45738457384573},
-		{32.484161097083344, 79.17739177391775},
-		{32.993621236686266, 79.20979209792097},
-		{33.50646649349588, 78.81018810188101},
-		{34.322279740301894, 78.91098910989109},
-		{35.49353029380765, 77.83817838178385},
-		{35.898051800004, 76.19296192961929},
-		{36.66647340591673, 75.89775897758977},
-		{37.133619580436374, 75.15975159751599},
-		{37.41966198439948, 74.97974979749799},
-		{37.99005423372236, 74.82854828548287},
-		{38.379342712488736, 74.86454864548645},
-		{38.60614556533521, 74.25614256142563},
-		{38.506284607738635, 73.92853928539287},
-		{39.4304216051579, 73.67653676536767},
-		{39.660609575211055, 73.96093960939609},
-		{39.89418266247088, 73.82053820538206},
-		{40.36640651280051, 74.77814778147783},
-		{40.56274331078703, 75.46935469354693},
-		{40.427338622520466, 76.5277652776528},
-		{41.067125774579964, 76.90576905769058},
-		{41.1856048768132, 78.18738187381877},
-		{41.581663589992885, 78.54378543785441},
-		{42.12328234305913, 80.12060120601205},
-		{42.35008519590562, 80.26100261002608},
-		{42.920477445228514, 80.18180181801819},
-		{43.181131470141636, 80.86580865808656},
-		{44.91769659716029, 79.96579965799657},
-		{45.31714042754665, 81.94581945819459},
-		{45.53886560458314, 82.46062460624609},
-		{47.329592606908406, 83.18063180631805},
-		{47.00123623786199, 85.16425164251643},
-		{47.45314938495163, 85.72225722257224},
-		{48.45514407812418, 85.76905769057691},
-		{48.54992735991077, 86.59706597065974},
-		{49.215102891020265, 87.36027360273602},
-		{49.29803826258352, 87.7526775267753},
-		{48.5990115594074, 88.01548015480154},
-		{48.069240716564494, 88.85428854288546},
-		{47.69349270662478, 90.27990279902798},
-		{46.887834811438736, 90.97110971109714},
-		{45.719969375139655, 90.58590585905858},
-		{45.286674372686676, 90.9459094590946},
-		{45.11572595375013, 92.13392133921343},
-		{44.97524358967358, 93.48033480334806},
-		{44.352382023647394, 94.68994689946902},
-		{44.24067315582748, 95.30555305553054},
-		{43.319921275614874, 95.76275762757626},
-		{42.72583320584532, 96.34956349563498},
-		{42.74952902629197, 97.45117451174514},
-		{42.52441873204883, 99.51759517595178},
-		{42.66320853752204, 100.84600846008459},
-		{42.51426338042883, 101.83241832418327},
-		{41.90663484183264, 103.3120331203312},
-		{41.908327400435965, 104.52164521645216},
-		{41.596896617422885, 104.96444964449648},
-		{42.135130253282455, 106.13086130861308},
-		{42.48210476696552, 107.74367743677436},
-		{42.519341056238815, 109.24489244892447},
-		{42.87139324573188, 110.41130411304113},
-		{43.4062417643848, 111.13131131311314},
-		{43.74306092644788, 111.82971829718298},
-		{44.07310985409761, 111.66771667716677},
-		{44.457320657053984, 111.34731347313476},
-		{45.10218548492348, 111.87291872918729},
-		{45.01247987894688, 112.43452434524346},
-		{44.80937284654705, 113.46413464134645},
-		{45.33914368938996, 114.46134461344616},
-		{45.72673960955298, 115.98415984159845},
-		{46.3885300234558, 116.71856718567187},
-		{46.67287986881557, 117.42057420574207},
-		{46.804899439875484, 118.8749887498875},
-		{46.69319057205557, 119.66339663396633},
-		{47.04862787875528, 119.77139771397714},
-		{47.7476545819314, 118.86778867788678},
-		{48.067548157961156, 118.0649806498065},
-		{47.69687782383146, 117.29457294572944},
-		{47.85259321533799, 116.30816308163082},
-		{47.72734387869143, 115.74295742957429},
-		{48.13525050209442, 115.48375483754836},
-		{49.13386007806032, 116.19296192961929},
-		{49.888741215146396, 116.67896678966792},
-		{49.511300646603345, 117.8777787777878},
-		{50.142625005646195, 119.28899288992892},
-		{50.58269024251253, 119.27819278192783},
-		{51.64392448680168, 120.18180181801819},
-		{51.963818062831436, 120.73980739807399},
-		{52.51559216751768, 120.72540725407254},
-		{52.754242930587495, 120.17820178201782},
-		{53.25185515996709, 121.00261002610029},
-		{53.4312663719203, 122.24462244622447},
-		{53.4583473095736, 123.57303573035733},
-		{53.16045699538718, 125.06705067050672},
-		{52.79317177846413, 125.94545945459458},
-		{51.784406850878256, 126.56466564665647},
-		{51.354496965631924, 126.93906939069393},
-		{50.7400981926224, 127.28827288272885},
-		{49.76010676129316, 127.65907659076589},
-		{49.4402131852634, 129.3978939789398},
-		{48.72933857186398, 130.58230582305822},
-		{47.78996854701471, 130.98550985509854},
-		{47.78827598841137, 132.50832508325084},
-		{48.18264214298772, 133.37233372333725},
-		{48.478839898570826, 135.02475024750248},
-		{47.57839872159819, 134.49914499144995},
-		{47.21280606327848, 134.1139411394114},
-		{46.11772064692268, 133.76833768337684},
-		{45.14449945000676, 133.09873098730986},
-		{45.320525544753295, 131.88191881918823},
-		{44.96847335526026, 131.02511025110255},
-		{44.11203870197424, 131.28791287912878},
-		{42.93063279684851, 131.1439114391144},
-		{42.90355185919519, 130.63270632706326},
-		{42.39578427819559, 130.639906399064},
-	},
-	{
-		{4.99531682036816, -2.85662856628565},
-		{4.983468910144836, -3.3102331023310114},
-		{5.179805708131354, -4.008640086400845},
-		{5.167957797908031, -4.649446494464939},
-		{4.993624261764836, -5.833858338583383},
-		{4.70588929919839, -6.528665286652853},
-		{4.338604082275339, -7.518675186751864},
-		{4.363992461325324, -7.713077130771296},
-		{5.188268501148016, -7.633876338763372},
-		{5.313517837794578, -7.54027540275402},
-		{5.707883992370938, -7.569075690756904},
-		{6.125945967393946, -7.993879938799381},
-		{6.193648311527227, -8.31068310683105},
-		{6.467842805267011, -8.602286022860227},
-		{6.911293159340005, -8.38628386283861},
-		{7.395364919892955, -8.48708487084869},
-		{7.686484999666064, -8.440284402844014},
-		{7.686484999666064, -8.281882818828194},
-		{8.12316511932572, -8.220682206822062},
-		{8.316116800105561, -8.299882998829986},
-		{8.454906605578785, -8.20268202682027},
-		{8.57507826641536, -7.831878318783168},
-		{9.375658485791405, -8.080280802808034},
-		{9.790335343607751, -8.31068310683105},
-		{10.128847064274154, -8.22788227882279},
-		{10.20670476002742, -8.029880298802993},
-		{10.298102924607349, -7.900279002790029},
-		{10.147465208910802, -7.623076230762308},
-		{10.13900241589414, -6.849068490684914},
-		{10.430122495667248, -6.665466654666545},
-		{10.411504351030601, -6.492664926649269},
-		{10.524905777453839, -6.204662046620456},
-		{10.096688450810845, -6.049860498604971},
-		{10.221937787457406, -5.815858158581591},
-		{10.37088294455063, -5.405454054540542},
-		{10.152542884720802, -4.955449554495544},
-		{9.82249395707106, -4.779047790477904},
-		{9.610924131654556, -4.329043290432907},
-		{9.863115363551017, -3.9798397983979896},
-		{9.900351652824327, -3.511835118351172},
-		{9.643082745117866, -2.827828278282766},
-		{8.219640959715647, -2.5614256142561374},
-		{7.38013189246297, -2.9826298262982505},
-		{6.2511953040405075, -3.2454324543245434},
-		{5.38968297494452, -2.809828098280974},
-		{4.99531682036816, -2.85662856628565},
-	},
-	{
-		{2.266912351796961, 13.077130771307708},
-		{2.321074227103594, 12.951129511295107},
-		{2.1924397732503564, 12.360723607236082},
-		{2.3261519029135798, 11.752317523175236},
-		{2.261834675986961, 11.277112771127719},
-		{2.2838379378302847, 9.649896498965006},
-		{3.0725702469830054, 9.793897938979399},
-		{3.7343606608858124, 9.405094050940505},
-		{3.9036165212190213, 8.947889478894808},
-		{4.3521445511020005, 8.746287462874648},
-		{4.496012032385224, 8.487084870848719},
-		{4.771899084728332, 8.501485014850147},
-		{5.479388580921125, 8.757087570875711},
-		{6.444146984820364, 9.232292322923229},
-		{6.45430233644035, 9.523895238952406},
-		{7.038235054589904, 10.117901179011795},
-		{7.055160640623214, 10.495904959049597},
-		{6.643868900013544, 11.057510575105766},
-		{6.980688062076609, 11.745117451174508},
-		{7.397057478496279, 11.838718387183889},
-		{7.7998864260893015, 12.06552065520657},
-		{8.305961448485576, 12.220322203222025},
-		{8.71725318909526, 12.753127531275311},
-		{9.4179724508747, 12.954729547295472},
-		{9.641390186514528, 13.167131671316724},
-		{10.161005677737464, 13.307533075330753},
-		{10.799100271193623, 13.57393573935741},
-		{11.572599552916358, 14.416344163441636},
-		{11.904341039169424, 14.466744667446676},
-		{12.085444809725956, 14.578345783457848},
-		{12.483196081508979, 14.182341823418227},
-		{12.801397098935396, 14.214742147421475},
-		{12.858944091448677, 14.49554495544956},
-		{12.21915693938918, 14.895148951489517},
-		{11.555673966883035, 14.959949599496014},
-		{10.890498435773551, 14.923949239492401},
-		{9.98159446578427, 15.46755467554675},
-		{9.991749817404255, 14.909549095490974},
-		{9.920662356064312, 14.628746287462889},
-		{10.022215872264226, 14.171541715417163},
-		{9.5499920219346, 13.955539555395575},
-		{8.966059303785059, 14.5459454594546},
-		{8.796803443451864, 14.98154981549817},
-		{8.382126585635518, 15.121951219512198},
-		{7.693255234079388, 15.435154351543531},
-		{7.422445857546265, 15.280352803528046},
-		{6.408603254150393, 14.776347763477645},
-		{6.22749948359386, 14.535145351453508},
-		{5.452307643267801, 14.459544595445948},
-		{5.030860551038131, 14.560345603456028},
-		{4.732970236851699, 14.477544775447768},
-		{4.209969628422115, 14.952749527495286},
-		{3.8511472045157262, 15.035550355503574},
-		{3.3349168304994663, 15.406354063540647},
-		{3.0133306958663724, 15.863558635586372},
-		{2.5580324315700693, 15.906759067590684},
-		{2.266912351796961, 16.01116011160113},
-		{1.7269861573340535, 15.939159391593932},
-		{1.9639443618005288, 15.147151471514718},
-		{2.2279835039203277, 14.337143371433712},
-		{2.266912351796961, 13.077130771307708},
-	},
-	{
-		{3.509250366642661, 30.832508325083268},
-		{2.3396923717402416, 30.7749077490775},
-		{2.20428768347368, 31.174511745117456},
-		{1.848850376773953, 30.854108541085424},
-		{1.5831186760508302, 30.468904689046894},
-		{1.0618106262245703, 30.08730087300873},
-		{0.5980495689116054, 29.874898748987505},
-		{-0.20591576767110098, 29.8208982089821},
-		{-0.5867414534207995, 29.586895868958692},
-		{-1.341622590506887, 29.579695796957964},
-		{-1.6208947600566574, 29.29169291692918},
-		{-2.214982829826198, 29.255692556925567},
-		{-2.2928405255794786, 29.118891188911903},
-		{-2.83953695445571, 29.02529025290255},
-		{-3.293142660148689, 29.277292772927723},
-		{-4.499936944324418, 29.338493384933855},
-		{-5.420688824537024, 29.51849518495186},
-		{-5.94030431575996, 29.421294212942144},
-		{-6.5208519167028385, 29.61929619296194},
-		{-7.0793962558023935, 30.1989019890199},
-		{-8.340352415284741, 30.738907389073887},
-		{-8.238798899084827, 30.346503465034658},
-		{-8.406362200814698, 29.003690036900366},
-		{-8.52653386165126, 28.733687336873373},
-		{-9.164628455107433, 28.449284492844924},
-		{-9.606386250577089, 28.67248672486727},
-		{-10.78948471430617, 28.49608496084963},
-		{-11.793171966082042, 28.373683736837364},
-		{-11.970890619431913, 28.643686436864385},
-		{-12.360179098198273, 29.34209342093422},
-		{-12.17907532764174, 29.615696156961576},
-		{-13.257235157964232, 29.698496984969864},
-		{-13.24877236494757, 28.935289352893534},
-		{-12.698690818864677, 28.524885248852485},
-		{-12.272166050825007, 28.15408154081541},
-		{-12.133376245351783, 27.387273872738746},
-		{-11.608683078318862, 27.1640716407164},
-		{-11.925191537141941, 26.55206552065522},
-		{-11.78470917306538, 25.752857528575305},
-		{-11.331103467372401, 25.418054180541816},
-		{-11.238012744189149, 24.78444784447845},
-		{-11.26340112323912, 24.312843128431297},
-		{-10.95197034022604, 24.258842588425892},
-		{-10.926581961176055, 23.91323913239134},
-		{-10.867342410059436, 23.456034560345614},
-		{-11.017980125755983, 22.836828368283676},
-		{-10.992591746705997, 22.401224012240135},
-		{-11.083989911285926, 22.156421564215663},
-		{-9.894121213143535, 22.21042210422104},
-		{-9.523450879013822, 21.875618756187578},
-		{-8.909052106004296, 21.800018000180017},
-		{-8.306501243218108, 21.947619476194774},
-		{-7.920597881658409, 21.746017460174613},
-		{-7.290966081218897, 21.72801728017282},
-		{-7.299428874235559, 20.514805148051494},
-		{-6.938913891725846, 20.601206012060118},
-		{-6.942299008932508, 20.090000900009017},
-		{-7.116632545075703, 20.036000360003612},
-		{-7.155561392952336, 19.416794167941674},
-		{-7.737801552498553, 19.168391683916838},
-		{-7.98830022579169, 19.017190171901717},
-		{-7.847817861715129, 18.462784627846275},
-		{-7.98830022579169, 18.135181351813515},
-		{-8.067850480148294, 17.472774727747293},
-		{-7.5448498717186965, 17.091170911709128},
-		{-7.221571178482279, 16.860768607686083},
-		{-6.622405432902752, 16.57276572765727},
-		{-5.877679647436665, 16.327963279632797},
-		{-5.864139178610017, 13.375933759337613},
-		{-5.984310839446593, 13.023130231302332},
-		{-5.965692694809931, 12.735127351273519},
-		{-6.099404824473169, 12.321123211232106},
-		{-5.7896666000633985, 12.180721807218077},
-		{-5.684727966656823, 12.436324363243642},
-		{-5.248047846997167, 12.46872468724689},
-		{-4.990778939290692, 12.630726307263075},
-		{-4.780901672477526, 12.994329943299448},
-		{-4.882455188677454, 13.257132571325712},
-		{-4.499936944324418, 13.59913599135993},
-		{-4.510092295944403, 14.146341463414643},
-		{-4.79274958270085, 14.207542075420747},
-		{-4.970468236050706, 14.581945819458213},
-		{-4.344221552817871, 15.172351723517238},
-		{-3.85507211645492, 15.7519575195752},
-		{-3.5351785404251643, 16.007560075600765},
-		{-2.7125950592058103, 15.971559715597152},
-		{-1.7410664208932332, 16.40716407164072},
-		{-1.2265286054803113, 16.864368643686447},
-		{-0.7441494035306846, 17.523175231752333},
-		{-0.4242558275009287, 17.63837638376384},
-		{-0.058663169181215835, 17.66357663576636},
-		{0.2883113445018495, 17.825578255782574},
-		{0.8553184766180664, 17.775177751777534},
-		{1.7422191847640391, 17.89757897578977},
-		{2.365080750790213, 18.095580955809567},
-		{2.8999292694431347, 18.394383943839443},
-		{3.504172690832661, 18.45198451984521},
-		{4.201506835405453, 18.5419854198542},
-		{4.7092744164050515, 18.930789307893093},
-		{5.030860551038131, 19.467194671946714},
-		{4.692348830371728, 20.291602916029177},
-		{4.323371054845353, 20.92880928809288},
-		{4.223510097248763, 21.65961659616596},
-		{4.028865857865583, 22.4048240482405},
-		{4.633109279255109, 22.703627036270376},
-		{4.71096697500839, 22.84042840428404},
-		{4.609413458808461, 23.297632976329766},
-		{5.108718246791412, 24.410044100441013},
-		{4.897148421374908, 24.806048060480606},
-		{4.927614476234879, 25.130051300513003},
-		{5.1696503565113545, 25.27765277652776},
-		{5.255970845281297, 25.652056520565225},
-		{5.151032211874707, 26.404464044640463},
-		{5.127336391428059, 27.04527045270453},
-		{5.233967583437973, 27.37287372873729},
-		{4.407998985011957, 27.981279812798135},
-		{4.287827324175382, 28.42768427684277},
-		{4.4553906259052525, 28.69768697686979},
-		{4.38938084037531, 29.15849158491585},
-		{4.6009506657918, 29.716497164971656},
-		{4.174425897752144, 29.95409954099543},
-		{3.509250366642661, 30.832508325083268},
-	},
-	{
-		{-4.780901672477526, 12.994329943299448},
-		{-4.437312276001123, 12.61992619926201},
-		{-4.606568136334332, 12.31752317523177},
-		{-5.038170580183987, 11.914319143191449},
-		{-3.978628894498158, 11.09351093510935},
-		{-3.4268547898119124, 11.85671856718568},
-		{-2.7650643759091054, 11.47871478714788},
-		{-2.5145657026159682, 11.820718207182068},
-		{-2.3910089245727306, 12.49392493924941},
-		{-1.9492511291030752, 12.57672576725767},
-		{-2.42824521384604, 13.109531095310956},
-		{-2.470559178929335, 13.991539915399159},
-		{-1.9983353285997083, 14.297542975429764},
-		{-1.3331597974902252, 14.427144271442728},
-		{-0.5528902813541663, 14.315543155431556},
-		{0.03950522981203619, 13.843938439384402},
-		{1.1972153144911317, 14.275942759427608},
-		{1.3952446710809738, 14.027540275402771},
-		{1.3140018581210455, 13.282332823328233},
-		{1.8302322321373055, 13.001530015300148},
-		{2.266912351796961, 13.077130771307708},
-		{2.2279835039203277, 14.337143371433712},
-		{1.9639443618005288, 15.147151471514718},
-		{1.7269861573340535, 15.939159391593932},
-		{2.266912351796961, 16.01116011160113},
-		{3.197819583629567, 16.536765367653686},
-		{3.7275904264724886, 17.13437134371344},
-		{3.560027124742618, 17.811178111781118},
-		{3.504172690832661, 18.45198451984521},
-		{2.8999292694431347, 18.394383943839443},
-		{2.365080750790213, 18.095580955809567},
-		{1.7422191847640391, 17.89757897578977},
-		{0.8553184766180664, 17.775177751777534},
-		{0.2883113445018495, 17.825578255782574},
-		{-0.058663169181215835, 17.66357663576636},
-		{-0.4242558275009287, 17.63837638376384},
-		{-0.7441494035306846, 17.523175231752333},
-		{-1.2265286054803113, 16.864368643686447},
-		{-1.7410664208932332, 16.40716407164072},
-		{-2.7125950592058103, 15.971559715597152},
-		{-3.5351785404251643, 16.007560075600765},
-		{-3.85507211645492, 15.7519575195752},
-		{-4.344221552817871, 15.172351723517238},
-		{-4.970468236050706, 14.581945819458213},
-		{-4.79274958270085, 14.207542075420747},
-		{-4.510092295944403, 14.146341463414643},
-		{-4.499936944324418, 13.59913599135993},
-		{-4.882455188677454, 13.257132571325712},
-		{-4.780901672477526, 12.994329943299448},
-	},
-	{
-		{-0.1517538923644821, -75.37215372153722},
-		{0.0852043121020074, -75.80055800558006},
-		{0.415253239751749, -76.29376293762937},
-		{0.2561527310385401, -76.57816578165782},
-		{0.39494253651176336, -77.42417424174242},
-		{0.8265449803614189, -77.6689766897669},
-		{0.8096193943280952, -77.85617856178561},
-		{1.3817042022543262, -78.8569885698857},
-		{1.6914424266640822, -78.99018990189902},
-		{1.7659150052106867, -78.61938619386194},
-		{2.266912351796961, -78.66258662586625},
-		{2.629119892910012, -78.42858428584286},
-		{2.6968222370432926, -77.93177931779317},
-		{3.3247614788794664, -77.51057510575106},
-		{3.849454645912388, -77.1289712897129},
-		{4.0881054089822015, -77.49617496174962},
-		{4.66865300992508, -77.3089730897309},
-		{5.582634655724377, -77.53217532175321},
-		{5.844981239240838, -77.31977319773198},
-		{6.691260540906839, -77.47817478174781},
-		{7.224416500956423, -77.88137881378813},
-		{7.710180820112711, -77.75177751777517},
-		{7.637400800169431, -77.43137431374313},
-		{7.935291114355863, -77.24417244172442},
-		{8.524301508315403, -77.47457474574746},
-		{8.66986154820195, -77.35217352173521},
-		{8.63939549334198, -76.83736837368373},
-		{9.336729637914772, -76.08496084960849},
-		{9.443360829924686, -75.67455674556746},
-		{9.773409757574427, -75.66375663756637},
-		{10.619689059240429, -75.48015480154801},
-		{11.083450116553408, -74.90774907749078},
-		{11.102068261190055, -74.27774277742778},
-		{11.310252969399897, -74.19854198541985},
-		{11.227317597836617, -73.41373413734136},
-		{11.731700061629567, -72.62892628926289},
-		{11.955117797269395, -72.23652236522365},
-		{12.437496999219007, -71.7541175411754},
-		{12.376564889499065, -71.40131401314012},
-		{12.112525747379266, -71.13851138511384},
-		{11.7757065853162, -71.33291332913329},
-		{11.60814328358633, -71.97371973719737},
-		{11.10883849560338, -72.22932229322292},
-		{10.82279609164027, -72.61452614526145},
-		{10.450433198907234, -72.90612906129061},
-		{9.736173468301118, -73.02853028530285},
-		{9.152240750151577, -73.30573305733057},
-		{9.084538406018297, -72.78732787327873},
-		{8.625855024515317, -72.66132661326613},
-		{8.405822406082166, -72.43812438124381},
-		{8.002993458489144, -72.36252362523625},
-		{7.632323124359431, -72.48132481324812},
-		{7.424138416149603, -72.44532445324452},
-		{7.341203044586322, -72.19692196921969},
-		{6.990843413696609, -71.95931959319593},
-		{7.087319254086523, -70.6741067410674},
-		{6.960377358836624, -70.09450094500944},
-		{6.1005575883439604, -69.38889388893888},
-		{6.2071887803538885, -68.98568985689856},
-		{6.1530269050472555, -68.26568265682657},
-		{6.268120890073831, -67.69687696876969},
-		{6.095479912533975, -67.34047340473404},
-		{5.557246276674391, -67.52047520475205},
-		{5.220427114611326, -67.74367743677436},
-		{4.504474825401886, -67.82287822878229},
-		{3.8392992942924025, -67.62127621276213},
-		{3.543101538709294, -67.33687336873368},
-		{3.3179912444661426, -67.30447304473044},
-		{2.82037901508653, -67.80847808478084},
-		{2.6003463966533644, -67.44847448474485},
-		{2.249986765763637, -67.1820718207182},
-		{1.2530697484010886, -66.8760687606876},
-		{1.129512970357851, -67.06327063270632},
-		{1.7202159229207297, -67.26127261272612},
-		{2.0367243817438094, -67.53847538475384},
-		{1.693134985267406, -67.86967869678696},
-		{1.7151382471107297, -69.81729817298172},
-		{1.0888915638778798, -69.80289802898028},
-		{0.9856454890746278, -69.21969219692197},
-		{0.6031272447215912, -69.2520925209252},
-		{0.7063733195248432, -69.45369453694536},
-		{0.5421951350016485, -70.01530015300153},
-		{-0.18560506443111535, -70.01890018900188},
-		{-0.5495051641475044, -69.5760957609576},
-		{-1.1232825306770593, -69.42129421294213},
-		{-1.5565775331300529, -69.44289442894429},
-		{-4.2985224705278995, -69.89289892898928},
-		{-3.767059069081654, -70.39330393303932},
-		{-3.7433632486350064, -70.69210692106921},
-		{-2.724442969429134, -70.04770047700477},
-		{-2.257296794909493, -70.81450814508145},
-		{-2.3436172836794356, -71.4121141211412},
-		{-2.169283747536241, -71.77571775717757},
-		{-2.435015448259364, -72.32652326523265},
-		{-2.309766111612788, -73.07173071730718},
-		{-1.2603797775469445, -73.65853658536585},
-		{-1.0031108698404836, -74.12294122941229},
-		{-0.5308870195108568, -74.44334443344432},
-		{-0.05697061057789199, -75.10575105751057},
-		{-0.1517538923644821, -75.37215372153722},
-	},
-	{
-		{8.224718635525633, -82.96462964629646},
-		{8.446443812562137, -83.50823508235082},
-		{8.656321079375303, -83.70983709837098},
-		{8.830654615518498, -83.59463594635946},
-		{9.050687233951663, -83.63423634236342},
-		{9.2910305556248, -83.91143911439114},
-		{9.487367353611319, -84.30384303843039},
-		{9.616001807464556, -84.64584645846458},
-		{9.908814445840989, -84.71424714247142},
-		{10.086533099190845, -84.9770497704977},
-		{9.795413019417737, -84.91224912249122},
-		{9.556762256347923, -85.11025110251101},
-		{9.834341867294384, -85.34065340653406},
-		{9.932510266287636, -85.6610566105661},
-		{10.135617298687478, -85.79785797857978},
-		{10.43858528868391, -85.79065790657906},
-		{10.75509374750699, -85.6610566105661},
-		{10.895576111583551, -85.94185941859418},
-		{11.088527792363394, -85.71145711457115},
-		{11.217162246216631, -85.56025560255603},
-		{10.953123104096832, -84.90144901449014},
-		{11.083450116553408, -84.67464674646746},
-		{10.998822186386803, -84.35424354243543},
-		{10.794022595383623, -84.18864188641886},
-		{10.726320251250343, -83.89343893438934},
-		{10.939582635270185, -83.65583655836558},
-		{10.396271323600601, -83.40383403834038},
-		{9.993442376007593, -83.0150301503015},
-		{9.566917607967923, -82.5470254702547},
-		{9.477212001991319, -82.93222932229322},
-		{9.07438305439831, -82.92862928629286},
-		{8.925437897305088, -82.71982719827199},
-		{8.80695879507185, -82.86742867428674},
-		{8.625855024515317, -82.83142831428314},
-		{8.42274799211549, -82.91422914229142},
-		{8.224718635525633, -82.96462964629646},
-	},
-	{
-		{23.188629247583933, -82.26982269822697},
-		{23.11754178624399, -81.40581405814058},
-		{23.105693876020666, -80.61740617406174},
-		{22.76548959675094, -79.67779677796777},
-		{22.399896938431226, -79.28179281792818},
-		{22.51160580625114, -78.34578345783457},
-		{22.278032718991312, -77.99297992979929},
-		{21.6585562701718, -77.1469714697147},
-		{21.20664312308216, -76.52416524165241},
-		{21.220183591908807, -76.19296192961929},
-		{21.017076559508965, -75.5989559895599},
-		{20.73441927275252, -75.6709567095671},
-		{20.69379786627256, -74.9329493294933},
-		{20.284198684266215, -74.1769417694177},
-		{20.050625597006388, -74.29574295742957},
-		{19.923683701756488, -74.96174961749617},
-		{19.87459950225987, -75.6349563495635},
-		{19.952457198013136, -76.32256322563225},
-		{19.855981357623207, -77.75537755377553},
-		{20.41283313811944, -77.08577085770857},
-		{20.673487163032576, -77.49257492574925},
-		{20.73949694856252, -78.1369813698137},
-		{21.02892446973229, -78.48258482584825},
-		{21.597624160451844, -78.72018720187201},
-		{21.55869531257521, -79.28539285392854},
-		{21.82781213050501, -80.21780217802177},
-		{22.037689397318175, -80.51660516605166},
-		{22.191712230221384, -81.81981819818198},
-		{22.386356469604564, -82.1690216902169},
-		{22.6368551428977, -81.79461794617946},
-		{22.68763190099766, -82.77742777427774},
-		{22.168016409774737, -83.49383493834938},
-		{22.154475940948075, -83.90783907839078},
-		{21.910747502068276, -84.05184051840519},
-		{21.800731192851686, -84.54864548645486},
-		{21.89551447463829, -84.97344973449734},
-		{22.205252699048046, -84.44784447844478},
-		{22.56576768155776, -84.23184231842318},
-		{22.78749285859425, -83.77823778237781},
-		{22.983829656580767, -83.2670326703267},
-		{23.078612938367357, -82.5110251102511},
-		{23.188629247583933, -82.26982269822697},
-	},
-	{
-		{35.13978554571126, 32.73332733327334},
-		{35.14486322152126, 32.80172801728017},
-		{35.38689910179774, 32.94572945729459},
-		{35.373358632971076, 33.66573665736658},
-		{35.67124894715751, 34.57654576545767},
-		{35.24641673772118, 33.89973899738999},
-		{35.05854273275132, 33.97533975339755},
-		{35.09408646342129, 33.86733867338674},
-		{35.01792132627136, 33.67653676536767},
-		{35.038232029511335, 33.52533525335255},
-		{35.00099574023804, 33.47493474934751},
-		{35.10085669783463, 33.45693456934569},
-		{35.16348136615791, 33.384933849338495},
-		{35.173636717777896, 33.19053190531906},
-		{35.08731622900797, 32.92052920529207},
-		{35.13978554571126, 32.73332733327334},
-	},
-	{
-		{35.05854273275132, 33.97533975339755},
-		{34.97729991979139, 34.00414004140043},
-		{34.57108585499171, 32.97812978129781},
-		{34.70141286744827, 32.488524885248864},
-		{35.10254925643795, 32.25812258122582},
-		{35.13978554571126, 32.73332733327334},
-		{35.08731622900797, 32.92052920529207},
-		{35.173636717777896, 33.19053190531906},
-		{35.16348136615791, 33.384933849338495},
-		{35.10085669783463, 33.45693456934569},
-		{35.00099574023804, 33.47493474934751},
-		{35.038232029511335, 33.52533525335255},
-		{35.01792132627136, 33.67653676536767},
-		{35.09408646342129, 33.86733867338674},
-		{35.05854273275132, 33.97533975339755},
-	},
-	{
-		{48.59731900080406, 16.961569615696163},
-		{48.785193005773934, 16.500765007650074},
-		{48.73441624767396, 16.02916029160292},
-		{49.03907679627373, 15.255152551525526},
-		{48.964604217727114, 14.902349023490245},
-		{48.55500503572078, 14.337143371433712},
-		{48.87659117035385, 13.595535955359566},
-		{49.30650105560018, 13.030330303303032},
-		{49.54684437727333, 12.522725227252266},
-		{49.968291469503, 12.414724147241486},
-		{50.26618178368945, 12.238322383223846},
-		{50.48452184351926, 12.965529655296564},
-		{50.733327958209074, 13.336333363333637},
-		{50.92627963898893, 14.056340563405655},
-		{51.11753876116545, 14.308343083430827},
-		{51.00244477613886, 14.57114571145712},
-		{51.10738340954545, 15.017550175501754},
-		{50.78410471630902, 15.49275492754927},
-		{50.69778422753909, 16.23796237962381},
-		{50.42189717519598, 16.176761767617677},
-		{50.215405025589476, 16.720367203672055},
-		{50.47436649189926, 16.86796867968681},
-		{50.36265762407935, 17.555575555755553},
-		{50.04953428246294, 17.649176491764933},
-		{49.98860217274297, 18.394383943839443},
-		{49.49606761917336, 18.85158851588517},
-		{49.49437506057005, 18.556385563855656},
-		{49.31496384861684, 18.40158401584017},
-		{49.27095732493022, 18.171181711817127},
-		{49.04415447208372, 18.10638106381066},
-		{48.99676283119042, 17.911979119791198},
-		{48.90367210800717, 17.886778867788678},
-		{48.80042603320392, 17.54477544775449},
-		{48.81735161923724, 17.10197101971019},
-		{48.59731900080406, 16.961569615696163},
-	},
-	{
-		{54.98334261117576, 9.923499234992363},
-		{54.597439249616045, 9.93789937899379},
-		{54.363866162356246, 10.949509495094958},
-		{54.008428855656504, 10.938709387093866},
-		{54.196302860626375, 11.95751957519576},
-		{54.470497354366145, 12.51912519125193},
-		{54.0761311997898, 13.645936459364606},
-		{53.75623762376004, 14.121141211412123},
-		{53.248470042760445, 14.351543515435168},
-		{52.98104578343397, 14.074340743407447},
-		{52.62560847673426, 14.437944379443792},
-		{52.090759958081335, 14.686346863468628},
-		{51.74547800300162, 14.607146071460733},
-		{51.10738340954545, 15.017550175501754},
-		{51.00244477613886, 14.57114571145712},
-		{51.11753876116545, 14.308343083430827},
-		{50.92627963898893, 14.056340563405655},
-		{50.733327958209074, 13.336333363333637},
-		{50.48452184351926, 12.965529655296564},
-		{50.26618178368945, 12.238322383223846},
-		{49.968291469503, 12.414724147241486},
-		{49.54684437727333, 12.522725227252266},
-		{49.30650105560018, 13.030330303303032},
-		{48.87659117035385, 13.595535955359566},
-		{48.416215230247545, 13.242732427324285},
-		{48.289273334997645, 12.882728827288275},
-		{47.637638272714824, 13.026730267302668},
-		{47.468382412381615, 12.933129331293316},
-		{47.67318200338481, 12.61992619926201},
-		{47.70364805824478, 12.14112141121413},
-		{47.52423684629157, 11.424714247142475},
-		{47.56655081137488, 10.542705427054273},
-		{47.30251166925508, 10.402304023040244},
-		{47.58009128020153, 9.894698946989479},
-		{47.52423684629157, 9.595895958959602},
-		{47.83058995349468, 8.523085230852303},
-		{47.61394245226818, 8.317883178831806},
-		{47.6207126866815, 7.468274682746824},
-		{48.33327985868429, 7.594275942759424},
-		{49.01707353443042, 8.098280982809825},
-		{49.20156242219359, 6.658266582665846},
-		{49.46390900571005, 6.186661866618664},
-		{49.902281683973044, 6.244262442624432},
-		{50.12739197821621, 6.042660426604272},
-		{50.80441541954902, 6.15786157861578},
-		{51.85210919501152, 5.988659886598867},
-		{51.85210919501152, 6.589865898658985},
-		{52.227857204951235, 6.841868418684186},
-		{53.143531409353855, 7.0902709027090225},
-		{53.482043130020244, 6.906669066690682},
-		{53.69361295543676, 7.101071010710115},
-		{53.74777483074338, 7.936279362793641},
-		{53.52774221231023, 8.123481234812346},
-		{54.02027676587984, 8.800288002880023},
-		{54.39602477581953, 8.573485734857343},
-		{54.96303190793576, 8.526685266852667},
-		{54.83101233687587, 9.282692826928269},
-		{54.98334261117576, 9.923499234992363},
-	},
-	{
-		{12.699843582735468, 43.079830798307995},
-		{12.390105358325712, 43.31743317433174},
-		{11.975428500509366, 43.28503285032852},
-		{11.73508517883623, 42.71622716227162},
-		{11.462583243699768, 43.14463144631446},
-		{10.926042166443523, 42.777427774277754},
-		{11.105453378396717, 42.55422554225544},
-		{11.034365917056775, 42.3130231302313},
-		{11.051291503090098, 41.75501755017552},
-		{11.355952051689854, 41.74061740617407},
-		{11.631839104032977, 41.66141661416614},
-		{12.100677837155942, 41.999819998199996},
-		{12.542435632625597, 42.35262352623528},
-		{12.45611514385567, 42.78102781027812},
-		{12.699843582735468, 43.079830798307995},
-	},
-	{
-		{55.60958929440859, 12.688326883268843},
-		{54.8005462820159, 12.09072090720909},
-		{55.364168296925456, 11.04311043110431},
-		{55.78053771334511, 10.902709027090282},
-		{56.11058664099487, 12.371523715237146},
-		{55.60958929440859, 12.688326883268843},
-	},
-	{
-		{54.98334261117576, 9.923499234992363},
-		{54.83101233687587, 9.282692826928269},
-		{54.96303190793576, 8.526685266852667},
-		{55.51819112982865, 8.119881198811981},
-		{56.5404965262412, 8.091080910809126},
-		{56.809613344170984, 8.256682566825674},
-		{57.10919621696074, 8.544685446854487},
-		{57.17182088528405, 9.423094230942326},
-		{57.44770793762716, 9.775897758977607},
-		{57.73036522438359, 10.578705787057885},
-		{57.21582740897067, 10.546305463054637},
-		{56.890856157130926, 10.251102511025124},
-		{56.609891428977804, 10.369903699036996},
-		{56.45925371328126, 10.913509135091346},
-		{56.081813144738234, 10.668706687066873},
-		{56.19013689535147, 10.369903699036996},
-		{55.470799488935356, 9.649896498965006},
-		{54.98334261117576, 9.923499234992363},
-	},
-	{
-		{19.713806434943322, -71.71091710917109},
-		{19.884754853879855, -71.58851588515884},
-		{19.879677178069855, -70.80730807308073},
-		{19.622408270363394, -70.21330213302133},
-		{19.64779664941338, -69.95049950499505},
-		{19.29405190131699, -69.77049770497705},
-		{19.312670045953638, -69.22329223292232},
-		{19.014779731767206, -69.25569255692557},
-		{18.979236001097235, -68.80928809288092},
-		{18.611950784174184, -68.31968319683196},
-		{18.2057367193745, -68.69048690486905},
-		{18.422384220601003, -69.16569165691656},
-		{18.380070255517694, -69.62289622896229},
-		{18.42746189641099, -69.9540995409954},
-		{18.24635812585447, -70.1341013410134},
-		{18.18373345753119, -70.51570515705157},
-		{18.42746189641099, -70.67050670506704},
-		{18.28359441512778, -71.00171001710017},
-		{17.59810818077831, -71.40131401314012},
-		{17.75720868949152, -71.65691656916569},
-		{18.044943652057967, -71.70731707317073},
-		{18.317445587194413, -71.68931689316892},
-		{18.617028459984184, -71.94491944919449},
-		{18.784591761714054, -71.70011700117001},
-		{19.170495123273753, -71.62451624516245},
-		{19.713806434943322, -71.71091710917109},
-	},
-	{
-		{23.47128653434038, 12.000720007200073},
-		{21.565465546988534, 8.573485734857343},
-		{19.600405008520084, 5.679056790567898},
-		{19.155262095843753, 4.267842678426803},
-		{19.0570936968505, 3.1590315903159194},
-		{19.693495731703337, 3.148231482314827},
-		{19.855981357623207, 2.683826838268402},
-		{20.142023761586316, 2.0610206102061},
-		{20.61086249470928, 1.8234182341823555},
-		{22.79257053440425, -1.5498154981549703},
-		{24.974278574099216, -4.923049230492296},
-		{27.39632993546732, -8.685086850868515},
-		{27.589281616247163, -8.66348663486633},
-		{27.656983960380444, -8.667086670866695},
-		{28.84177498271285, -8.674286742867423},
-		{29.579730533765613, -7.057870578705774},
-		{29.732060808065484, -6.0606606066060635},
-		{30.001177625995282, -5.243452434524329},
-		{30.50048241397822, -4.858248582485828},
-		{30.896541127157917, -3.6918369183691766},
-		{31.63788179541733, -3.6486364863648646},
-		{31.72420228418727, -3.069030690306903},
-		{32.09487261831697, -2.6154261542615416},
-		{32.26243592004684, -1.3086130861308618},
-		{32.6517243988132, -1.1250112501124931},
-		{32.863294224229705, -1.387813878138786},
-		{33.919450792708886, -1.733417334173339},
-		{34.527079331305075, -1.794617946179443},
-		{35.16855904196791, -2.1690216902169084},
-		{35.71525547084414, -1.2078120781207815},
-		{35.88789644838401, -0.1278012780127824},
-		{36.30088074759702, 0.5022050220502194},
-		{36.605541296196776, 1.4670146701467104},
-		{36.78325994954665, 3.1626316263162835},
-		{36.864502762506575, 4.815048150481516},
-		{36.71725016401669, 5.319053190531918},
-		{37.10992375998973, 6.262262622626224},
-		{37.11838655300639, 7.331473314733159},
-		{36.8865060243499, 7.738277382773845},
-		{36.94574557546652, 8.422284222842222},
-		{36.43290031865692, 8.217082170821726},
-		{35.47998982498099, 8.375483754837546},
-		{34.65571378515831, 8.141481414814166},
-		{34.09716944605874, 7.525875258752592},
-		{33.34398086757599, 7.612276122761244},
-		{32.74820023920313, 8.42948429484295},
-		{32.506164358926654, 8.440284402844043},
-		{32.10333541133363, 9.055890558905588},
-		{30.307530733198377, 9.480694806948065},
-		{29.424015142259066, 9.80469804698049},
-		{28.960254084946087, 9.858698586985867},
-		{28.14444083814007, 9.682296822968226},
-		{27.687450015240415, 9.757897578975786},
-		{27.140753586364184, 9.62829628296285},
-		{26.51281434452801, 9.714697146971474},
-		{26.094752369505002, 9.318693186931881},
-		{25.3652596114689, 9.909099090990907},
-		{24.937042284825907, 9.948699486994883},
-		{24.378497945726338, 10.305103051030528},
-		{24.562986833489532, 10.773107731077317},
-		{24.09753321757323, 11.561515615156168},
-		{23.47128653434038, 12.000720007200073},
-	},
-	{
-		{-3.404851527968603, -80.30420304203042},
-		{-2.6567406252958534, -79.77139771397714},
-		{-2.220060505636198, -79.98739987399874},
-		{-2.685514121552501, -80.36900369003689},
-		{-2.2471414432895074, -80.96660966609666},
-		{-1.9644841565330609, -80.7650076500765},
-		{-1.0572727451471025, -80.93420934209341},
-		{-0.9066350294505554, -80.5850058500585},
-		{-0.2837734634243816, -80.39780397803978},
-		{0.3610913644451159, -80.01980019800197},
-		{0.7689979878481381, -80.09180091800917},
-		{0.9822603718679659, -79.54099540995409},
-		{1.3817042022543262, -78.8569885698857},
-		{0.8096193943280952, -77.85617856178561},
-		{0.8265449803614189, -77.6689766897669},
-		{0.39494253651176336, -77.42417424174242},
-		{0.2561527310385401, -76.57816578165782},
-		{0.415253239751749, -76.29376293762937},
-		{0.0852043121020074, -75.80055800558006},
-		{-0.1517538923644821, -75.37215372153722},
-		{-0.9117127052605554, -75.23535235352354},
-		{-1.5616552089400386, -75.5449554495545},
-		{-2.6093489844025584, -76.63576635766357},
-		{-3.0037151389789187, -77.83817838178382},
-		{-3.8736902610915678, -78.45018450184502},
-		{-4.547328585217713, -78.6409864098641},
-		{-4.958620325827383, -79.20619206192062},
-		{-4.4542378620344465, -79.62379623796238},
-		{-4.3459141114211945, -80.03060030600305},
-		{-4.425464365777799, -80.4410044100441},
-		{-4.059871707458086, -80.46980469804697},
-		{-3.8212209443882728, -80.18540185401854},
-		{-3.404851527968603, -80.30420304203042},
-	},
-	{
-		{29.501872838012332, 34.92214922149222},
-		{29.099043890419324, 34.641346413464134},
-		{28.344162753333237, 34.425344253442546},
-		{27.822854703506977, 34.15534155341555},
-		{27.648521167363782, 33.921339213392145},
-		{27.9717998606002, 33.586535865358655},
-		{28.416942773276517, 33.13653136531366},
-		{29.850539910298735, 32.42372423724237},
-		{29.76083430432213, 32.31932319323195},
-		{28.704677735842964, 32.73332733327334},
-		{27.69929792546374, 33.34893348933491},
-		{26.142144010398297, 34.10494104941051},
-		{25.598832698728728, 34.47214472144722},
-		{25.033518125215835, 34.79614796147962},
-		{23.926584798636696, 35.69255692556925},
-		{23.752251262493502, 35.49455494554945},
-		{23.102308758814004, 35.5269552695527},
-		{22.205252699048046, 36.68976689766899},
-		{22.000453108044866, 36.86616866168663},
-		{22.000453108044866, 32.898928989289914},
-		{22.000453108044866, 29.021690216902186},
-		{22.000453108044866, 25.00045000450004},
-		{25.681768070291994, 25.00045000450004},
-		{29.237833695892547, 25.00045000450004},
-		{30.043491591078578, 24.70164701647016},
-		{30.661275481294766, 24.957249572495726},
-		{31.08949280793776, 24.802448024480242},
-		{31.568486892680724, 25.166051660516615},
-		{31.585412478714034, 26.49446494464945},
-		{31.32137333659425, 27.459274592745942},
-		{31.02517558101114, 28.449284492844924},
-		{30.869460189504593, 28.913689136891378},
-		{31.18766120693101, 29.684096840968408},
-		{31.473703610894134, 30.094500945009457},
-		{31.5566389824574, 30.97650976509766},
-		{31.4296970872075, 31.68931689316895},
-		{30.933777416431212, 31.959319593195943},
-		{31.260441226874292, 32.19332193321935},
-		{31.023483022407817, 32.992529925299266},
-		{30.96762858849786, 33.77373773737739},
-		{31.21981982039432, 34.2669426694267},
-		{29.501872838012332, 34.92214922149222},
-	},
-	{
-		{12.542435632625597, 42.35262352623528},
-		{12.865714325862015, 42.01062010620106},
-		{13.45133960261488, 41.60021600216004},
-		{13.772925737247974, 41.15381153811538},
-		{14.118207692327701, 40.89820898208984},
-		{14.519344081317385, 40.0270002700027},
-		{14.531191991540709, 39.33939339393396},
-		{14.741069258353875, 39.09819098190982},
-		{14.505803612490723, 38.511385113851134},
-		{14.959409318183702, 37.90657906579065},
-		{14.212990974114291, 37.59337593375935},
-		{14.422868240927457, 36.43056430564306},
-		{14.822312071313817, 36.32256322563225},
-		{16.291452939005993, 36.75456754567546},
-		{16.956628470115476, 36.85176851768517},
-		{17.26298157731857, 37.16857168571687},
-		{17.42715976184178, 37.902979029790316},
-		{17.997552011164657, 38.41058410584105},
-		{16.839841926485576, 38.990189901899015},
-		{15.922475163479618, 39.267392673926736},
-		{15.435018285720005, 39.81459814598148},
-		{14.490570585060738, 41.1790117901179},
-		{13.921870894341183, 41.73341733417334},
-		{13.344708410604966, 42.27702277022772},
-		{13.001119014128577, 42.59022590225902},
-		{12.699843582735468, 43.079830798307995},
-		{12.45611514385567, 42.78102781027812},
-		{12.542435632625597, 42.35262352623528},
-	},
-	{
-		{41.881246462782656, -9.034290342903432},
-		{42.592121076182096, -8.983889838898392},
-		{43.02710863723844, -9.394293942939413},
-		{43.74813860225787, -7.979479794797953},
-		{43.56872739030466, -6.755467554675533},
-		{43.57380506611467, -5.41265412654127},
-		{43.402856647178126, -4.3470434704346985},
-		{43.455325963881435, -3.5190351903519},
-		{43.423167350418126, -1.9026190261902514},
-		{43.033878871651766, -1.5030150301502943},
-		{42.580273165958786, 0.33660336603367114},
-		{42.79522810858195, 0.700207002070016},
-		{42.3433149614923, 1.8270182701827196},
-		{42.47364197394886, 2.986229862298643},
-		{41.891401814402656, 3.0402304023040188},
-		{41.22622628329317, 2.093420934209348},
-		{41.01465645787667, 0.8118081180811885},
-		{40.6778372958136, 0.7218072180722004},
-		{40.124370632524034, 0.1062010620106264},
-		{39.31024994432133, -0.27900279002790285},
-		{38.73816513639511, 0.10980109801099047},
-		{38.29302222371879, -0.46620466204660715},
-		{37.64307972003931, -0.682206822068224},
-		{37.44335780484613, -1.4382143821438262},
-		{36.674936198933395, -2.147421474214724},
-		{36.65970317150341, -3.414634146341456},
-		{36.67832131614006, -4.3686436864368545},
-		{36.32457656804367, -4.995049950499492},
-		{35.94713599950063, -5.3766537665376575},
-		{36.0300713710639, -5.866258662586631},
-		{36.36689053312696, -6.237062370623704},
-		{36.942360458259856, -6.521465214652153},
-		{37.0980758497664, -7.453874538745367},
-		{37.428124777416144, -7.536675366753656},
-		{37.80387278735584, -7.165871658716583},
-		{38.076374722492304, -7.02907029070289},
-		{38.3725724780754, -7.3746737467374714},
-		{39.02928521616822, -7.097470974709751},
-		{39.63014352035108, -7.497074970749708},
-		{39.711386333311026, -7.065070650706502},
-		{40.18530274224398, -7.0254702547025545},
-		{40.33086278213054, -6.863468634686342},
-		{41.1111322982666, -6.85266852668525},
-		{41.38194167479972, -6.3882638826388245},
-		{41.882939021385994, -6.669066690666909},
-		{41.918482752055965, -7.252272522725235},
-		{41.791540856806066, -7.4214742147421475},
-		{41.791540856806066, -8.011880118801173},
-		{42.280690293169016, -8.263882638826374},
-		{42.135130253282455, -8.670686706867059},
-		{41.881246462782656, -9.034290342903432},
-	},
-	{
-		{57.79298989270687, 24.312843128431297},
-		{58.38369284526975, 24.428044280442805},
-		{58.25675095001985, 24.060840608406096},
-		{58.61218825671959, 23.42723427234273},
-		{59.187658181852456, 23.340833408334078},
-		{59.4652377927989, 24.604446044460445},
-		{59.61079783268546, 25.86445864458645},
-		{59.44661964816227, 26.948069480694812},
-		{59.4753931444189, 27.981279812798135},
-		{59.30105960827571, 28.132481324813256},
-		{58.72389712453948, 27.419674196741965},
-		{57.79129733410356, 27.718477184771842},
-		{57.47478887528045, 27.286472864728665},
-		{57.47648143388379, 26.462064620646203},
-		{57.84715176801352, 25.601656016560185},
-		{57.97070854605674, 25.166051660516615},
-		{57.79298989270687, 24.312843128431297},
-	},
-	{
-		{12.542435632625597, 42.35262352623528},
-		{12.100677837155942, 41.999819998199996},
-		{11.631839104032977, 41.66141661416614},
-		{11.355952051689854, 41.74061740617407},
-		{11.051291503090098, 41.75501755017552},
-		{11.034365917056775, 42.3130231302313},
-		{11.105453378396717, 42.55422554225544},
-		{10.926042166443523, 42.777427774277754},
-		{10.572297418347134, 42.5578255782558},
-		{10.022215872264226, 42.928629286292875},
-		{9.539836670314614, 43.295832958329584},
-		{9.184399363614887, 43.67743677436775},
-		{7.99622322407582, 46.949869498694994},
-		{8.002993458489144, 47.788677886778885},
-		{5.002087054781498, 44.96264962649627},
-		{4.958080531094865, 43.65943659436596},
-		{4.25228359350541, 42.770227702277026},
-		{4.233665448868763, 42.12942129421296},
-		{3.918849548649007, 41.855818558185604},
-		{3.918849548649007, 41.1718117181172},
-		{4.25736126931541, 40.76860768607688},
-		{3.8392992942924025, 39.854198541985426},
-		{3.4212373192693946, 39.55899558995591},
-		{3.500787573625999, 38.8929889298893},
-		{3.6158815586525748, 38.66978669786698},
-		{3.5888006209992653, 38.43578435784357},
-		{3.598955972619251, 38.12258122581227},
-		{4.448620391491929, 36.85536855368554},
-		{4.448620391491929, 36.16056160561607},
-		{4.776976760538332, 35.81855818558185},
-		{5.338906216844563, 35.81855818558185},
-		{5.506469518574434, 35.296552965529656},
-		{6.594784700516911, 34.70614706147063},
-		{6.8266652291734005, 34.248942489424905},
-		{7.226109059559747, 34.07614076140763},
-		{7.713565937319373, 33.56853568535686},
-		{7.784653398659316, 32.95292952929529},
-		{8.355045647982209, 33.29493294932951},
-		{8.378741468428856, 33.82413824138243},
-		{8.68509457563195, 33.97533975339755},
-		{9.583843194001247, 33.96093960939609},
-		{10.629844410860429, 34.256142561425634},
-		{10.910809139013537, 34.73134731347315},
-		{11.31871576241656, 34.83214832148323},
-		{12.082059692519294, 35.26055260552607},
-		{12.577979363295569, 35.865358653586554},
-		{13.563048470434794, 36.268562685626875},
-		{14.422868240927457, 36.43056430564306},
-		{14.212990974114291, 37.59337593375935},
-		{14.959409318183702, 37.90657906579065},
-		{14.505803612490723, 38.511385113851134},
-		{14.741069258353875, 39.09819098190982},
-		{14.531191991540709, 39.33939339393396},
-		{14.519344081317385, 40.0270002700027},
-		{14.118207692327701, 40.89820898208984},
-		{13.772925737247974, 41.15381153811538},
-		{13.45133960261488, 41.60021600216004},
-		{12.865714325862015, 42.01062010620106},
-		{12.542435632625597, 42.35262352623528},
-	},
-	{
-		{69.06543019089808, 28.593285932859345},
-		{68.36471092911863, 28.44568445684459},
-		{67.6978428394058, 29.975699756997585},
-		{66.94465426092306, 29.054090540905406},
-		{65.80556232088061, 30.216902169021694},
-		{64.94912766759462, 29.54369543695438},
-		{64.20440188212855, 30.443704437044374},
-		{63.5527668198457, 30.03690036900369},
-		{62.86728058549626, 31.516515165151645},
-		{62.357820445893324, 31.138511385113873},
-		{61.78065796215709, 30.209702097020966},
-		{60.502776216641436, 28.071280712807123},
-		{60.42322596228483, 26.256862568625706},
-		{60.05763330396512, 24.496444964449665},
-		{59.8460634785486, 22.869228692286924},
-		{60.39275990742486, 22.289622896228963},
-		{60.71942371786794, 21.321213212132136},
-		{61.704492825007165, 21.544415444154453},
-		{62.60662656058311, 21.058410584105843},
-		{63.190559278732664, 21.537215372153725},
-		{63.81849852056884, 22.444424444244447},
-		{64.90173602670133, 24.730447304473046},
-		{65.11161329351451, 25.39645396453966},
-		{65.53475294434749, 25.29565295652958},
-		{66.00697679467713, 23.902439024390247},
-		{66.39626527344349, 23.56763567635676},
-		{67.93649360247561, 23.538835388353903},
-		{68.61690216101509, 21.980019800198022},
-		{69.10605159737803, 20.644406444064458},
-		{69.37009073949783, 21.245612456124576},
-		{68.84201245525826, 22.358023580235823},
-		{68.89109665475486, 23.66123661236614},
-		{68.6490607744784, 24.73404734047341},
-		{69.09251112855138, 25.68805688056881},
-		{69.82538900379414, 26.181261812618146},
-		{70.16390072446053, 27.7328773287733},
-		{69.76614945267751, 29.01449014490146},
-		{69.06543019089808, 28.593285932859345},
-	},
-	{
-		{-17.339686509201044, 178.37278372783732},
-		{-17.629114030370815, 178.71838718387187},
-		{-18.150422080197075, 178.5527855278553},
-		{-18.287519327066974, 177.93357933579335},
-		{-18.163962549023736, 177.38277382773828},
-		{-17.723897312157405, 177.28557285572856},
-		{-17.380307915681016, 177.67077670776712},
-		{-17.505557252327577, 178.12438124381248},
-		{-17.339686509201044, 178.37278372783732},
-	},
-	{
-		{-16.066882439495373, -180},
-		{-16.021183357205402, -179.79479794797948},
-		{-16.50187000055169, -179.9171991719917},
-		{-16.556031875858324, -180},
-		{-16.80145287334146, 179.3627936279363},
-		{-17.011330140154627, 178.7255872558726},
-		{-16.63896724742159, 178.5959859598596},
-		{-16.43416765641841, 179.09639096390964},
-		{-16.378313222508453, 179.41319413194134},
-		{-16.066882439495373, -180},
-	},
-	{
-		{-51.84926387253739, -61.19881198811987},
-		{-51.250098126957866, -60},
-		{-51.500596800251, -59.15039150391503},
-		{-51.09946041126131, -58.549185491854914},
-		{-51.54968099974763, -57.749977499775},
-		{-51.900040630637356, -58.048780487804876},
-		{-52.19962350342712, -59.39879398793987},
-		{-51.84926387253739, -59.84879848798488},
-		{-52.29948446102371, -60.698406984069834},
-		{-51.84926387253739, -61.19881198811987},
-	},
-	{
-		{2.312611434086932, -54.52434524345243},
-		{2.7391362021265877, -54.27234272342723},
-		{3.194434466422905, -54.18594185941859},
-		{3.6226517930658986, -54.013140131401315},
-		{4.213354745628777, -54.39834398343983},
-		{4.897148421374908, -54.477544775447754},
-		{5.756968191867571, -53.95913959139591},
-		{5.646951882650981, -53.61713617136171},
-		{5.409993678184506, -52.882728827288275},
-		{4.565406935121828, -51.82431824318243},
-		{4.155807753115482, -51.658716587165856},
-		{3.2418261073162, -52.24912249122491},
-		{2.5038705562634505, -52.555125551255514},
-		{2.1247374291170757, -52.94032940329403},
-		{2.053649967777133, -53.41913419134191},
-		{2.3346146959302416, -53.55593555935559},
-		{2.3769286610135367, -53.779137791377906},
-		{2.106119284480428, -54.088740887408875},
-		{2.312611434086932, -54.52434524345243},
-	},
-	{
-		{42.15205583931578, 9.55989559895599},
-		{41.38024911619638, 9.228692286922865},
-		{41.58335614859622, 8.775087750877503},
-		{42.25699447272237, 8.544685446854487},
-		{42.62766480685208, 8.746287462874648},
-		{43.01018305120512, 9.390693906939077},
-		{42.15205583931578, 9.55989559895599},
-	},
-	{
-		{49.52991879124001, 5.675456754567563},
-		{49.44190574386674, 5.898658986589879},
-		{49.46390900571005, 6.186661866618664},
-		{49.20156242219359, 6.658266582665846},
-		{49.01707353443042, 8.098280982809825},
-		{48.33327985868429, 7.594275942759424},
-		{47.6207126866815, 7.468274682746824},
-		{47.44976426774498, 7.191071910719103},
-		{47.541162432324896, 6.7374673746737415},
-		{47.2872786418251, 6.76986769867699},
-		{46.72534918551888, 6.0390603906039075},
-		{46.27343603842921, 6.021060210602116},
-		{46.42915142993577, 6.499864998649997},
-		{45.99077875167278, 6.841868418684186},
-		{45.708121464916346, 6.802268022680238},
-		{45.33237345497663, 7.097470974709751},
-		{45.02771290637686, 6.748267482674834},
-		{44.25421362465413, 7.0074700747007626},
-		{44.12727172940423, 7.551075510755112},
-		{43.69397672695125, 7.435874358743604},
-		{43.128662153438356, 6.528665286652881},
-		{43.39947152997148, 4.555845558455587},
-		{43.07450027813174, 3.101431014310151},
-		{42.47364197394886, 2.986229862298643},
-		{42.3433149614923, 1.8270182701827196},
-		{42.79522810858195, 0.700207002070016},
-		{42.580273165958786, 0.33660336603367114},
-		{43.033878871651766, -1.5030150301502943},
-		{43.423167350418126, -1.9026190261902514},
-		{44.02233309599764, -1.384213842138422},
-		{46.014474572119425, -1.1934119341193252},
-		{47.06386090618527, -2.2266222662226482},
-		{47.56993592858156, -2.9646296462964585},
-		{47.954146731537904, -4.491044910449091},
-		{48.68363948957402, -4.591845918459171},
-		{48.90197954940383, -3.2958329583295836},
-		{48.64471064169736, -1.6182161821618024},
-		{49.77703234732648, -1.9350193501934996},
-		{49.34712246208015, -0.9882098820988006},
-		{50.12739197821621, 1.337413374133746},
-		{50.9465903422289, 1.6398163981639868},
-		{51.14800481602542, 2.5146251462514613},
-		{50.79764518513569, 2.658626586265882},
-		{50.78071959910237, 3.123031230312307},
-		{50.37958321011267, 3.5874358743587607},
-		{49.90735935978306, 4.285842858428595},
-		{49.985217055536324, 4.80064800648006},
-		{49.52991879124001, 5.675456754567563},
-	},
-	{
-		{-3.978628894498158, 11.09351093510935},
-		{-2.9698639669122713, 10.067500675006755},
-		{-2.1438953684862554, 9.405094050940505},
-		{-1.1114346204537355, 8.796687966879688},
-		{-0.7796931342006559, 8.829088290882908},
-		{-0.4597995581709, 9.048690486904889},
-		{0.26800064126186385, 9.289892898928997},
-		{1.0093413095212753, 9.491494914949158},
-		{1.0685808606378941, 9.829898298982982},
-		{1.0584255090179084, 11.284312843128447},
-		{2.261834675986961, 11.277112771127719},
-		{2.3261519029135798, 11.752317523175236},
-		{2.1924397732503564, 12.360723607236082},
-		{2.321074227103594, 12.951129511295107},
-		{2.266912351796961, 13.077130771307708},
-		{1.8302322321373055, 13.001530015300148},
-		{1.3140018581210455, 13.282332823328233},
-		{1.3952446710809738, 14.027540275402771},
-		{1.1972153144911317, 14.275942759427608},
-		{0.03950522981203619, 13.843938439384402},
-		{-0.5528902813541663, 14.315543155431556},
-		{-1.3331597974902252, 14.427144271442728},
-		{-1.9983353285997083, 14.297542975429764},
-		{-2.470559178929335, 13.991539915399159},
-		{-2.42824521384604, 13.109531095310956},
-		{-1.9492511291030752, 12.57672576725767},
-		{-2.3910089245727306, 12.49392493924941},
-		{-2.5145657026159682, 11.820718207182068},
-		{-2.7650643759091054, 11.47871478714788},
-		{-3.4268547898119124, 11.85671856718568},
-		{-3.978628894498158, 11.09351093510935},
-	},
-	{
-		{53.86794649157996, -6.197461974619728},
-		{54.07443864118646, -6.95346953469533},
-		{54.059205613756475, -7.572675726757268},
-		{54.59574669101271, -7.367473674736743},
-		{55.13228776826897, -7.572675726757268},
-		{55.17290917474894, -6.733867338673377},
-		{54.555125284532735, -5.661056610566106},
-		{53.86794649157996, -6.197461974619728},
-	},
-	{
-		{58.6341915185629, -3.0042300423004065},
-		{57.55264657103375, -4.073440734407342},
-		{57.68974381790362, -3.0546305463054466},
-		{57.68466614209363, -1.9602196021960197},
-		{56.87054545389093, -2.2194221942219485},
-		{55.97348939412497, -3.119431194311943},
-		{55.90917216719835, -2.08622086220862},
-		{55.80423353379176, -2.0070200702006957},
-		{54.62452018726937, -1.114211142111401},
-		{54.46372711995282, -0.4302043020430233},
-		{53.32463517991037, 0.1854018540185507},
-		{52.93026902533403, 0.46980469804699965},
-		{52.73900990315751, 1.6830168301682988},
-		{52.099222751098, 1.5606156061560625},
-		{51.806410112721565, 1.0494104941049613},
-		{51.290179738705305, 1.4490144901449185},
-		{50.76548657167238, 0.5490054900548955},
-		{50.77564192329238, -0.7866078660786684},
-		{50.499754870949246, -2.489424894248941},
-		{50.69609166893578, -2.9574295742957304},
-		{50.22894549441612, -3.6162361623616164},
-		{50.342346920839375, -4.541445414454131},
-		{49.95982867648634, -5.243452434524329},
-		{50.15955059167952, -5.776257762577615},
-		{51.2106294843487, -4.311043110431086},
-		{51.42558442697187, -3.414634146341456},
-		{51.427276985575205, -3.421834218342184},
-		{51.59314772870174, -4.9842498424984285},
-		{51.99089900048476, -5.268652686526849},
-		{52.300637224894515, -4.221042210422098},
-		{52.84056341935742, -4.768247682476812},
-		{53.49558359884692, -4.581045810458107},
-		{53.40418543426698, -3.094230942309423},
-		{53.40418543426698, -3.090630906309059},
-		{53.98473303520986, -2.9466294662946666},
-		{54.60082436682272, -3.6162361623616164},
-		{54.61436483564937, -3.6306363063630442},
-		{54.7903909303959, -4.843848438484372},
-		{55.061200306929024, -5.081450814508145},
-		{55.50803577820868, -4.7178471784717715},
-		{55.78392283055179, -5.0490504905048965},
-		{55.31169898022215, -5.585455854558546},
-		{56.27476482551806, -5.64665646656465},
-		{56.784224965121, -6.150661506615052},
-		{57.818378271756856, -5.787057870578707},
-		{58.630806401356224, -5.009450094500949},
-		{58.55125614699962, -4.210242102421006},
-		{58.6341915185629, -3.0042300423004065},
-	},
-	{
-		{41.53596450770293, 41.55341553415536},
-		{41.9624892757426, 41.70461704617048},
-		{42.644590392885405, 41.45261452614528},
-		{43.013568168411766, 40.87660876608766},
-		{43.128662153438356, 40.322203222032215},
-		{43.435015260641435, 39.954999549995506},
-		{43.55349436287467, 40.07740077400774},
-		{43.382545943938155, 40.92340923409236},
-		{43.22006031801827, 42.39582395823959},
-		{42.741066233275305, 43.75663756637567},
-		{42.5548847869088, 43.92943929439295},
-		{42.71229273701867, 44.537845378453795},
-		{42.50241547020549, 45.47025470254704},
-		{42.09281628819916, 45.77625776257764},
-		{41.86093575954267, 46.406264062640645},
-		{41.72214595406945, 46.147061470614716},
-		{41.18221975960654, 46.63666636666366},
-		{41.0637406573733, 46.49986499865},
-		{41.124672767093244, 45.96345963459635},
-		{41.41071517105635, 45.21825218252184},
-		{41.24822954513648, 44.973449734497365},
-		{41.092514153629935, 43.5838358383584},
-		{41.58335614859622, 42.619026190261906},
-		{41.53596450770293, 41.55341553415536},
-	},
-	{
-		{5.929609169407428, 1.0602106021060251},
-		{5.343983892654563, -0.5094050940509476},
-		{5.00039449617816, -1.0638106381063608},
-		{4.71096697500839, -1.9638196381963837},
-		{4.99531682036816, -2.85662856628565},
-		{5.38968297494452, -2.809828098280974},
-		{6.2511953040405075, -3.2454324543245434},
-		{7.38013189246297, -2.9826298262982505},
-		{8.219640959715647, -2.5614256142561374},
-		{9.643082745117866, -2.827828278282766},
-		{10.394578764997277, -2.9646296462964585},
-		{10.963278455716832, -2.9394293942939385},
-		{11.00897753800679, -1.2042120421204174},
-		{10.936197518063523, -0.7614076140761483},
-		{11.098683143983394, -0.437404374043723},
-		{11.019132889626789, 0.02340023400233804},
-		{10.707702106613695, -0.04860048600485811},
-		{10.191471732597435, 0.36900369003691935},
-		{9.465364091767995, 0.3654036540365553},
-		{8.676631782615289, 0.4626046260462715},
-		{8.3127316828989, 0.7110071100711082},
-		{7.412290505926279, 0.49140491404915565},
-		{6.914678276546667, 0.5706057060570799},
-		{6.279968800297155, 0.8370083700837085},
-		{5.929609169407428, 1.0602106021060251},
-	},
-	{
-		{7.686484999666064, -8.440284402844014},
-		{7.711873378716035, -8.7210872108721},
-		{7.309044431123027, -8.926289262892624},
-		{7.314122106933013, -9.207092070920709},
-		{7.527384490952855, -9.405094050940505},
-		{7.928520879942539, -9.336693366933673},
-		{8.541227094348727, -9.754297542975422},
-		{8.427825667925475, -10.017100171001715},
-		{8.405822406082166, -10.22950229502294},
-		{8.34827541356887, -10.50670506705066},
-		{8.715560630491922, -10.495904959049597},
-		{8.977907214008383, -10.654306543065417},
-		{9.267334735178153, -10.621906219062197},
-		{9.688781827407823, -10.837908379083785},
-		{10.045911692710874, -11.11871118711187},
-		{10.047604251314212, -11.917919179191784},
-		{9.858037687741032, -12.151921519215193},
-		{9.836034425897708, -12.42552425524255},
-		{9.619386924671218, -12.598325983259826},
-		{9.343499872328096, -12.713527135271335},
-		{8.903434635461778, -13.24633246332462},
-		{9.494137588024643, -13.685536855368554},
-		{9.886811183997665, -14.074340743407419},
-		{10.015445637850902, -14.329943299432983},
-		{10.215167553044083, -14.57834578345782},
-		{10.656925348513738, -14.693546935469357},
-		{10.876957966946904, -14.841148411484113},
-		{11.041136151470099, -15.129151291512898},
-		{11.528593029229725, -14.686346863468628},
-		{11.509974884593063, -14.383943839438388},
-		{11.677538186322934, -14.121141211412095},
-		{11.679230744926272, -13.901539015390142},
-		{11.811250315986172, -13.743137431374322},
-		{12.142991802239237, -13.829538295382946},
-		{12.247930435645827, -13.717937179371802},
-		{12.58644215631223, -13.699936999369982},
-		{12.57628680469223, -13.217532175321736},
-		{12.332558365812432, -12.497524975249746},
-		{12.354561627655741, -12.277922779227794},
-		{12.466270495475655, -12.202322023220233},
-		{12.38672024111905, -11.658716587165856},
-		{12.442574675029007, -11.514715147151463},
-		{12.076982016709294, -11.457114571145695},
-		{12.078674575312618, -11.298712987129875},
-		{12.210694146372518, -11.035910359103582},
-		{12.178535532909208, -10.870308703087034},
-		{11.92465174240941, -10.593105931059313},
-		{11.843408929449481, -10.164701647016471},
-		{12.06005643067597, -9.891098910989115},
-		{12.193768560339208, -9.56709567095669},
-		{12.334250924415755, -9.325893258932581},
-		{12.308862545365784, -9.127891278912784},
-		{12.088829926932618, -8.904689046890468},
-		{11.812942874589496, -8.785887858878596},
-		{11.393188340963164, -8.375483754837546},
-		{11.135919433256689, -8.580685806858071},
-		{10.810948181416947, -8.620286202862019},
-		{10.909116580410199, -8.407884078840794},
-		{10.7923300367803, -8.281882818828194},
-		{10.494439722593867, -8.33588335883357},
-		{10.20670476002742, -8.029880298802993},
-		{10.128847064274154, -8.22788227882279},
-		{9.790335343607751, -8.31068310683105},
-		{9.375658485791405, -8.080280802808034},
-		{8.57507826641536, -7.831878318783168},
-		{8.454906605578785, -8.20268202682027},
-		{8.316116800105561, -8.299882998829986},
-		{8.12316511932572, -8.220682206822062},
-		{7.686484999666064, -8.281882818828194},
-		{7.686484999666064, -8.440284402844014},
-	},
-	{
-		{13.151756729825124, -16.842768427684263},
-		{13.595207083898103, -16.713167131671298},
-		{13.62398058015475, -15.6259562595626},
-		{13.860938784621226, -15.399153991539919},
-		{13.876171812051226, -15.082350823508222},
-		{13.630750814568074, -14.686346863468628},
-		{13.625673138758088, -14.37674376743766},
-		{13.793236440487945, -14.045540455404534},
-		{13.505501477921513, -13.843938439384374},
-		{13.280391183678347, -14.27594275942758},
-		{13.299009328315009, -14.711547115471149},
-		{13.508886595128175, -15.13995139951399},
-		{13.278698625075023, -15.510755107551063},
-		{13.270235832058361, -15.690756907569067},
-		{13.1297534679818, -15.931959319593176},
-		{13.151756729825124, -16.842768427684263},
-	},
-	{
-		{11.041136151470099, -15.129151291512898},
-		{11.459198126493106, -15.665556655566547},
-		{11.525207912023063, -16.08676086760866},
-		{11.806172640176172, -16.31356313563134},
-		{11.958502914476057, -16.309963099630977},
-		{12.170072739892547, -16.612366123661218},
-		{12.385027682515712, -16.677166771667714},
-		{12.547513308435597, -16.147961479614793},
-		{12.515354694972288, -15.816758167581668},
-		{12.628756121395526, -15.546755467554675},
-		{12.58644215631223, -13.699936999369982},
-		{12.247930435645827, -13.717937179371802},
-		{12.142991802239237, -13.829538295382946},
-		{11.811250315986172, -13.743137431374322},
-		{11.679230744926272, -13.901539015390142},
-		{11.677538186322934, -14.121141211412095},
-		{11.509974884593063, -14.383943839438388},
-		{11.528593029229725, -14.686346863468628},
-		{11.041136151470099, -15.129151291512898},
-	},
-	{
-		{1.0093413095212753, 9.491494914949158},
-		{1.1616715838211604, 9.304293042930425},
-		{2.2838379378302847, 9.649896498965006},
-		{2.261834675986961, 11.277112771127719},
-		{1.0584255090179084, 11.284312843128447},
-		{1.0685808606378941, 9.829898298982982},
-		{1.0093413095212753, 9.491494914949158},
-	},
-	{
-		{35.705100119224156, 23.700837008370087},
-		{35.368280957161076, 24.2480424804248},
-		{35.42582794967437, 25.02565025650256},
-		{35.35474048833443, 25.770857708577097},
-		{35.180406952191234, 25.745657456574577},
-		{35.30057861302781, 26.289262892628926},
-		{35.0043808574447, 26.163261632616326},
-		{34.9197529272781, 24.723247232472346},
-		{35.085623670404644, 24.73404734047341},
-		{35.280267909787824, 23.513635136351382},
-		{35.705100119224156, 23.700837008370087},
-	},
-	{
-		{40.82339733570015, 26.055260552605546},
-		{40.8521708319568, 25.4468544685447},
-		{40.94695411374339, 24.924849248492478},
-		{40.686300088830265, 23.715237152371543},
-		{40.124370632524034, 24.40644406444065},
-		{39.96188500660416, 23.898838988389883},
-		{39.960192448000825, 23.34443344433444},
-		{40.476422822017085, 22.81522815228152},
-		{40.25639020358393, 22.628026280262816},
-		{39.65891701660773, 22.851228512285132},
-		{39.190078283484766, 23.35163351633517},
-		{38.97173822365494, 22.97362973629737},
-		{38.5096697249453, 23.531635316353174},
-		{38.22024220377553, 24.024840248402484},
-		{37.654927630262634, 24.03924039240394},
-		{37.92065933098576, 23.114031140311397},
-		{37.40950663277948, 23.409234092340938},
-		{37.30456799937291, 22.775627756277572},
-		{36.42274496703692, 23.153631536315373},
-		{36.40920449821027, 22.491224912249123},
-		{36.84419205926659, 21.670416704167053},
-		{37.644772278642634, 21.296012960129616},
-		{38.30994780975212, 21.119611196111975},
-		{38.77032374985842, 20.730807308073082},
-		{39.34071599918131, 20.216002160021617},
-		{39.62506584454108, 20.15120151201512},
-		{40.11083016369737, 20.615606156061574},
-		{40.43580141553713, 20.673206732067314},
-		{40.57966889682034, 21.000810008100075},
-		{40.8420154803368, 21.018810188101895},
-		{40.9317210863134, 21.674016740167417},
-		{41.15006114614323, 22.055620556205582},
-		{41.129750442903244, 22.595625956259568},
-		{41.30408397904644, 22.761227612276116},
-		{41.337935151113086, 22.952029520295213},
-		{41.30916165485644, 23.69363693636936},
-		{41.58335614859622, 24.4928449284493},
-		{41.234689076309834, 25.198451984519863},
-		{41.329472358096425, 26.105661056610586},
-		{41.82708458747604, 26.11646116461165},
-		{41.561352886752914, 26.60246602466026},
-		{40.9367987621234, 26.29286292862929},
-		{40.82339733570015, 26.055260552605546},
-	},
-	{
-		{82.62790227939747, -46.76266762667626},
-		{83.22537546637368, -43.40743407434073},
-		{83.17967638408369, -39.89739897398974},
-		{83.54865415961008, -38.622986229862306},
-		{83.64513000000001, -35.08775087750877},
-		{83.51988066335345, -27.099270992709933},
-		{82.72607067839071, -20.84600846008459},
-		{82.34185987543437, -22.692826928269284},
-		{82.29785335174772, -26.516065160651607},
-		{82.19968495275448, -31.901719017190175},
-		{82.02196629940461, -31.397713977139773},
-		{82.13198260862119, -27.855278552785535},
-		{81.78670065354144, -24.845648456484554},
-		{82.09305376074455, -22.901629016290144},
-		{81.73423133683816, -22.07002070020701},
-		{81.15199117729195, -23.1680316803168},
-		{81.52435407002498, -20.622806228062274},
-		{81.91194999018803, -15.769957699576992},
-		{81.71899830940818, -12.771127711277103},
-		{81.29078098276518, -12.209522095220933},
-		{80.57990636936573, -16.284762847628457},
-		{80.34971839931258, -16.84996849968499},
-		{80.17707742177272, -20.046800468004676},
-		{80.12968578087943, -17.731977319773193},
-		{79.40019302284331, -18.898388983889845},
-		{78.75194307776717, -19.704797047970487},
-		{77.6382395167747, -19.67239672396724},
-		{76.98491189588854, -18.47358473584734},
-		{76.94429048940857, -20.036000360003584},
-		{76.6277820305855, -21.681216812168117},
-		{76.09801118774259, -19.834398343983423},
-		{75.2483467688699, -19.600396003960043},
-		{75.15525604568664, -20.66960669606695},
-		{74.29543627519398, -19.373593735937362},
-		{74.22434881385404, -21.594815948159464},
-		{73.81644219045101, -20.43560435604354},
-		{73.46439000095798, -20.763207632076302},
-		{73.31036716805475, -22.17082170821709},
-		{73.30698205084808, -23.56763567635676},
-		{72.62995860951527, -22.314823148231483},
-		{72.18481569683895, -22.300423004230026},
-		{72.59779999605199, -24.276842768427684},
-		{72.33037573672551, -24.79164791647915},
-		{72.07987706343239, -23.441634416344158},
-		{71.46886340762951, -22.131221312213114},
-		{70.6632055124435, -21.753217532175313},
-		{70.47025383166364, -23.53523535235351},
-		{70.85615719322332, -24.30564305643057},
-		{71.43162711835622, -25.544055440554388},
-		{70.75291111842007, -25.2020520205202},
-		{70.22652539278384, -26.361263612636122},
-		{70.18421142770053, -23.726037260372607},
-		{70.13004955239391, -22.34722347223473},
-		{69.25838187167791, -25.029250292502923},
-		{68.46964956252519, -27.747277472774726},
-		{68.12436760744548, -30.67410674106742},
-		{68.1209824902388, -31.775717757177574},
-		{67.73507912867912, -32.81252812528126},
-		{66.67892256019995, -34.2021420214202},
-		{65.9782032984205, -36.351363513635135},
-		{65.93758189194052, -37.04257042570424},
-		{65.69216089445739, -38.37458374583744},
-		{65.45858780719756, -39.810998109981085},
-		{64.84080391698139, -40.66780667806677},
-		{64.1383920965986, -40.682206822068224},
-		{63.48167935850576, -41.18981189811899},
-		{62.682791697733066, -42.820628206282066},
-		{61.90082962299367, -42.417424174241745},
-		{61.07486102456765, -42.86742867428674},
-		{60.09825471044509, -43.37863378633787},
-		{60.03732260072512, -44.78624786247863},
-		{60.85313584753116, -46.262262622626224},
-		{60.85821352334115, -48.263882638826374},
-		{61.40660251082072, -49.23229232292323},
-		{62.38320882494328, -49.90189901899018},
-		{63.62723939839232, -51.633516335163335},
-		{64.27887446067514, -52.1411214112141},
-		{65.1759305204411, -52.277922779227794},
-		{66.10006751786038, -53.660336603366034},
-		{66.83633051030982, -53.300333003330024},
-		{67.18838269980286, -53.96993969939699},
-		{68.35794069470528, -52.97992979929799},
-		{68.73030358743834, -51.475114751147515},
-		{69.14836556246134, -51.079110791107894},
-		{69.9286350785974, -50.870308703087034},
-		{69.57489033050102, -52.0151201512015},
-		{69.42594517340778, -52.558725587255864},
-		{69.2837702507279, -53.45513455134551},
-		{69.61043406117098, -54.682746827468264},
-		{70.28915006110712, -54.75114751147511},
-		{70.82061346255337, -54.35874358743587},
-		{70.83584648998335, -53.42993429934299},
-		{70.57011478926022, -51.38871388713886},
-		{71.20482426550974, -53.10953109531094},
-		{71.54672110338281, -54.00594005940059},
-		{71.40623873930623, -54.99954999549995},
-		{71.65504485399605, -55.834758347583474},
-		{72.58595208582865, -54.71874718747188},
-		{72.95831497856169, -55.32715327153271},
-		{73.65057144732448, -56.11916119161191},
-		{74.71011313301032, -57.32517325173251},
-		{75.09940161177668, -58.59598595985959},
-		{75.5174635867997, -58.58518585185851},
-		{76.10308886355257, -61.26721267212672},
-		{76.17586888349585, -63.39123391233912},
-		{76.13524747701588, -66.0660606606066},
-		{76.06077489846926, -68.50328503285033},
-		{76.37897591589568, -69.6660966609666},
-		{77.00860771633519, -71.40131401314012},
-		{77.32342361655496, -68.77688776887769},
-		{77.37589293325824, -66.76446764467644},
-		{77.63654695817137, -71.04131041310413},
-		{78.04445358157439, -73.29853298532984},
-		{78.43204950173741, -73.15813158131581},
-		{78.91442870368705, -69.37449374493744},
-		{79.39511534703333, -65.70965709657096},
-		{79.75732288814639, -65.32445324453244},
-		{80.11783787065609, -68.02448024480245},
-		{80.51558914243911, -67.14967149671496},
-		{81.21461584561523, -63.690036900368995},
-		{81.32124703762516, -62.23562235622356},
-		{81.76977506750815, -62.64962649626496},
-		{82.03381420962792, -60.28080280802807},
-		{82.19122215973782, -57.206372063720636},
-		{82.19968495275448, -54.13554135541355},
-		{81.88825416974139, -53.044730447304474},
-		{82.4383357158243, -50.39150391503915},
-		{82.06428026448792, -48.00468004680047},
-		{81.98642256873462, -46.60066600666005},
-		{81.66145131689488, -44.52344523445234},
-		{82.19968495275448, -46.899468994689954},
-		{82.62790227939747, -46.76266762667626},
-	},
-	{
-		{13.735689447974664, -90.09630096300963},
-		{13.910022984117859, -90.60750607506074},
-		{13.928641128754506, -91.23391233912339},
-		{14.126670485344363, -91.6911169111691},
-		{14.53965478455737, -92.22752227522275},
-		{14.830774864330479, -92.20232202322023},
-		{15.064347951590292, -92.08712087120871},
-		{15.252221956560149, -92.22752227522275},
-		{16.06634264476284, -91.74871748717487},
-		{16.069727761969503, -90.46350463504635},
-		{16.409932041239244, -90.43830438304383},
-		{16.470864150959187, -90.60030600306003},
-		{16.68751165218569, -90.71190711907118},
-		{16.917699622238842, -91.08271082710827},
-		{17.252826225698584, -91.45351453514535},
-		{17.254518784301908, -91.00351003510035},
-		{17.818140799211477, -90.99990999909998},
-		{17.8198333578148, -90.06750067500674},
-		{17.807985447591477, -89.14229142291423},
-		{17.015868021232095, -89.14949149491494},
-		{15.886931432809646, -89.22869228692286},
-		{15.886931432809646, -88.92988929889299},
-		{15.705827662253128, -88.60588605886059},
-		{15.854772819346337, -88.51948519485194},
-		{15.727830924096438, -88.22428224282243},
-		{15.347005238346739, -88.68148681486815},
-		{15.066040510193616, -89.1530915309153},
-		{14.874781388017112, -89.2250922509225},
-		{14.678444590030594, -89.14589145891459},
-		{14.424560799530795, -89.35469354693547},
-		{14.3619361312075, -89.58869588695886},
-		{14.2451495875776, -89.53469534695347},
-		{14.133440719757687, -89.72189721897219},
-		{13.881249487861211, -90.06390063900639},
-		{13.735689447974664, -90.09630096300963},
-	},
-	{
-		{8.366893558205533, -59.75879758797588},
-		{7.999608341282482, -59.09999099990999},
-		{7.34797327899966, -58.48438484384843},
-		{6.833435463586724, -58.45558455584555},
-		{6.809739643140077, -58.07758077580776},
-		{6.320590206777126, -57.54117541175411},
-		{5.973615693094061, -57.14877148771487},
-		{5.0731745161214405, -57.307173071730716},
-		{4.8125204912083035, -57.91557915579155},
-		{4.577254845345152, -57.86157861578616},
-		{4.061024471328892, -58.04518045180451},
-		{3.3349168304994663, -57.60237602376023},
-		{3.3332242718961282, -57.281972819728196},
-		{2.7696022569865733, -57.14877148771487},
-		{1.89962713487391, -56.540365403654036},
-		{1.8640834042039387, -56.78156781567816},
-		{1.9487113343705431, -57.335973359733586},
-		{1.6829796336474203, -57.659976599766},
-		{1.5069535389008877, -58.11358113581136},
-		{1.4646395738175926, -58.43038430384303},
-		{1.2683027758310743, -58.538385383853836},
-		{1.3173869753277074, -59.03159031590316},
-		{1.7862257084506723, -59.64719647196472},
-		{2.249986765763637, -59.719197191971915},
-		{2.7560617881599114, -59.97479974799748},
-		{3.605726207032589, -59.81639816398163},
-		{3.959470955128978, -59.53919539195391},
-		{4.423232012441943, -59.76599765997659},
-		{4.575562286741828, -60.11160111601116},
-		{5.013934965004822, -59.981999819998194},
-		{5.244122935057973, -60.21240212402124},
-		{5.20011641137134, -60.73440734407343},
-		{5.958382665664075, -61.411214112141124},
-		{6.234269718007198, -61.14121141211412},
-		{6.696338216716839, -61.15921159211592},
-		{6.857131284033372, -60.54360543605435},
-		{7.04331273039989, -60.29520295202951},
-		{7.415675623132941, -60.637206372063716},
-		{7.779575722849316, -60.55080550805508},
-		{8.366893558205533, -59.75879758797588},
-	},
-	{
-		{12.984193428095253, -87.3170731707317},
-		{13.29731676971167, -87.48987489874898},
-		{13.383637258481599, -87.79227792277922},
-		{13.784773647471297, -87.72387723877239},
-		{13.893097398084535, -87.86067860678607},
-		{13.964184859424478, -88.06588065880659},
-		{13.84570575719124, -88.50508505085051},
-		{13.979417886854478, -88.54108541085411},
-		{14.14021095417101, -88.84348843488435},
-		{14.33993286936419, -89.05949059490595},
-		{14.424560799530795, -89.35469354693547},
-		{14.678444590030594, -89.14589145891459},
-		{14.874781388017112, -89.2250922509225},
-		{15.066040510193616, -89.1530915309153},
-		{15.347005238346739, -88.68148681486815},
-		{15.727830924096438, -88.22428224282243},
-		{15.688902076219804, -88.11988119881198},
-		{15.864928170966337, -87.90027900279003},
-		{15.878468639792985, -87.61587615876158},
-		{15.797225826833056, -87.52227522275223},
-		{15.846310026329675, -87.36747367473674},
-		{15.756604420353085, -86.9030690306903},
-		{15.781992799403056, -86.44226442264423},
-		{15.89370166722297, -86.11826118261182},
-		{16.005410535042884, -86.00306003060031},
-		{15.95294121833959, -85.68265682656826},
-		{15.885238874206308, -85.4450544505445},
-		{15.90893469465297, -85.18225182251823},
-		{15.995255183422898, -84.98424984249843},
-		{15.856465377949675, -84.5270452704527},
-		{15.834462116106351, -84.36864368643685},
-		{15.648280669739833, -84.06264062640626},
-		{15.424862934100005, -83.77463774637746},
-		{15.270840101196796, -83.4110341103411},
-		{14.996645607457012, -83.14823148231481},
-		{15.016956310696997, -83.49023490234902},
-		{14.879859063827098, -83.6270362703627},
-		{14.749532051370537, -83.97623976239763},
-		{14.749532051370537, -84.22824228242283},
-		{14.620897597517299, -84.44784447844478},
-		{14.66659667980727, -84.64944649446494},
-		{14.818926954107155, -84.81864818648187},
-		{14.790153457850508, -84.9230492304923},
-		{14.551502694780694, -85.05265052650526},
-		{14.559965487797356, -85.14985149851498},
-		{14.355165896794176, -85.16425164251642},
-		{14.079278844451068, -85.51345513455134},
-		{13.960799742217816, -85.6970569705697},
-		{13.835550405571254, -85.80145801458015},
-		{14.038657437971096, -86.09666096660966},
-		{13.771233178644636, -86.31266312663126},
-		{13.77800341305796, -86.52146521465214},
-		{13.754307592611312, -86.75546755467555},
-		{13.263465597645038, -86.73386733867338},
-		{13.255002804628376, -86.88146881468815},
-		{13.026507393178548, -87.00747007470075},
-		{12.984193428095253, -87.3170731707317},
-	},
-	{
-		{45.909535938712835, 18.829988299883013},
-		{45.52194001854981, 19.07119071190712},
-		{45.235897614586705, 19.391593915939154},
-		{44.86014960464699, 19.006390063900653},
-		{45.08187478168348, 18.552785527855292},
-		{45.068334312856834, 17.861578615786158},
-		{45.23420505598337, 17.00117001170011},
-		{45.21220179414006, 16.533165331653322},
-		{45.004017085930215, 16.317163171631734},
-		{45.23420505598337, 15.960759607596088},
-		{44.81952819816702, 15.748357483574836},
-		{44.350689465044056, 16.23796237962381},
-		{44.0409512406343, 16.457564575645762},
-		{43.666895789297925, 16.914769147691487},
-		{43.44686317086477, 17.296372963729652},
-		{43.02880119584175, 17.674376743767453},
-		{42.64966806869539, 18.55998559985599},
-		{42.48041220836218, 18.448384483844848},
-		{42.84938998388857, 17.508775087750877},
-		{43.2099049663983, 16.929169291692915},
-		{43.507795280584716, 16.014760147601493},
-		{44.24236571443082, 15.175951759517602},
-		{44.318530851580746, 15.377553775537763},
-		{44.73828538520708, 14.920349203492037},
-		{45.076797105873496, 14.902349023490245},
-		{45.23420505598337, 14.257942579425787},
-		{44.8026026121337, 13.95193951939521},
-		{45.13772921559344, 13.65673656736567},
-		{45.48470372927652, 13.678336783367854},
-		{45.499936756706504, 13.714337143371438},
-		{45.466085584639856, 14.412744127441272},
-		{45.635341444973065, 14.59634596345964},
-		{45.47116326044984, 14.934749347493494},
-		{45.45254511581321, 15.327153271532723},
-		{45.73181728536299, 15.323553235532358},
-		{45.83337080156291, 15.672756727567275},
-		{46.23789230775925, 15.769957699576992},
-		{46.50362400848239, 16.56556565565657},
-		{46.38006723043914, 16.88236882368824},
-		{45.951849903796145, 17.631176311763113},
-		{45.75889822301629, 18.455584555845576},
-		{45.909535938712835, 18.829988299883013},
-	},
-	{
-		{19.713806434943322, -71.71091710917109},
-		{19.170495123273753, -71.62451624516245},
-		{18.784591761714054, -71.70011700117001},
-		{18.617028459984184, -71.94491944919449},
-		{18.317445587194413, -71.68931689316892},
-		{18.044943652057967, -71.70731707317073},
-		{18.21419951239116, -72.37332373323733},
-		{18.144804609654543, -72.8449284492845},
-		{18.217584629597823, -73.45333453334533},
-		{18.031403183231305, -73.92133921339213},
-		{18.3428339662444, -74.45774457744577},
-		{18.66442010087748, -74.37134371343713},
-		{18.525630295404255, -73.44973449734496},
-		{18.44608004104765, -72.69372693726937},
-		{18.66780521808414, -72.33372333723337},
-		{19.101100220537134, -72.79092790927909},
-		{19.48361846489017, -72.78372783727836},
-		{19.639333856396718, -73.41373413734136},
-		{19.915220908739826, -73.19053190531905},
-		{19.871214385053193, -72.57852578525785},
-		{19.713806434943322, -71.71091710917109},
-	},
-	{
-		{46.85229108076878, 16.201962019620197},
-		{47.49546335003494, 16.533165331653322},
-		{47.71211085126144, 16.342363423634254},
-		{47.71549596846809, 16.903969039690395},
-		{48.12340259187111, 16.979569795697955},
-		{47.867826242767975, 17.48717487174872},
-		{47.7578099335514, 17.857978579785794},
-		{47.88136671159462, 18.696786967869684},
-		{48.0810886267878, 18.77598775987761},
-		{48.111554681647775, 19.175591755917566},
-		{48.267270073154336, 19.661596615966175},
-		{48.20295284622772, 19.769597695976955},
-		{48.32820218287428, 20.237602376023773},
-		{48.563467828737444, 20.475204752047517},
-		{48.62439993845739, 20.80280802808028},
-		{48.31973938985762, 21.872018720187214},
-		{48.42298546466087, 22.084420844208438},
-		{48.150483529524436, 22.642426424264244},
-		{47.88136671159462, 22.710827108271076},
-		{47.67318200338481, 22.098820988209894},
-		{46.994466003448665, 21.62721627216274},
-		{46.31575000351252, 21.02241022410226},
-		{46.12787599854268, 20.21960219602198},
-		{46.171882522229296, 19.59679596795968},
-		{45.909535938712835, 18.829988299883013},
-		{45.75889822301629, 18.455584555845576},
-		{45.951849903796145, 17.631176311763113},
-		{46.38006723043914, 16.88236882368824},
-		{46.50362400848239, 16.56556565565657},
-		{46.84213572914878, 16.37116371163711},
-		{46.85229108076878, 16.201962019620197},
-	},
-	{
-		{-10.239403168223262, 120.71460714607144},
-		{-10.25802131285991, 120.29340293402936},
-		{-9.557302051080455, 118.96858968589686},
-		{-9.360965253093951, 119.90099900999013},
-		{-9.665625801693707, 120.42660426604266},
-		{-9.970286350293463, 120.77580775807758},
-		{-10.239403168223262, 120.71460714607144},
-	},
-	{
-		{-8.892126519970986, 124.969849698497},
-		{-9.090155876560829, 125.07065070650708},
-		{-9.39312386655726, 125.0886508865089},
-		{-10.139542210626672, 124.43704437044374},
-		{-10.359574829059838, 123.58023580235806},
-		{-10.239403168223262, 123.46143461434616},
-		{-9.89919888895352, 123.55143551435515},
-		{-9.289877791754009, 123.97983979839802},
-		{-8.892126519970986, 124.969849698497},
-	},
-	{
-		{-8.094931417801604, 117.89937899378992},
-		{-8.362355677128065, 118.25938259382593},
-		{-8.281112864168122, 118.87858878588787},
-		{-8.705945073604454, 119.12699126991271},
-		{-8.907359547400972, 117.97137971379715},
-		{-9.041071677064195, 117.27657276572768},
-		{-9.032608884047534, 116.740167401674},
-		{-8.457138958914655, 117.08217082170825},
-		{-8.448676165897993, 117.63297632976332},
-		{-8.094931417801604, 117.89937899378992},
-	},
-	{
-		{-8.094931417801604, 122.90342903429035},
-		{-8.650090639694497, 122.7558275582756},
-		{-8.934440485054282, 121.25461254612549},
-		{-8.810883707011044, 119.92259922599226},
-		{-8.445291048691331, 119.92259922599226},
-		{-8.237106340481489, 120.71460714607144},
-		{-8.53668921327126, 121.34101341013411},
-		{-8.460524076121317, 122.00702007020072},
-		{-8.094931417801604, 122.90342903429035},
-	},
-	{
-		{-6.778120824409299, 108.62208622086223},
-		{-6.8779817820058895, 110.54090540905412},
-		{-6.4649974827928816, 110.76050760507604},
-		{-6.94568412613917, 112.61452614526144},
-		{-7.59393407121533, 112.97812978129781},
-		{-7.776730400375186, 114.47934479344792},
-		{-8.370818470144727, 115.7069570695707},
-		{-8.751644155894425, 114.5657456574566},
-		{-8.348815208301403, 113.46413464134645},
-		{-8.375896145954712, 112.56052560525609},
-		{-8.301423567408108, 111.52371523715237},
-		{-8.122012355454913, 110.5877058770588},
-		{-7.741186669705215, 109.42849428494287},
-		{-7.641325712108625, 108.6940869408694},
-		{-7.766575048755186, 108.27648276482768},
-		{-7.355283308145516, 106.4548645486455},
-		{-6.925373422899185, 106.2820628206282},
-		{-6.85090084435258, 105.36405364053644},
-		{-5.896297792073327, 106.05166051660518},
-		{-5.955537343189945, 107.26487264872651},
-		{-6.34651838055963, 108.07128071280715},
-		{-6.422683517709572, 108.48528485284857},
-		{-6.778120824409299, 108.62208622086223},
-	},
-	{
-		{-6.214498809499744, 134.7259472594726},
-		{-6.894907368039213, 134.2111421114211},
-		{-6.141718789556464, 134.1139411394114},
-		{-5.782896365650075, 134.29034290342906},
-		{-5.444384644983671, 134.49914499144995},
-		{-5.737197283360118, 134.7259472594726},
-		{-6.214498809499744, 134.7259472594726},
-	},
-	{
-		{-3.4590134032752218, 127.2486724867249},
-		{-3.7907548895283014, 126.87426874268743},
-		{-3.607958560368445, 126.18306183061833},
-		{-3.1780486751221133, 125.9886598865989},
-		{-3.12896447562548, 127.00027000270006},
-		{-3.4590134032752218, 127.2486724867249},
-	},
-	{
-		{-3.093420744955509, 130.4707047070471},
-		{-3.858457233661582, 130.83430834308342},
-		{-3.445472934448574, 129.9918999189992},
-		{-3.362537562885308, 129.1566915669157},
-		{-3.4285473484152504, 128.59148591485916},
-		{-3.393003617745279, 127.90027900279006},
-		{-2.8429220716623718, 128.13428134281344},
-		{-2.8023006651824005, 129.37269372693726},
-		{-3.093420744955509, 130.4707047070471},
-	},
-	{
-		{-2.6008861913858965, 141.00081000810007},
-		{-5.859061502800017, 141.0188101881019},
-		{-9.117236814214138, 141.03321033210335},
-		{-8.296345891598108, 140.1440014400144},
-		{-8.096623976404928, 139.12879128791292},
-		{-8.380973821764712, 138.88038880388802},
-		{-8.411439876624684, 137.61317613176135},
-		{-7.5973191884219915, 138.0379803798038},
-		{-7.319739577475545, 138.66798667986683},
-		{-6.233116954136392, 138.4087840878409},
-		{-5.393607886883714, 137.92637926379263},
-		{-4.547328585217713, 135.98955989559897},
-		{-4.462700655051108, 135.1651516515165},
-		{-3.5385636576318262, 133.6639366393664},
-		{-4.024327976788115, 133.36873368733688},
-		{-4.112341024161381, 132.98352983529838},
-		{-3.7467483658416683, 132.75672756727568},
-		{-3.3117608047853366, 132.7531275312753},
-		{-2.8209188098190623, 131.98991989919898},
-		{-2.460403827309335, 133.06633066330664},
-		{-2.479021971945997, 133.77913779137793},
-		{-2.214982829826198, 133.69633696336962},
-		{-2.213290271222874, 132.23112231122315},
-		{-1.6175096428499955, 131.8351183511835},
-		{-1.4330207550868153, 130.94230942309423},
-		{-0.9371010843105267, 130.52110521105215},
-		{-0.6950652040340515, 131.86751867518677},
-		{-0.37009395219430985, 132.3787237872379},
-		{-0.7796931342006559, 133.98433984339846},
-		{-1.1520560269336926, 134.1427414274143},
-		{-2.7684494931157673, 134.42354423544236},
-		{-3.3676152386952936, 135.45675456754566},
-		{-2.306380994406126, 136.2919629196292},
-		{-1.703830131619938, 137.44037440374404},
-		{-1.7021375730166, 138.32958329583295},
-		{-2.050804645302989, 139.18639186391863},
-		{-2.409627069209378, 139.92799927999283},
-		{-2.6008861913858965, 141.00081000810007},
-	},
-	{
-		{1.4206330501309594, 125.23985239852402},
-		{0.42710114997507276, 124.43704437044374},
-		{0.23584202779855445, 123.68463684636845},
-		{0.43048626718173466, 122.72342723427238},
-		{0.38140206768510154, 121.05661056610569},
-		{0.2375345864018783, 120.18180181801819},
-		{-0.5190391092875188, 120.04140041400416},
-		{-1.4093249346401677, 120.93420934209342},
-		{-0.9557192289471885, 121.47421474214741},
-		{-0.615514949677447, 123.3390333903339},
-		{-1.0758908897837642, 123.259832598326},
-		{-0.9303308498972029, 122.82422824228246},
-		{-1.5176486852534055, 122.38862388623886},
-		{-1.905244605416442, 121.50661506615069},
-		{-3.186511468138775, 122.45342453424536},
-		{-3.5301008646151786, 122.27342273422738},
-		{-4.684425832087598, 123.16983169831701},
-		{-5.341138570180419, 123.16263162631628},
-		{-5.633951208556866, 122.62982629826297},
-		{-5.2835915776671385, 122.23742237422374},
-		{-4.464393213654446, 122.71982719827201},
-		{-4.851989133817469, 121.7370173701737},
-		{-4.574409522871022, 121.48861488614887},
-		{-4.188506161311324, 121.61821618216186},
-		{-3.602880884558445, 120.89820898208984},
-		{-2.627967129039206, 120.97380973809737},
-		{-2.930935119035638, 120.30420304203045},
-		{-4.0971079967313955, 120.39060390603908},
-		{-5.529012575150276, 120.43020430204302},
-		{-5.672880056433499, 119.79659796597969},
-		{-5.3800674180570525, 119.36819368193682},
-		{-4.4593155378444465, 119.6525965259653},
-		{-3.494557133945193, 119.49779497794981},
-		{-3.4877868995318693, 119.07659076590767},
-		{-2.8023006651824005, 118.7669876698767},
-		{-2.1472804856929173, 119.18099180991811},
-		{-1.3534705007302108, 119.3249932499325},
-		{0.15459921483861194, 119.82539825398254},
-		{0.565890955448296, 120.03420034200343},
-		{1.3089241823110456, 120.88740887408875},
-		{1.0144189853312753, 121.6686166861669},
-		{0.875629179858052, 122.92862928629285},
-		{0.9179431449413471, 124.07704077040773},
-		{1.6440507857707871, 125.06705067050672},
-		{1.4206330501309594, 125.23985239852402},
-	},
-	{
-		{1.132898087564513, 128.68868688686888},
-		{0.25784528964186393, 128.63468634686348},
-		{0.35601368863513017, 128.11988119881198},
-		{-0.2516148499610722, 127.96867968679686},
-		{-0.7796931342006559, 128.3790837908379},
-		{-0.8998647950372316, 128.09828098280985},
-		{-0.26684787739105786, 127.69507695076953},
-		{1.0110338681246134, 127.39987399874002},
-		{1.8099215288973198, 127.60147601476018},
-		{2.173821628613709, 127.93267932679328},
-		{1.6288177583408014, 128.0046800468005},
-		{1.5408047109675351, 128.59508595085953},
-		{1.132898087564513, 128.68868688686888},
-	},
-	{
-		{2.006258326883838, 109.66249662496625},
-		{1.337697678567693, 109.83169831698319},
-		{0.7723831050548, 110.51570515705157},
-		{0.9771826960579659, 111.160111601116},
-		{0.9044026761146995, 111.79731797317976},
-		{1.4104776985109595, 112.38052380523806},
-		{1.4984907458842258, 112.85932859328597},
-		{1.2175260177311173, 113.80613806138064},
-		{1.4307884017509451, 114.619746197462},
-		{2.8220715736898683, 115.13455134551344},
-		{3.1690460873729194, 115.519755197552},
-		{4.306445468812029, 115.86535865358655},
-		{4.306445468812029, 117.01377013770139},
-		{4.137189608478835, 117.88137881378816},
-		{3.235055872902876, 117.31257312573126},
-		{2.2872230550369466, 118.04698046980474},
-		{1.8268471149306436, 117.87417874178743},
-		{0.9027101175113614, 118.99738997389977},
-		{0.7842310152781238, 117.8129781297813},
-		{0.10212989813531692, 117.47817478174784},
-		{-0.8033889546473034, 117.52137521375215},
-		{-1.4871826303934341, 116.56016560165602},
-		{-2.4840996477559827, 116.53496534965353},
-		{-4.012480066564791, 116.14976149761497},
-		{-3.657042759865078, 116.00216002160022},
-		{-4.107263348351381, 114.86454864548648},
-		{-3.496249692548531, 114.46854468544689},
-		{-3.438702700035236, 113.7557375573756},
-		{-3.1188091240054945, 113.25533255332556},
-		{-3.4776315479118836, 112.06732067320672},
-		{-2.995252345962257, 111.7037170371704},
-		{-3.0494142212688757, 111.04851048510488},
-		{-2.9343202362423, 110.22410224102242},
-		{-1.5921212638000242, 110.06930069300694},
-		{-1.3145416528535776, 109.57249572495726},
-		{-0.4597995581709, 109.09009090090905},
-		{0.415253239751749, 108.95328953289533},
-		{1.3427753543776788, 109.06849068490686},
-		{2.006258326883838, 109.66249662496625},
-	},
-	{
-		{-5.8522912683866934, 105.8176581765818},
-		{-5.872601971626679, 104.70884708847092},
-		{-5.036478021580663, 103.86643866438664},
-		{-4.220664774774633, 102.5848258482585},
-		{-3.614728794781769, 102.15642156421563},
-		{-2.8006081065790767, 101.40041400414003},
-		{-2.050804645302989, 100.90360903609036},
-		{-0.6510586803474183, 100.14040140401403},
-		{0.18337271109525943, 99.26199261992622},
-		{1.0431924815879228, 98.97038970389707},
-		{1.8234619977239817, 98.59958599585997},
-		{2.4530937981634793, 97.69957699576997},
-		{3.3095284514494807, 97.17757177571775},
-		{3.86807279054905, 96.42516425164251},
-		{4.971620999921512, 95.38115381153813},
-		{5.479388580921125, 95.2947529475295},
-		{5.438767174441153, 95.93555935559357},
-		{5.245815493661297, 97.48357483574836},
-		{4.269209179538734, 98.36918369183695},
-		{3.590493179602589, 99.14319143191432},
-		{3.1741237631829193, 99.6939969399694},
-		{2.09934905006709, 100.64080640806407},
-		{2.0841160226371045, 101.65961659616596},
-		{1.3986297882876357, 102.49842498424988},
-		{0.5608132796382961, 103.07803078030781},
-		{0.10382245673865498, 103.83763837638378},
-		{-0.7119907900673752, 103.43803438034382},
-		{-1.0589653037504405, 104.01044010440103},
-		{-1.0843536828004119, 104.37044370443704},
-		{-1.7816878273732044, 104.53964539645398},
-		{-2.3402321664727737, 104.8888488884889},
-		{-2.42824521384604, 105.62325623256231},
-		{-3.0612621314921995, 106.10926109261095},
-		{-4.3052927049412375, 105.85725857258575},
-		{-5.8522912683866934, 105.8176581765818},
-	},
-	{
-		{35.49353029380765, 77.83817838178385},
-		{34.322279740301894, 78.91098910989109},
-		{33.50646649349588, 78.81018810188101},
-		{32.993621236686266, 79.20979209792097},
-		{32.484161097083344, 79.17739177391775},
-		{32.61787322674657, 78.45738457384573},
-		{31.51601757597743, 78.73818738187384},
-		{30.883000658331255, 79.7209972099721},
-		{30.18397395515514, 81.1106111061111},
-		{29.73036824946216, 80.4770047700477},
-		{28.794383341819554, 80.08820088200883},
-		{28.41525021467318, 81.05661056610569},
-		{27.92610077831023, 81.9998199982},
-		{27.36417132200401, 83.30303303033031},
-		{27.235536868150774, 84.67464674646749},
-		{26.726076728547838, 85.25065250652506},
-		{26.631293446761248, 86.02466024660248},
-		{26.397720359501434, 87.22707227072272},
-		{26.414645945534744, 88.0586805868059},
-		{26.810704658714442, 88.17388173881739},
-		{27.44541413496394, 88.04428044280445},
-		{27.87701657881361, 88.11988119881198},
-		{28.086893845626776, 88.73188731887319},
-		{27.299854095077393, 88.81468814688145},
-		{27.09843962128089, 88.83628836288364},
-		{26.719306494134514, 89.74349743497436},
-		{26.87502188564106, 90.37350373503733},
-		{26.809012100111104, 91.21591215912161},
-		{26.837785596367752, 92.03312033120335},
-		{27.452184369377278, 92.10512105121052},
-		{27.77207794540702, 91.69831698316983},
-		{27.897327282053595, 92.50472504725047},
-		{28.640360508916345, 93.4119341193412},
-		{29.27676254376918, 94.56754567545676},
-		{29.031341546286043, 95.40635406354062},
-		{29.452788638515713, 96.11916119161191},
-		{28.831619631092863, 96.58716587165873},
-		{28.411865097466517, 96.2487624876249},
-		{28.26122738176997, 97.32877328773287},
-		{27.88209425462361, 97.4007740077401},
-		{27.69929792546374, 97.05157051570518},
-		{27.08320659385089, 97.13437134371344},
-		{27.26431036440742, 96.41796417964179},
-		{26.573746454247953, 95.12555125551256},
-		{26.001661646321736, 95.15435154351542},
-		{25.16215257906906, 94.60354603546034},
-		{24.674695701309446, 94.5531455314553},
-		{23.850419661486754, 94.10674106741067},
-		{24.07891507293658, 93.32553325533257},
-		{23.043069207697386, 93.28593285932862},
-		{22.70286492842766, 93.05913059130592},
-		{22.278032718991312, 93.16713167131672},
-		{22.041074514524837, 92.67392673926742},
-		{23.627001925846926, 92.14472144721447},
-		{23.623616808640264, 91.87111871118714},
-		{22.98552221518409, 91.70551705517056},
-		{23.50344514780369, 91.15831158311585},
-		{24.072144838523243, 91.46791467914682},
-		{24.12969183103654, 91.91431914319145},
-		{24.97597113270254, 92.37512375123754},
-		{25.146919551639073, 91.79911799117991},
-		{25.133379082812425, 90.87390873908743},
-		{25.27047632968231, 89.91989919899203},
-		{25.96442535704844, 89.83349833498335},
-		{26.015202115148398, 89.3546935469355},
-		{26.446804558998053, 88.56268562685625},
-		{25.768088559061923, 88.20988209882097},
-		{25.238317716219, 88.92988929889299},
-		{24.865954823485964, 88.30708307083074},
-		{24.502054723769575, 88.0838808388084},
-		{24.23293790583979, 88.69948699486997},
-		{23.630387043053588, 88.53028530285303},
-		{22.878891023174177, 88.87588875888758},
-		{22.056307541954823, 89.03069030690307},
-		{21.69071488363511, 88.89028890288904},
-		{21.702562793858434, 88.20988209882097},
-		{21.49607064425193, 86.97506975069751},
-		{20.74288206576918, 87.03267032670328},
-		{20.152179113206316, 86.49986499864997},
-		{19.47854078908017, 85.05985059850599},
-		{18.302212559764428, 83.94023940239401},
-		{17.670888200721592, 83.18783187831878},
-		{17.015868021232095, 82.19422194221943},
-		{16.55718463972913, 82.19062190621906},
-		{16.310071083642654, 81.69381693816939},
-		{15.951248659736265, 80.79020790207903},
-		{15.89877934303297, 80.32580325803258},
-		{15.137127971533573, 80.02340023400234},
-		{13.835550405571254, 80.23220232202323},
-		{13.006196689938562, 80.28620286202863},
-		{12.056671313469309, 79.86139861398613},
-		{10.357342475723968, 79.85779857798576},
-		{10.308258276227349, 79.33939339393396},
-		{9.546606904727938, 78.8857888578886},
-		{9.216557977078196, 79.18819188191884},
-		{8.932208131718411, 78.27738277382775},
-		{8.25349213178228, 77.94257942579429},
-		{7.965757169215834, 77.53937539375397},
-		{8.900049518255116, 76.59256592565924},
-		{10.299795483210687, 76.13176131761321},
-		{11.30856041079656, 75.74655746557465},
-		{11.780784261126186, 75.39735397353974},
-		{12.742157547818778, 74.86454864548645},
-		{13.992958355681125, 74.61614616146161},
-		{14.617512480310637, 74.44334443344434},
-		{15.990177507612898, 73.53253532535325},
-		{17.928157108428053, 73.11853118531187},
-		{19.207731412547048, 72.81972819728199},
-		{20.419603372532777, 72.82332823328235},
-		{21.35558828017537, 72.62892628926289},
-		{20.758115093199166, 71.17451174511746},
-		{20.876594195432418, 70.4689046890469},
-		{22.088466155418132, 69.16569165691658},
-		{22.450673696531183, 69.64449644496446},
-		{22.843347292504205, 69.34929349293495},
-		{23.691319152773545, 68.17568175681757},
-		{24.35987980108969, 68.84168841688418},
-		{24.356494683883028, 71.04491044910449},
-		{25.214621895772353, 70.84330843308433},
-		{25.72238947677195, 70.28170281702819},
-		{26.492503641288025, 70.17010170101702},
-		{26.941031671171004, 69.5148951489515},
-		{27.988725446633524, 70.61650616506165},
-		{27.91256030948358, 71.77931779317794},
-		{28.961946643549425, 72.82332823328235},
-		{29.975789246945297, 73.44973449734499},
-		{30.979476498721183, 74.42174421744218},
-		{31.69204367072396, 74.40734407344075},
-		{32.2708987130635, 75.25695256952571},
-		{32.76512582523645, 74.45054450544507},
-		{33.44214926656926, 74.10494104941051},
-		{34.31720206449191, 73.74853748537487},
-		{34.748804508341564, 74.24174241742418},
-		{34.50507606946175, 75.75735757357575},
-		{34.654021226554974, 76.87336873368736},
-		{35.49353029380765, 77.83817838178385},
-	},
-	{
-		{53.86794649157996, -6.197461974619728},
-		{53.153686760973855, -6.031860318603179},
-		{52.260015818414544, -6.787867878678782},
-		{51.669312865851666, -8.56268562685625},
-		{51.81995058154821, -9.977499774997739},
-		{52.86425923980407, -9.167491674916732},
-		{53.881486960406605, -9.689496894968954},
-		{54.66514159374934, -8.32868328683287},
-		{55.13228776826897, -7.572675726757268},
-		{54.59574669101271, -7.367473674736743},
-		{54.059205613756475, -7.572675726757268},
-		{54.07443864118646, -6.95346953469533},
-		{53.86794649157996, -6.197461974619728},
-	},
-	{
-		{37.19962936596632, 53.92313923139233},
-		{37.39258104674617, 54.801548015480165},
-		{37.96466585467239, 55.51075510755109},
-		{37.93589235841574, 56.18036180361804},
-		{38.12207380478226, 56.619566195661974},
-		{38.02898308159901, 57.32877328773287},
-		{37.522908059202734, 58.437584375843755},
-		{37.412891749986144, 59.233192331923334},
-		{36.52768360044351, 60.3780037800378},
-		{36.49213986977354, 61.12321123211234},
-		{35.6492456853142, 61.20961209612096},
-		{34.40352255326184, 60.80280802808028},
-		{33.67572235382907, 60.52920529205292},
-		{33.52846975533919, 60.96480964809649},
-		{32.98177332646294, 60.53640536405365},
-		{32.182885665690236, 60.86400864008641},
-		{31.54817618944074, 60.943209432094335},
-		{31.37892032910753, 61.69921699216994},
-		{30.73574805984137, 61.7820178201782},
-		{29.82853664845541, 60.8748087480875},
-		{29.30384348142249, 61.36801368013681},
-		{28.699600060032964, 61.77121771217713},
-		{28.259534823166646, 62.728827288272896},
-		{27.379404349433997, 62.754027540275416},
-		{27.216918723514127, 63.2328323283233},
-		{26.75654278340781, 63.315633156331586},
-		{26.24031240939155, 61.87561875618758},
-		{25.077524648902468, 61.49761497614978},
-		{25.380492638898886, 59.6147961479615},
-		{25.610680608952052, 58.52398523985241},
-		{25.739315062805275, 57.39717397173973},
-		{26.96642005022099, 56.972369723697255},
-		{27.144138703570846, 56.493564935649374},
-		{26.96472749161765, 55.723157231572316},
-		{26.4806557310647, 54.71514715147151},
-		{26.812397217317766, 53.494734947349485},
-		{27.5808188232305, 52.48312483124832},
-		{27.865168668590286, 51.52191521915219},
-		{28.81469404505954, 50.85230852308524},
-		{30.148430224485168, 50.11430114301143},
-		{29.985944598565297, 49.57789577895781},
-		{30.317686084818362, 48.94068940689408},
-		{29.926705047448664, 48.56628566285664},
-		{30.453090773084924, 48.015480154801566},
-		{30.984554174531183, 48.00468004680047},
-		{30.984554174531183, 47.68427684276844},
-		{31.70896925675727, 47.84987849878499},
-		{32.468928069653344, 47.33507335073352},
-		{33.01731705713293, 46.111061110611104},
-		{33.968534992205505, 45.416254162541634},
-		{34.748804508341564, 45.65025650256504},
-		{35.09408646342129, 46.15066150661508},
-		{35.678019181570846, 46.07506075060752},
-		{35.9776020543606, 45.419854198542},
-		{37.17085586970967, 44.771847718477204},
-		{37.971436089085714, 44.22464224642246},
-		{38.28117431349547, 44.42264422644226},
-		{39.42872904655458, 44.109441094410954},
-		{39.71307889191435, 44.79344793447936},
-		{39.33563832337131, 44.95184951849518},
-		{38.87356982466167, 45.459454594545946},
-		{38.74155025360177, 46.14346143461435},
-		{38.77032374985842, 46.507065070650725},
-		{39.508279300911184, 47.68427684276844},
-		{39.58275187945779, 48.05868058680588},
-		{39.28824668247802, 48.35388353883539},
-		{38.79401957030507, 48.0118801188012},
-		{38.271018961875484, 48.634686346863475},
-		{38.3201031613721, 48.88308883088831},
-		{37.58214761031935, 49.19989199892001},
-		{37.37396290210951, 50.14670146701468},
-		{36.87296555552324, 50.84150841508415},
-		{36.70032457798338, 52.263522635226366},
-		{36.964363720103165, 53.82593825938261},
-		{37.19962936596632, 53.92313923139233},
-	},
-	{
-		{29.926705047448664, 48.56628566285664},
-		{29.975789246945297, 47.97587975879759},
-		{30.058724618508563, 47.302673026730275},
-		{29.099043890419324, 46.56826568265683},
-		{29.17859414477593, 44.71064710647107},
-		{31.18935376553435, 41.88821888218882},
-		{31.890073027313804, 40.40140401404014},
-		{32.16088240384693, 39.19539195391954},
-		{33.379524598245965, 38.79218792187922},
-		{34.41875558069182, 41.00621006210062},
-		{35.62893498207421, 41.38421384213842},
-		{36.358427740110315, 41.29061290612907},
-		{36.605541296196776, 41.83781837818378},
-		{37.23009542082629, 42.34902349023491},
-		{37.385810812332835, 42.777427774277754},
-		{37.255483799876274, 43.943839438394406},
-		{37.001600009376475, 44.29304293042932},
-		{37.17085586970967, 44.771847718477204},
-		{35.9776020543606, 45.419854198542},
-		{35.678019181570846, 46.07506075060752},
-		{35.09408646342129, 46.15066150661508},
-		{34.748804508341564, 45.65025650256504},
-		{33.968534992205505, 45.416254162541634},
-		{33.01731705713293, 46.111061110611104},
-		{32.468928069653344, 47.33507335073352},
-		{31.70896925675727, 47.84987849878499},
-		{30.984554174531183, 47.68427684276844},
-		{30.984554174531183, 48.00468004680047},
-		{30.453090773084924, 48.015480154801566},
-		{29.926705047448664, 48.56628566285664},
-	},
-	{
-		{66.45550482456012, -14.509945099450988},
-		{65.80894743808729, -14.740347403474033},
-		{65.1268463209445, -13.609936099360993},
-		{64.36350239084176, -14.909549095490945},
-		{63.67801615649229, -17.793177931779326},
-		{63.496912385935744, -18.657186571865708},
-		{63.64416498442564, -19.971199711997116},
-		{63.96067344324872, -22.761227612276116},
-		{64.40243123871839, -21.778417784177833},
-		{64.89158067508133, -23.95643956439565},
-		{65.08453235586119, -22.18522185221852},
-		{65.37903755284096, -22.22842228422283},
-		{65.61091808149745, -24.327243272432725},
-		{66.26255314378027, -23.650436504365047},
-		{66.40980574227014, -22.134821348213478},
-		{65.73278230093733, -20.576005760057598},
-		{66.27609361260691, -19.056790567905665},
-		{65.99343632585048, -17.800378003780025},
-		{66.52659228590007, -16.16956169561695},
-		{66.45550482456012, -14.509945099450988},
-	},
-	{
-		{32.709271391326496, 35.721357213572134},
-		{32.39445549110674, 35.54495544955449},
-		{32.53324529657996, 35.18495184951851},
-		{31.866377206867156, 34.976149761497624},
-		{31.754668339047242, 35.22455224552246},
-		{31.617571092177343, 34.968949689496895},
-		{31.353531950057558, 34.92574925749258},
-		{31.48893663832412, 35.39735397353974},
-		{31.09964815955776, 35.42255422554226},
-		{29.501872838012332, 34.92214922149222},
-		{31.21981982039432, 34.2669426694267},
-		{31.54817618944074, 34.55494554945551},
-		{31.60572318195402, 34.48654486544865},
-		{32.07286935647366, 34.75294752947531},
-		{32.82775049355973, 34.95454954549547},
-		{33.07994172545621, 35.09855098550986},
-		{33.090097077076194, 35.127351273512744},
-		{33.08840451847287, 35.46215462154623},
-		{33.26443061321939, 35.55215552155522},
-		{33.27797108204605, 35.822158221582214},
-		{32.868371900039705, 35.83655836558367},
-		{32.71604162573982, 35.69975699756998},
-		{32.709271391326496, 35.721357213572134},
-	},
-	{
-		{38.23039755539551, 15.521555215552155},
-		{37.44335780484613, 15.161551615516174},
-		{37.133619580436374, 15.30915309153093},
-		{36.620774323626776, 15.100351003510042},
-		{36.996522333566475, 14.333543335433347},
-		{37.10484608417973, 13.82593825938261},
-		{37.612613665179325, 12.432724327243278},
-		{38.12715148059226, 12.56952569525697},
-		{38.03575331601233, 13.739537395373958},
-		{38.144077066625584, 14.761947619476189},
-		{38.23039755539551, 15.521555215552155},
-	},
-	{
-		{41.20930069725985, 9.210692106921073},
-		{40.50011864246373, 9.808298082980826},
-		{39.176537814658104, 9.667896678966798},
-		{39.24085504158472, 9.214292142921437},
-		{38.90742099672832, 8.807488074880752},
-		{39.171460138848104, 8.42948429484295},
-		{40.37825442302383, 8.389883898839003},
-		{40.95033923095005, 8.159481594815958},
-		{40.89956247285009, 8.710287102871035},
-		{41.20930069725985, 9.210692106921073},
-	},
-	{
-		{46.508701684292376, 13.80793807938079},
-		{46.01616713072276, 13.696336963369646},
-		{45.59133492128642, 13.937539375393754},
-		{45.73689496117298, 13.141931419314204},
-		{45.381457654473266, 12.328323283232834},
-		{44.88553798369698, 12.382323823238238},
-		{44.60118813833721, 12.259922599226002},
-		{44.09172799873427, 12.587525875258763},
-		{43.58734553494132, 13.527135271352734},
-		{42.761376936515305, 14.031140311403135},
-		{41.95571904132926, 15.143551435514354},
-		{41.96079671713926, 15.924759247592476},
-		{41.740764098706094, 16.169561695616977},
-		{41.54104218351293, 15.888758887588892},
-		{41.17883464239988, 16.785167851678523},
-		{40.87755921100677, 17.51957519575197},
-		{40.35625116118052, 18.37638376383765},
-		{40.16837715621067, 18.480784807848096},
-		{39.811247290907616, 18.293582935829363},
-		{40.27839346542724, 17.73917739177392},
-		{40.44257164995045, 16.86796867968681},
-		{39.796014263477616, 16.450364503645034},
-		{39.42534392934792, 17.170371703717052},
-		{38.90234332091832, 17.05157051570515},
-		{38.8431037698017, 16.633966339663402},
-		{37.9866691165157, 16.101161011610117},
-		{37.90881142076243, 15.683556835568368},
-		{38.21516452796553, 15.687156871568732},
-		{38.75170560522177, 15.892358923589256},
-		{38.9649679892416, 16.108361083610845},
-		{39.543823031581155, 15.719557195571952},
-		{40.04820549537409, 15.413554135541375},
-		{40.17345483202065, 14.999549995499962},
-		{40.60505727587032, 14.704347043470449},
-		{40.78616104642684, 14.05994059940599},
-		{41.18898999401986, 13.627936279362814},
-		{41.25330722094648, 12.88632886328864},
-		{41.70522036803612, 12.105121051210517},
-		{42.35516287171562, 11.190711907119066},
-		{42.93063279684851, 10.510305103051024},
-		{43.920779579797724, 10.200702007020084},
-		{44.035873564824314, 9.70389703897041},
-		{44.36592249247404, 8.89028890288904},
-		{44.23051780420748, 8.42948429484295},
-		{43.76675674689453, 7.849878498784989},
-		{43.69397672695125, 7.435874358743604},
-		{44.12727172940423, 7.551075510755112},
-		{44.25421362465413, 7.0074700747007626},
-		{45.02771290637686, 6.748267482674834},
-		{45.33237345497663, 7.097470974709751},
-		{45.708121464916346, 6.802268022680238},
-		{45.99077875167278, 6.841868418684186},
-		{45.77751636765295, 7.273872738727391},
-		{45.824908008546245, 7.756277562775637},
-		{46.163419729212634, 8.317883178831806},
-		{46.004319220499426, 8.490684906849083},
-		{46.036477833962735, 8.9658896588966},
-		{46.44099934015908, 9.181891818918189},
-		{46.31405744490918, 9.923499234992363},
-		{46.48331330524239, 10.362703627036268},
-		{46.89291248724875, 10.441904419044192},
-		{46.75073756456884, 11.050310503105038},
-		{46.941996686745355, 11.165511655116546},
-		{47.11463766428524, 12.151921519215193},
-		{46.76766315060216, 12.37512375123751},
-		{46.508701684292376, 13.80793807938079},
-	},
-	{
-		{18.490086564734284, -77.56817568175681},
-		{18.40038095875768, -76.8949689496895},
-		{18.160037637084542, -76.36576365763658},
-		{17.88753570194808, -76.20016200162001},
-		{17.868917557311434, -76.90216902169021},
-		{17.701354255581563, -77.20457204572045},
-		{17.86214732289811, -77.76617766177661},
-		{18.226047422614485, -78.33858338583386},
-		{18.454542834064313, -78.21618216182162},
-		{18.523937736800917, -77.79857798577986},
-		{18.490086564734284, -77.56817568175681},
-	},
-	{
-		{32.39445549110674, 35.54495544955449},
-		{32.709271391326496, 35.721357213572134},
-		{32.31321267814681, 36.83376833768338},
-		{33.379524598245965, 38.79218792187922},
-		{32.16088240384693, 39.19539195391954},
-		{32.01024468815038, 39.00459004590047},
-		{31.509247341564105, 37.00297002970029},
-		{30.50894520699488, 38.00018000180003},
-		{30.337996788058348, 37.66897668976691},
-		{30.004562743201944, 37.50337503375036},
-		{29.86577293772872, 36.74016740167403},
-		{29.505257955218994, 36.502565025650256},
-		{29.197212289412576, 36.07056070560705},
-		{29.356312798125785, 34.95454954549547},
-		{29.501872838012332, 34.92214922149222},
-		{31.09964815955776, 35.42255422554226},
-		{31.48893663832412, 35.39735397353974},
-		{31.781749276700552, 35.54495544955449},
-		{32.39445549110674, 35.54495544955449},
-	},
-	{
-		{34.14963876276204, 134.63954639546398},
-		{33.806049366285635, 134.76554765547655},
-		{33.20180594489611, 134.20394203942038},
-		{33.521699520925864, 133.7935379353794},
-		{33.289818992269375, 133.2787327873279},
-		{32.704193715516496, 133.0159301593016},
-		{32.98854356087628, 132.36432364323645},
-		{33.46415252841257, 132.37152371523717},
-		{34.05993315678543, 132.92592925929262},
-		{33.94483917175886, 133.49473494734946},
-		{34.364593705385204, 133.9051390513905},
-		{34.14963876276204, 134.63954639546398},
-	},
-	{
-		{37.142082373453036, 140.97560975609758},
-		{36.343194712680315, 140.60120601206012},
-		{35.84219736609404, 140.77400774007742},
-		{35.138092987107925, 140.2520025200252},
-		{34.667561695381636, 138.97398973989743},
-		{34.60662958566168, 137.21717217172176},
-		{33.46415252841257, 135.79155791557918},
-		{33.848363331368944, 135.1219512195122},
-		{34.59647423404169, 135.0787507875079},
-		{34.37644161560853, 133.33993339933403},
-		{33.9042177652789, 132.15552155521556},
-		{33.88559962064224, 130.98550985509854},
-		{33.14933662819281, 132.00072000720007},
-		{31.450007790447486, 131.3311133111331},
-		{31.03025325682114, 130.68670686706866},
-		{31.417849176984177, 130.20070200702008},
-		{32.319982912560135, 130.44910449104492},
-		{32.611102992333244, 129.81549815498158},
-		{33.2965892266827, 129.4086940869409},
-		{33.60463489248913, 130.35550355503557},
-		{34.232574134325304, 130.8775087750878},
-		{34.7504970669449, 131.88551885518854},
-		{35.432598184087695, 132.61632616326165},
-		{35.732181056877465, 134.6071460714607},
-		{35.527381465874285, 135.67635676356764},
-		{37.30456799937291, 136.7239672396724},
-		{36.82726647323328, 137.389973899739},
-		{37.82756860780249, 138.8587885878859},
-		{38.21516452796553, 139.4275942759428},
-		{39.438884398174565, 140.0540005400054},
-		{40.56274331078703, 139.88479884798846},
-		{41.19576022843319, 140.3060030600306},
-		{41.37855655759306, 141.3680136801368},
-		{39.992351061464134, 141.91521915219153},
-		{39.181615490468104, 141.8828188281883},
-		{38.174543121485556, 140.96120961209613},
-		{37.142082373453036, 140.97560975609758},
-	},
-	{
-		{44.17466337029752, 143.90963909639095},
-		{43.961400986277695, 144.6116461164612},
-		{44.3845406371107, 145.32085320853207},
-		{43.26237428310158, 145.54405544055442},
-		{42.98817978936178, 144.06084060840607},
-		{41.99464788920591, 143.18243182431826},
-		{42.678441564952024, 141.61281612816128},
-		{41.58504870719956, 141.06561065610657},
-		{41.56981567976956, 139.9567995679957},
-		{42.56334757992546, 139.81639816398166},
-		{43.33346174444152, 140.31320313203133},
-		{43.38931617835148, 141.3788137881379},
-		{44.772136557273726, 141.67041670416705},
-		{45.550713514806446, 141.96921969219693},
-		{44.509789973757265, 143.1428314283143},
-		{44.17466337029752, 143.90963909639095},
-	},
-	{
-		{42.26545726573903, 70.96210962109623},
-		{42.080968377975836, 70.38970389703897},
-		{41.38363423340304, 69.06849068490686},
-		{40.66937450279694, 68.6328863288633},
-		{40.66260426838362, 68.25848258482586},
-		{41.13652067731657, 67.9848798487985},
-		{41.16867929077988, 66.7140671406714},
-		{41.98787765479257, 66.50886508865091},
-		{41.99464788920591, 66.0228602286023},
-		{42.99833514098178, 66.09846098460986},
-		{43.72782789901787, 64.89964899648999},
-		{43.6499702032646, 63.18603186031862},
-		{43.50441016337807, 62.01242012420124},
-		{44.40654389895401, 61.05841058410584},
-		{44.78398446749706, 60.24120241202414},
-		{45.499936756706504, 58.689586895868956},
-		{45.58625724547643, 58.50238502385025},
-		{44.99555429291355, 55.92835928359284},
-		{41.30916165485644, 55.96795967959682},
-		{41.260077455359806, 55.45675456754569},
-		{42.04373208870253, 54.75474754747549},
-		{42.32469681685565, 54.07794077940781},
-		{42.11651210864581, 52.94392943929441},
-		{41.783078063789404, 52.50112501125011},
-		{42.02680650266922, 52.447124471244734},
-		{42.44317591908889, 52.69192691926921},
-		{42.791842991375276, 52.50112501125011},
-		{43.13373982924834, 51.34191341913419},
-		{44.0307958890143, 50.89190891908919},
-		{44.2846796795141, 50.33750337503375},
-		{44.60965093135387, 50.30510305103053},
-		{44.51486764956728, 51.27711277112772},
-		{45.246052966206705, 51.31671316713167},
-		{45.40853859212656, 52.16632166321665},
-		{45.25959343503335, 53.041130411304124},
-		{46.23450719055258, 53.22113221132213},
-		{46.85229108076878, 53.041130411304124},
-		{46.804899439875484, 52.04032040320405},
-		{47.04862787875528, 51.190711907119066},
-		{46.60856264188895, 50.03510035100351},
-		{46.3986853750758, 49.102691026910264},
-		{46.56117100099566, 48.59148591485916},
-		{47.07570881640861, 48.69588695886961},
-		{47.74426946472475, 48.05868058680588},
-		{47.71549596846809, 47.31347313473137},
-		{48.394211968404235, 46.46746467464675},
-		{49.152478222696985, 47.043470434704346},
-		{49.35558525509681, 46.7518675186752},
-		{50.45405578865929, 47.55107551075511},
-		{49.87520074631975, 48.57708577085771},
-		{50.604693504355836, 48.70308703087031},
-		{51.69300868629831, 50.76590765907659},
-		{51.7183970653483, 52.328323283232834},
-		{51.026140596585506, 54.53154531545317},
-		{50.62161909038916, 55.715957159571616},
-		{51.04306618261883, 56.777967779677795},
-		{51.0633768858588, 58.361983619836195},
-		{50.5454539532392, 59.643596435964355},
-		{50.84165170882231, 59.93159931599317},
-		{50.79933774373903, 61.33921339213393},
-		{51.27325415267198, 61.587615876158765},
-		{51.96043294562479, 59.96759967599678},
-		{52.447889823384386, 60.92880928809288},
-		{52.72039175852085, 60.741607416074174},
-		{52.97935322483066, 61.69921699216994},
-		{53.6648394591801, 60.97920979209792},
-		{54.006736297053166, 61.436414364143644},
-		{54.353710810736246, 65.17685176851771},
-		{54.60082436682272, 65.66645666456665},
-		{54.96980214234908, 68.16848168481687},
-		{55.38447900016543, 69.06849068490686},
-		{55.16952405754226, 70.86490864908649},
-		{54.133678192303066, 71.18171181711818},
-		{54.37740663118289, 72.22572225722257},
-		{54.03550979330983, 73.50733507335073},
-		{53.490505923036906, 73.42453424534247},
-		{53.54636035694686, 74.38574385743857},
-		{54.490808057606145, 76.89136891368912},
-		{54.17768471598971, 76.52416524165244},
-		{53.40418543426698, 77.80217802178021},
-		{50.86534752926896, 80.03420034200343},
-		{51.38834813769857, 80.56700567005669},
-		{50.81287821256568, 81.94581945819459},
-		{51.068454561668815, 83.38223382233821},
-		{50.88904334971561, 83.93663936639365},
-		{50.311880865979404, 84.41544415444156},
-		{50.11723662659621, 85.1138511385114},
-		{49.69240441715989, 85.54225542255426},
-		{49.826116546823116, 86.83106831068312},
-		{49.215102891020265, 87.36027360273602},
-		{48.54992735991077, 86.59706597065974},
-		{48.45514407812418, 85.76905769057691},
-		{47.45314938495163, 85.72225722257224},
-		{47.00123623786199, 85.16425164251643},
-		{47.329592606908406, 83.18063180631805},
-		{45.53886560458314, 82.46062460624609},
-		{45.31714042754665, 81.94581945819459},
-		{44.91769659716029, 79.96579965799657},
-		{43.181131470141636, 80.86580865808656},
-		{42.920477445228514, 80.18180181801819},
-		{42.35008519590562, 80.26100261002608},
-		{42.497337794395506, 79.64539645396457},
-		{42.856160218301895, 79.14139141391416},
-		{42.961098851708485, 77.65817658176582},
-		{42.98817978936178, 75.99855998559985},
-		{42.878163480145204, 75.63855638556387},
-		{43.297918013771536, 74.2129421294213},
-		{43.09142586416503, 73.64413644136442},
-		{42.50072291160218, 73.48933489334894},
-		{42.846004866681895, 71.84411844118443},
-		{42.70382994400201, 71.18531185311855},
-		{42.26545726573903, 70.96210962109623},
-	},
-	{
-		{-1.6835194283799524, 41.58581585815858},
-		{-2.0829632587662985, 40.883808838088385},
-		{-2.4993326751859826, 40.63900639006391},
-		{-2.573805253732587, 40.264602646026475},
-		{-3.2779096327187034, 40.120601206012054},
-		{-3.6807385803117256, 39.80019800198002},
-		{-4.3459141114211945, 39.60579605796059},
-		{-4.675963039070936, 39.20259202592027},
-		{-3.6773534631050495, 37.766177661776624},
-		{-3.096805862162171, 37.69777697776979},
-		{-1.0606578623537644, 34.072540725407265},
-		{-0.9506415531371886, 33.90333903339035},
-		{0.11059269115197878, 33.89253892538926},
-		{0.5151141973483391, 34.18054180541807},
-		{1.176904611251146, 34.67374673746738},
-		{1.906397369287248, 35.037350373503756},
-		{3.0539521023463436, 34.59454594545946},
-		{3.554949448932618, 34.47934479344795},
-		{4.250591034902072, 34.00414004140043},
-		{4.846371663274951, 34.61974619746198},
-		{5.506469518574434, 35.296552965529656},
-		{5.338906216844563, 35.81855818558185},
-		{4.776976760538332, 35.81855818558185},
-		{4.448620391491929, 36.16056160561607},
-		{4.448620391491929, 36.85536855368554},
-		{3.598955972619251, 38.12258122581227},
-		{3.5888006209992653, 38.43578435784357},
-		{3.6158815586525748, 38.66978669786698},
-		{3.500787573625999, 38.8929889298893},
-		{3.4212373192693946, 39.55899558995591},
-		{3.8392992942924025, 39.854198541985426},
-		{4.25736126931541, 40.76860768607688},
-		{3.918849548649007, 41.1718117181172},
-		{3.918849548649007, 41.855818558185604},
-		{2.784835284416559, 40.9810098100981},
-		{-0.8575508299539223, 40.99180991809919},
-		{-1.6835194283799524, 41.58581585815858},
-	},
-	{
-		{42.26545726573903, 70.96210962109623},
-		{42.70382994400201, 71.18531185311855},
-		{42.846004866681895, 71.84411844118443},
-		{42.50072291160218, 73.48933489334894},
-		{43.09142586416503, 73.64413644136442},
-		{43.297918013771536, 74.2129421294213},
-		{42.878163480145204, 75.63855638556387},
-		{42.98817978936178, 75.99855998559985},
-		{42.961098851708485, 77.65817658176582},
-		{42.856160218301895, 79.14139141391416},
-		{42.497337794395506, 79.64539645396457},
-		{42.35008519590562, 80.26100261002608},
-		{42.12328234305913, 80.12060120601205},
-		{41.581663589992885, 78.54378543785441},
-		{41.1856048768132, 78.18738187381877},
-		{41.067125774579964, 76.90576905769058},
-		{40.427338622520466, 76.5277652776528},
-		{40.56274331078703, 75.46935469354693},
-		{40.36640651280051, 74.77814778147783},
-		{39.89418266247088, 73.82053820538206},
-		{39.660609575211055, 73.96093960939609},
-		{39.4304216051579, 73.67653676536767},
-		{39.279783889461356, 71.7829178291783},
-		{39.6047551413011, 70.54810548105482},
-		{39.52689744554783, 69.46449464494646},
-		{40.10236737068071, 69.55809558095581},
-		{39.93649662755418, 70.6489064890649},
-		{40.24454229336061, 71.01251012510127},
-		{40.14637389436734, 71.77571775717757},
-		{40.865711300783445, 73.05373053730537},
-		{41.392097026419705, 71.86931869318695},
-		{41.143290911729906, 71.15651156511566},
-		{41.52073148027294, 70.41850418504185},
-		{42.167288866745764, 71.26091260912611},
-		{42.26545726573903, 70.96210962109623},
-	},
-	{
-		{12.18699832592587, 102.5848258482585},
-		{13.393792610101599, 102.34722347223476},
-		{14.226531442940939, 102.98802988029882},
-		{14.416098006514133, 104.28044280442805},
-		{14.273923083834248, 105.22005220052199},
-		{13.881249487861211, 106.04446044460445},
-		{14.570120839417342, 106.49806498064981},
-		{14.202835622494291, 107.38367383673835},
-		{13.535967532781484, 107.61407614076143},
-		{12.337636041622417, 107.49167491674916},
-		{11.567521877106358, 105.81045810458107},
-		{10.961585897113494, 106.24966249662498},
-		{10.888805877170228, 105.19845198451986},
-		{10.485976929577205, 104.33444334443345},
-		{10.63322952806709, 103.49563495634959},
-		{11.152845019290012, 103.09243092430927},
-		{12.18699832592587, 102.5848258482585},
-	},
-	{
-		{38.61291579974855, 128.35028350283505},
-		{37.43320245322613, 129.21429214292147},
-		{36.78495250814997, 129.45909459094594},
-		{35.632320099280875, 129.46989469894697},
-		{35.08223855319797, 129.0918909189092},
-		{34.89097943102145, 128.18468184681848},
-		{34.47630257320512, 127.38547385473856},
-		{34.389982084435175, 126.48546485464857},
-		{34.93498595470808, 126.3738637386374},
-		{35.68478941598417, 126.55746557465574},
-		{36.72571295703335, 126.11826118261183},
-		{36.89327625876322, 126.85986859868598},
-		{37.749710912049224, 126.1758617586176},
-		{37.84110907662915, 126.23706237062373},
-		{37.80556534595918, 126.68346683466837},
-		{38.2557859344455, 127.07227072270723},
-		{38.30487013394212, 127.78147781477816},
-		{38.370879919472074, 128.20628206282066},
-		{38.61291579974855, 128.35028350283505},
-	},
-	{
-		{41.855858083732684, 20.590405904059054},
-		{42.21806562484572, 20.522005220052222},
-		{42.31961914104565, 20.28440284402845},
-		{42.58873595897545, 20.072000720007196},
-		{42.812153694615276, 20.25920259202593},
-		{42.88493371455853, 20.4968049680497},
-		{43.21667520081162, 20.633606336063366},
-		{43.27252963472158, 20.81360813608137},
-		{43.130354712041694, 20.957609576095763},
-		{43.06942260232172, 21.144811448114496},
-		{42.910322093608514, 21.27441274412746},
-		{42.86293045271522, 21.44001440014401},
-		{42.676749006348714, 21.63441634416344},
-		{42.68351924076204, 21.774817748177497},
-		{42.43979080188221, 21.663216632166325},
-		{42.31961914104565, 21.544415444154453},
-		{42.245146562499045, 21.5768157681577},
-		{42.2062177146224, 21.353613536135356},
-		{42.05219488171919, 20.76320763207633},
-		{41.84739529071602, 20.716407164071654},
-		{41.855858083732684, 20.590405904059054},
-	},
-	{
-		{29.975789246945297, 47.97587975879759},
-		{29.53403145147564, 48.18468184681848},
-		{29.305536040025828, 48.09468094680949},
-		{28.55234746154308, 48.41508415084152},
-		{28.52526652388977, 47.70947709477096},
-		{29.002568050029396, 47.461074610746124},
-		{29.099043890419324, 46.56826568265683},
-		{30.058724618508563, 47.302673026730275},
-		{29.975789246945297, 47.97587975879759},
-	},
-	{
-		{14.273923083834248, 105.22005220052199},
-		{14.724143672320551, 105.54405544055442},
-		{15.570422973986567, 105.58725587255873},
-		{16.442090654702554, 104.78084780847809},
-		{17.428852320445102, 104.71604716047159},
-		{18.24128045004447, 103.95643956439568},
-		{18.30898279417775, 103.20043200432008},
-		{17.962008280494686, 102.99882998829992},
-		{17.933234784238053, 102.4120241202412},
-		{18.10926087898457, 102.11322113221132},
-		{17.511787692008383, 101.05841058410584},
-		{18.40884375177434, 101.03681036810372},
-		{19.463307761650185, 101.28161281612819},
-		{19.509006843940142, 100.60480604806048},
-		{20.109865148123006, 100.54720547205471},
-		{20.41791081392944, 100.11520115201154},
-		{20.786888589455813, 100.32760327603279},
-		{21.43683109313531, 101.18081180811811},
-		{21.20156544727216, 101.2708127081271},
-		{21.17448450961885, 101.80361803618035},
-		{22.318654125471284, 101.65241652416523},
-		{22.464214165357845, 102.17082170821709},
-		{21.675481856205124, 102.75402754027539},
-		{20.766577886215828, 103.20403204032039},
-		{20.758115093199166, 104.43524435244353},
-		{19.886447412483193, 104.8240482404824},
-		{19.624100828966732, 104.18324183241833},
-		{19.265278405060343, 103.89523895238955},
-		{18.66780521808414, 105.09405094050942},
-		{17.48470675435506, 105.92565925659255},
-		{16.604576280622425, 106.55566555665558},
-		{15.90893469465297, 107.31167311673119},
-		{15.201445198460178, 107.56367563675639},
-		{14.202835622494291, 107.38367383673835},
-		{14.570120839417342, 106.49806498064981},
-		{13.881249487861211, 106.04446044460445},
-		{14.273923083834248, 105.22005220052199},
-	},
-	{
-		{33.27797108204605, 35.822158221582214},
-		{33.26443061321939, 35.55215552155522},
-		{33.08840451847287, 35.46215462154623},
-		{33.090097077076194, 35.127351273512744},
-		{33.905910323882225, 35.48375483754839},
-		{34.61001470286834, 35.98055980559806},
-		{34.64555843353831, 35.998559985599854},
-		{34.59308911683502, 36.44856448564485},
-		{34.20210807946533, 36.610566105661064},
-		{33.824667510922296, 36.066960669606715},
-		{33.27797108204605, 35.822158221582214},
-	},
-	{
-		{4.363992461325324, -7.713077130771296},
-		{4.355529668308662, -7.975879758797589},
-		{4.832831194448289, -9.005490054900548},
-		{5.592790007344362, -9.91269912699127},
-		{6.141178994823932, -10.76590765907659},
-		{6.786043822693429, -11.439114391143903},
-		{7.105937398723185, -11.201512015120159},
-		{7.397057478496279, -11.147511475114754},
-		{7.938676231562525, -10.693906939069393},
-		{8.405822406082166, -10.22950229502294},
-		{8.427825667925475, -10.017100171001715},
-		{8.541227094348727, -9.754297542975422},
-		{7.928520879942539, -9.336693366933673},
-		{7.527384490952855, -9.405094050940505},
-		{7.314122106933013, -9.207092070920709},
-		{7.309044431123027, -8.926289262892624},
-		{7.711873378716035, -8.7210872108721},
-		{7.686484999666064, -8.440284402844014},
-		{7.395364919892955, -8.48708487084869},
-		{6.911293159340005, -8.38628386283861},
-		{6.467842805267011, -8.602286022860227},
-		{6.193648311527227, -8.31068310683105},
-		{6.125945967393946, -7.993879938799381},
-		{5.707883992370938, -7.569075690756904},
-		{5.313517837794578, -7.54027540275402},
-		{5.188268501148016, -7.633876338763372},
-		{4.363992461325324, -7.713077130771296},
-	},
-	{
-		{22.86365799574419, 14.851948519485205},
-		{22.491295103011154, 14.142741427414279},
-		{23.039684090490724, 13.58113581135811},
-		{23.47128653434038, 12.000720007200073},
-		{24.09753321757323, 11.561515615156168},
-		{24.562986833489532, 10.773107731077317},
-		{24.378497945726338, 10.305103051030528},
-		{24.937042284825907, 9.948699486994883},
-		{25.3652596114689, 9.909099090990907},
-		{26.094752369505002, 9.318693186931881},
-		{26.51281434452801, 9.714697146971474},
-		{27.140753586364184, 9.62829628296285},
-		{27.687450015240415, 9.757897578975786},
-		{28.14444083814007, 9.682296822968226},
-		{28.960254084946087, 9.858698586985867},
-		{29.424015142259066, 9.80469804698049},
-		{30.307530733198377, 9.480694806948065},
-		{30.539411261854852, 9.970299702997039},
-		{30.96255091268786, 10.056700567005663},
-		{31.375535211900868, 9.948699486994883},
-		{31.761438573460566, 10.636306363063625},
-		{32.08133214949032, 10.945909459094594},
-		{32.369067112056754, 11.431914319143203},
-		{33.13748871796949, 11.489514895148972},
-		{32.79220676288976, 12.663126631266323},
-		{32.87852725165969, 13.084330843308436},
-		{32.71265650853316, 13.919539195391962},
-		{32.2658210372535, 15.244352443524434},
-		{31.375535211900868, 15.712357123571252},
-		{31.182583531121026, 16.612366123661246},
-		{30.76282899749468, 18.019980199802006},
-		{30.266909326718405, 19.085590855908578},
-		{30.525870793028204, 19.575195751957523},
-		{30.986246733134507, 20.054000540005404},
-		{31.75128322184058, 19.819998199981995},
-		{32.23874009960019, 20.13320133201333},
-		{32.70757883272316, 20.85320853208532},
-		{32.84298352098972, 21.544415444154453},
-		{32.63818392998655, 22.894428944289444},
-		{32.1913484587069, 23.23643236432366},
-		{32.187963341500236, 23.6108361083611},
-		{32.0170149225637, 23.927639276392767},
-		{31.898535820330466, 24.921249212492143},
-		{31.568486892680724, 25.166051660516615},
-		{31.08949280793776, 24.802448024480242},
-		{30.661275481294766, 24.957249572495726},
-		{30.043491591078578, 24.70164701647016},
-		{29.237833695892547, 25.00045000450004},
-		{25.681768070291994, 25.00045000450004},
-		{22.000453108044866, 25.00045000450004},
-		{20.003233956113093, 25.00045000450004},
-		{19.99984883890643, 23.848438484384843},
-		{19.5800943052801, 23.83763837638378},
-		{21.49437808564859, 19.84879848798488},
-		{23.410354424620436, 15.859958599586008},
-		{22.86365799574419, 14.851948519485205},
-	},
-	{
-		{7.522306815142855, 81.78741787417874},
-		{6.481383274093673, 81.63621636216362},
-		{6.197033428733889, 81.21861218612185},
-		{5.968538017284075, 80.34740347403476},
-		{6.76404056085012, 79.87219872198722},
-		{8.201022815078986, 79.6957969579696},
-		{9.824186515674384, 80.14940149401497},
-		{9.269027293781491, 80.84060840608407},
-		{8.564922914795375, 81.30501305013053},
-		{7.522306815142855, 81.78741787417874},
-	},
-	{
-		{-28.955716203868626, 28.978489784897846},
-		{-29.256991635261727, 29.324093240932427},
-		{-29.744448513021347, 29.018090180901822},
-		{-30.069419764861088, 28.84888848888491},
-		{-30.226827714970966, 28.290882908829104},
-		{-30.545028732397384, 28.107281072810736},
-		{-30.644889689993974, 27.75087750877509},
-		{-29.87646808408124, 26.998469984699852},
-		{-29.243451166435065, 27.531275312753138},
-		{-28.850777570462043, 28.074880748807487},
-		{-28.6476705380622, 28.542885428854305},
-		{-28.955716203868626, 28.978489784897846},
-	},
-	{
-		{54.32832243168626, 22.73242732427326},
-		{54.58220622218606, 22.649626496264972},
-		{54.85640071592586, 22.75762757627578},
-		{55.01550122463907, 22.314823148231483},
-		{55.18983476078226, 21.267212672126732},
-		{56.03103638663826, 21.05481054810548},
-		{56.33738949384134, 22.199621996219975},
-		{56.27307226691475, 23.877238772387727},
-		{56.37293322451133, 24.86004860048601},
-		{56.164748516301486, 25.00045000450004},
-		{56.10043128937487, 25.533255332553324},
-		{55.61466697021858, 26.49446494464945},
-		{55.167831498938924, 26.588065880658803},
-		{54.84624536430586, 25.767257672576733},
-		{54.2826233493963, 25.536855368553688},
-		{53.90518278085325, 24.44964449644496},
-		{53.911953015266576, 23.4848348483485},
-		{54.21999868107302, 23.24363243632436},
-		{54.32832243168626, 22.73242732427326},
-	},
-	{
-		{50.12739197821621, 6.042660426604272},
-		{49.902281683973044, 6.244262442624432},
-		{49.46390900571005, 6.186661866618664},
-		{49.44190574386674, 5.898658986589879},
-		{49.52991879124001, 5.675456754567563},
-		{50.090155688942914, 5.783457834578343},
-		{50.12739197821621, 6.042660426604272},
-	},
-	{
-		{56.03103638663826, 21.05481054810548},
-		{56.784224965121, 21.09081090810909},
-		{57.41216420695717, 21.580415804158037},
-		{57.75406104483024, 22.52362523625237},
-		{57.00595014215749, 23.31923319233192},
-		{57.02626084539749, 24.1220412204122},
-		{57.79298989270687, 24.312843128431297},
-		{57.97070854605674, 25.166051660516615},
-		{57.84715176801352, 25.601656016560185},
-		{57.47648143388379, 26.462064620646203},
-		{57.47478887528045, 27.286472864728665},
-		{57.2446009052273, 27.768877688776882},
-		{56.75883658607101, 27.855278552785535},
-		{56.1698261921115, 28.175681756817568},
-		{55.78392283055179, 27.102871028710297},
-		{55.61466697021858, 26.49446494464945},
-		{56.10043128937487, 25.533255332553324},
-		{56.164748516301486, 25.00045000450004},
-		{56.37293322451133, 24.86004860048601},
-		{56.27307226691475, 23.877238772387727},
-		{56.33738949384134, 22.199621996219975},
-		{56.03103638663826, 21.05481054810548},
-	},
-	{
-		{35.16855904196791, -2.1690216902169084},
-		{34.527079331305075, -1.794617946179443},
-		{33.919450792708886, -1.733417334173339},
-		{32.863294224229705, -1.387813878138786},
-		{32.6517243988132, -1.1250112501124931},
-		{32.26243592004684, -1.3086130861308618},
-		{32.09487261831697, -2.6154261542615416},
-		{31.72420228418727, -3.069030690306903},
-		{31.63788179541733, -3.6486364863648646},
-		{30.896541127157917, -3.6918369183691766},
-		{30.50048241397822, -4.858248582485828},
-		{30.001177625995282, -5.243452434524329},
-		{29.732060808065484, -6.0606606066060635},
-		{29.579730533765613, -7.057870578705774},
-		{28.84177498271285, -8.674286742867423},
-		{27.656983960380444, -8.667086670866695},
-		{27.656983960380444, -8.818288182881815},
-		{27.1204428831242, -8.793087930879295},
-		{27.08828426966089, -9.412294122941233},
-		{26.8614814168144, -9.73629736297363},
-		{26.8614814168144, -10.189901899018992},
-		{26.990115870667637, -10.549905499054972},
-		{26.883484678657723, -11.392313923139227},
-		{26.104907721124988, -11.719917199171988},
-		{26.030435142578384, -12.029520295202957},
-		{24.769478983096036, -12.50112501125011},
-		{23.691319152773545, -13.890738907389078},
-		{22.31019133245462, -14.221942219422175},
-		{21.861663302571642, -14.632346323463224},
-		{21.50114832006193, -14.751147511475097},
-		{21.419905507101987, -17.00117001170011},
-		{21.421598065705325, -17.019170191701903},
-		{21.88535912301829, -16.972369723697227},
-		{22.15786105815475, -16.590765907659062},
-		{22.67916910798101, -16.2631626316263},
-		{23.0176808286474, -16.327963279632797},
-		{23.723477766236854, -15.982359823598216},
-		{24.35987980108969, -15.424354243542439},
-		{24.520672868406237, -15.08955089550895},
-		{25.10291302795244, -14.823148231482321},
-		{25.636068988002023, -14.801548015480137},
-		{26.25385287821821, -14.441544415444156},
-		{26.619445536537924, -13.775537755377542},
-		{27.64005837434712, -13.13833138331384},
-		{27.653598843173782, -13.12033120331202},
-		{28.037809646130142, -12.619926199261982},
-		{28.147825955346732, -11.68751687516874},
-		{28.831619631092863, -10.902709027090253},
-		{29.099043890419324, -10.398703987039852},
-		{29.933475281862002, -9.563495634956354},
-		{31.177505855311026, -9.815498154981555},
-		{32.03732562580369, -9.43389433894339},
-		{32.56540391004327, -9.30069300693006},
-		{33.24073479277274, -8.656286562865631},
-		{33.69772561567238, -7.655476554765528},
-		{34.110709914885405, -6.913869138691382},
-		{35.14655578012459, -6.244262442624432},
-		{35.759261994530775, -5.931059310593099},
-		{35.75587687732411, -5.193051930519289},
-		{35.33104466788778, -4.591845918459171},
-		{35.400439570624386, -3.6414364143641365},
-		{35.178714393587896, -2.6046260462604494},
-		{35.16855904196791, -2.1690216902169084},
-	},
-	{
-		{48.22157099086438, 26.62046620466205},
-		{48.36882358935425, 26.858068580685824},
-		{48.466991988347516, 27.52407524075241},
-		{48.15556120533442, 28.258482584825856},
-		{48.11832491606113, 28.67248672486727},
-		{47.849208098131314, 29.122491224912267},
-		{47.510696377464924, 29.05049050490507},
-		{47.34651819294173, 29.414094140941415},
-		{46.92845621791871, 29.558095580955808},
-		{46.67457242741891, 29.907299072990725},
-		{46.5256272703257, 29.838898388983893},
-		{46.424073754125786, 30.026100261002625},
-		{46.34960117557917, 29.75969759697597},
-		{46.38006723043914, 29.169291692916943},
-		{46.51716447730904, 29.072090720907227},
-		{46.43761422295243, 28.863288632886338},
-		{46.258203010999225, 28.935289352893534},
-		{45.94000199357281, 28.661686616866177},
-		{45.59641259709643, 28.485284852848537},
-		{45.488088846483166, 28.233282332823336},
-		{45.94507966938282, 28.05328053280533},
-		{46.37160443742248, 28.16128161281614},
-		{46.80997711568547, 28.12888128881289},
-		{47.405757744058334, 27.552875528755294},
-		{47.827204836288004, 27.23247232472326},
-		{48.12340259187111, 26.922869228692292},
-		{48.22157099086438, 26.62046620466205},
-	},
-	{
-		{-12.470195407414849, 49.5418954189542},
-		{-12.89502761685118, 49.808298082980826},
-		{-13.555125472150664, 50.05670056700569},
-		{-14.75853463911973, 50.218702187021876},
-		{-15.225680813639357, 50.477904779047805},
-		{-15.706367456985646, 50.377103771037724},
-		{-16.000872653965416, 50.200702007020084},
-		{-15.413554818609214, 49.86229862298623},
-		{-15.709752574192322, 49.67149671496716},
-		{-16.451093242451734, 49.86229862298623},
-		{-16.87423289328474, 49.77589775897761},
-		{-17.10611342194123, 49.498694986949886},
-		{-17.952392723607232, 49.43389433894339},
-		{-19.11856560130299, 49.04149041490416},
-		{-20.496308304415237, 48.54828548285482},
-		{-22.39197394014709, 47.92907929079291},
-		{-23.781564553482674, 47.54747547475475},
-		{-24.9409671967651, 47.09747097470975},
-		{-25.177925401231583, 46.28386283862838},
-		{-25.601065052064584, 45.409054090540906},
-		{-25.345488702961447, 44.83304833048331},
-		{-24.988358837658396, 44.04104041040412},
-		{-24.460280553418805, 43.7638376383764},
-		{-23.573379845272832, 43.699036990369905},
-		{-22.776184743103457, 43.346233462334624},
-		{-22.056847336687355, 43.25263252632527},
-		{-21.335817371667915, 43.432634326343276},
-		{-21.163176394128058, 43.893438934389366},
-		{-20.82974234927164, 43.8970389703897},
-		{-20.073168653582243, 44.37584375843758},
-		{-19.43507406012607, 44.4658446584466},
-		{-18.961157651193105, 44.23184231842319},
-		{-18.331525850753593, 44.044640446404486},
-		{-17.410773970540987, 43.9618396183962},
-		{-16.850537072838094, 44.311043110431115},
-		{-16.215827596588582, 44.44784447844478},
-		{-16.178591307315287, 44.94464944649448},
-		{-15.973791716312107, 45.502655026550286},
-		{-15.792687945755588, 45.87345873458736},
-		{-15.780840035532265, 46.312663126631264},
-		{-15.210447786209372, 46.88146881468816},
-		{-14.594356454596522, 47.7058770587706},
-		{-14.09166654940691, 48.00468004680047},
-		{-13.663449222763916, 47.86787867878681},
-		{-13.783620883600491, 48.292682926829286},
-		{-13.08967185623436, 48.843488434884364},
-		{-12.487120993448173, 48.86508865088652},
-		{-12.040285522168517, 49.196291962919645},
-		{-12.470195407414849, 49.5418954189542},
-	},
-	{
-		{25.869642075261837, -97.14157141571415},
-		{24.992896718735864, -97.52677526775267},
-		{24.271866753716424, -97.70317703177031},
-		{22.93305289848081, -97.77517775177752},
-		{22.44390346211786, -97.87237872378724},
-		{21.898899591844952, -97.69957699576996},
-		{21.411442714085325, -97.38997389973899},
-		{20.636250873759266, -97.18837188371883},
-		{19.89152508829318, -96.5259652596526},
-		{19.3211328389703, -96.2919629196292},
-		{18.828598285400687, -95.89955899558996},
-		{18.56286658467755, -94.83754837548375},
-		{18.144804609654543, -94.42714427144271},
-		{18.424076779204327, -93.54873548735488},
-		{18.525630295404255, -92.78552785527854},
-		{18.70504150735745, -92.03672036720367},
-		{18.875989926293983, -91.40671406714067},
-		{19.28389654969699, -90.7731077310773},
-		{19.86782926784653, -90.53190531905318},
-		{20.70733833509921, -90.45270452704527},
-		{21.000150973475655, -90.279902799028},
-		{21.262497556992116, -89.6030960309603},
-		{21.49437808564859, -88.54468544685446},
-		{21.45883435497862, -87.6590765907659},
-		{21.543462285145225, -87.05067050670506},
-		{21.33189245972872, -86.8130681306813},
-		{20.849513257779094, -86.84546845468455},
-		{20.255425188009568, -87.38187381873819},
-		{19.64610409081004, -87.61947619476194},
-		{19.471770554666847, -87.43587435874359},
-		{19.040168110817177, -87.58707587075871},
-		{18.259898594681133, -87.83547835478355},
-		{18.517167502387593, -88.09108091080911},
-		{18.50024191635427, -88.29988299882999},
-		{18.486701447527608, -88.49068490684907},
-		{17.88245802613808, -88.8470884708847},
-		{18.000937128371334, -89.03069030690307},
-		{17.955238046081362, -89.14949149491494},
-		{17.807985447591477, -89.14229142291423},
-		{17.8198333578148, -90.06750067500674},
-		{17.818140799211477, -90.99990999909998},
-		{17.254518784301908, -91.00351003510035},
-		{17.252826225698584, -91.45351453514535},
-		{16.917699622238842, -91.08271082710827},
-		{16.68751165218569, -90.71190711907118},
-		{16.470864150959187, -90.60030600306003},
-		{16.409932041239244, -90.43830438304383},
-		{16.069727761969503, -90.46350463504635},
-		{16.06634264476284, -91.74871748717487},
-		{15.252221956560149, -92.22752227522275},
-		{15.064347951590292, -92.08712087120871},
-		{14.830774864330479, -92.20232202322023},
-		{14.53965478455737, -92.22752227522275},
-		{15.616122056276524, -93.35793357933579},
-		{15.939400749512942, -93.87633876338764},
-		{16.201747333029402, -94.68994689946899},
-		{16.12896731308612, -95.25155251552515},
-		{15.751526744543085, -96.05436054360543},
-		{15.653358345549833, -96.55836558365583},
-		{15.917397487669618, -97.26397263972639},
-		{16.106964051242812, -98.01278012780128},
-		{16.565647432745777, -98.94878948789487},
-		{16.70612979682234, -99.69759697596976},
-		{17.17158341273864, -100.8280082800828},
-		{17.64888493887827, -101.66681666816667},
-		{17.91630919820473, -101.91881918819188},
-		{17.975548749321348, -102.47682476824768},
-		{18.292057208144428, -103.49923499234993},
-		{18.749048031044083, -103.91683916839168},
-		{19.3160551631603, -104.99324993249932},
-		{19.947379522203136, -105.49365493654936},
-		{20.434836399962762, -105.73125731257312},
-		{20.531312240352676, -105.39645396453965},
-		{20.8173546443158, -105.50085500855008},
-		{21.076316110625584, -105.27045270452705},
-		{21.421598065705325, -105.26685266852668},
-		{21.871818654191628, -105.60165601656016},
-		{22.26956992597465, -105.69165691656916},
-		{22.7739523897676, -106.030060300603},
-		{23.767484289923487, -106.90846908469085},
-		{24.54944636466287, -107.91647916479164},
-		{25.17230793068906, -108.40248402484025},
-		{25.580214554092066, -109.25929259292593},
-		{25.825635551575203, -109.44289442894429},
-		{26.44341944179139, -109.29169291692916},
-		{26.676992529051205, -109.80289802898028},
-		{27.162756848207493, -110.39330393303933},
-		{27.860090992780286, -110.64170641706417},
-		{27.94133380574023, -111.1781117811178},
-		{28.467719531376474, -111.76131761317613},
-		{28.9551764091361, -112.22932229322294},
-		{29.26660719214918, -112.27252272522725},
-		{30.021488329235268, -112.8089280892809},
-		{30.786524817941327, -113.16533165331653},
-		{31.170735620897702, -113.14733147331474},
-		{31.566794334077386, -113.87093870938709},
-		{31.52448036899409, -114.20574205742057},
-		{31.8003674213372, -114.77814778147781},
-		{31.39415335653753, -114.93654936549365},
-		{30.913466713191227, -114.7709477094771},
-		{30.161970693311815, -114.67374673746737},
-		{29.750678952702145, -114.33174331743317},
-		{29.061807601146015, -113.590135901359},
-		{28.826541955282863, -113.42453424534246},
-		{28.75545449394292, -113.27333273332734},
-		{28.411865097466517, -113.14013140131401},
-		{28.42540556629318, -112.96372963729637},
-		{27.78054073842368, -112.7621276212762},
-		{27.526656947923883, -112.45612456124562},
-		{27.171219641224155, -112.24372243722436},
-		{26.663452060224557, -111.61731617316173},
-		{25.73254482839195, -111.28611286112861},
-		{25.294172150128958, -110.98730987309872},
-		{24.825333417005993, -110.710107101071},
-		{24.298947691369733, -110.65610656106561},
-		{24.2650965193031, -110.17370173701737},
-		{23.81149081361012, -109.77049770497705},
-		{23.364655342330465, -109.41049410494105},
-		{23.18524413037727, -109.43209432094321},
-		{22.817958913454234, -109.85329853298532},
-		{22.82303658926422, -110.02970029700296},
-		{23.430665127860422, -110.2961029610296},
-		{24.0010573771833, -110.94770947709476},
-		{24.48512913773625, -111.67131671316713},
-		{24.739012928236065, -112.18252182521825},
-		{25.47019824487549, -112.15012150121501},
-		{26.011816997941736, -112.30132301323013},
-		{26.321555222351492, -112.77652776527765},
-		{26.768390693631133, -113.4641346413464},
-		{26.63975623977791, -113.59733597335973},
-		{26.900410264691033, -113.84933849338493},
-		{27.142446144967508, -114.4649446494465},
-		{27.7229937459104, -115.05535055350553},
-		{27.797466324457005, -114.98334983349834},
-		{27.74161189054705, -114.56934569345694},
-		{28.115667341883423, -114.19854198541985},
-		{28.56588793036974, -114.16254162541625},
-		{29.280147660975842, -114.9329493294933},
-		{29.556034713318965, -115.51975519755197},
-		{30.180588837948477, -115.8869588695887},
-		{30.8373015760413, -116.25776257762578},
-		{31.636189236814005, -116.72216722167221},
-		{32.5349378551833, -117.1289712897129},
-		{32.61279555093657, -115.99135991359914},
-		{32.72111930154982, -114.72054720547206},
-		{32.5247825035633, -114.81414814148141},
-		{32.03901818440701, -113.30573305733057},
-		{31.334913805420896, -111.02331023310232},
-		{31.341684039834234, -109.0360903609036},
-		{31.341684039834234, -108.24048240482405},
-		{31.754668339047242, -108.24048240482405},
-		{31.754668339047242, -106.50886508865088},
-		{31.399231032347515, -106.14166141661417},
-		{31.08441513212776, -105.63045630456304},
-		{30.644349895261442, -105.03645036450364},
-		{30.121349286831858, -104.70524705247053},
-		{29.57126774074895, -104.45684456844567},
-		{29.269992309355857, -103.93843938439385},
-		{28.970409436566086, -103.11043110431105},
-		{29.76083430432213, -102.48042480424805},
-		{29.779452448958793, -101.66321663216632},
-		{29.380008618572433, -100.95760957609576},
-		{28.696214942826302, -100.45720457204571},
-		{28.110589666073423, -100.11160111601116},
-		{27.54019741675053, -99.52119521195212},
-		{26.83947815497109, -99.30159301593015},
-		{26.37063942184811, -99.02079020790208},
-		{26.05920863883503, -98.23958239582396},
-		{25.839176020401865, -97.53037530375303},
-		{25.869642075261837, -97.14157141571415},
-	},
-	{
-		{41.855858083732684, 20.590405904059054},
-		{41.84739529071602, 20.716407164071654},
-		{42.05219488171919, 20.76320763207633},
-		{42.2062177146224, 21.353613536135356},
-		{42.245146562499045, 21.5768157681577},
-		{42.304386113615664, 21.91881918819189},
-		{42.31961914104565, 22.37962379623798},
-		{41.99972556501589, 22.880028800288017},
-		{41.337935151113086, 22.952029520295213},
-		{41.30408397904644, 22.761227612276116},
-		{41.129750442903244, 22.595625956259568},
-		{41.15006114614323, 22.055620556205582},
-		{40.9317210863134, 21.674016740167417},
-		{40.8420154803368, 21.018810188101895},
-		{41.08574391921661, 20.60480604806048},
-		{41.51565380446294, 20.464404644046454},
-		{41.855858083732684, 20.590405904059054},
-	},
-	{
-		{14.617512480310637, -12.169921699216985},
-		{14.79861625086717, -11.835118351183496},
-		{15.38762664482671, -11.665916659166584},
-		{15.411322465273358, -11.349113491134915},
-		{15.132050295723573, -10.650706507065053},
-		{15.330079652313415, -10.085500855008547},
-		{15.264069866783473, -9.700297002970018},
-		{15.485795043819962, -9.549095490954898},
-		{15.501028071249948, -5.53865538655387},
-		{16.201747333029402, -5.315453154531525},
-		{16.32530411107264, -5.48825488254883},
-		{20.641328549569266, -5.970659706597047},
-		{24.957352988065892, -6.4530645306452925},
-		{24.974278574099216, -4.923049230492296},
-		{22.79257053440425, -1.5498154981549703},
-		{20.61086249470928, 1.8234182341823555},
-		{20.142023761586316, 2.0610206102061},
-		{19.855981357623207, 2.683826838268402},
-		{19.693495731703337, 3.148231482314827},
-		{19.0570936968505, 3.1590315903159194},
-		{19.155262095843753, 4.267842678426803},
-		{16.8516898367089, 4.271442714427138},
-		{16.18482174699608, 3.724237242372425},
-		{15.568730415383229, 3.637836378363801},
-		{15.40962990667002, 2.74862748627487},
-		{15.323309417900091, 1.384213842138422},
-		{14.967872111200364, 1.0170101701017131},
-		{14.928943263323731, 0.37620376203761907},
-		{14.923865587513731, -0.26460264602644656},
-		{15.116817268293588, -0.5166051660516473},
-		{14.974642345613688, -1.0674106741067249},
-		{14.558272929194018, -1.9998199981999676},
-		{14.246842146180924, -2.1906219062190644},
-		{13.798314116297945, -2.9682296822968226},
-		{13.541045208591484, -3.1050310503104868},
-		{13.337938176191642, -3.5226352263522642},
-		{13.471650305854865, -4.00504005040051},
-		{13.227921866975066, -4.278642786427866},
-		{12.542435632625597, -4.426244262442623},
-		{11.713081916992905, -5.221852218522173},
-		{11.374570196326502, -5.196651966519653},
-		{10.951430545493508, -5.47025470254701},
-		{10.37088294455063, -5.405454054540542},
-		{10.221937787457406, -5.815858158581591},
-		{10.096688450810845, -6.049860498604971},
-		{10.524905777453839, -6.204662046620456},
-		{10.411504351030601, -6.492664926649269},
-		{10.430122495667248, -6.665466654666545},
-		{10.13900241589414, -6.849068490684914},
-		{10.147465208910802, -7.623076230762308},
-		{10.298102924607349, -7.900279002790029},
-		{10.20670476002742, -8.029880298802993},
-		{10.494439722593867, -8.33588335883357},
-		{10.7923300367803, -8.281882818828194},
-		{10.909116580410199, -8.407884078840794},
-		{10.810948181416947, -8.620286202862019},
-		{11.135919433256689, -8.580685806858071},
-		{11.393188340963164, -8.375483754837546},
-		{11.812942874589496, -8.785887858878596},
-		{12.088829926932618, -8.904689046890468},
-		{12.308862545365784, -9.127891278912784},
-		{12.334250924415755, -9.325893258932581},
-		{12.193768560339208, -9.56709567095669},
-		{12.06005643067597, -9.891098910989115},
-		{11.843408929449481, -10.164701647016471},
-		{11.92465174240941, -10.593105931059313},
-		{12.178535532909208, -10.870308703087034},
-		{12.210694146372518, -11.035910359103582},
-		{12.078674575312618, -11.298712987129875},
-		{12.076982016709294, -11.457114571145695},
-		{12.442574675029007, -11.514715147151463},
-		{12.754005458042101, -11.467914679146787},
-		{13.141601378205124, -11.55431554315544},
-		{13.422566106358246, -11.928719287192877},
-		{13.994650914284463, -12.12312123121231},
-		{14.617512480310637, -12.169921699216985},
-	},
-	{
-		{9.932510266287636, 98.55278552785529},
-		{10.675543493150386, 98.45558455584558},
-		{11.440579981856459, 98.76518765187654},
-		{12.032975493022661, 98.42678426784266},
-		{13.122983233568476, 98.50958509585098},
-		{13.640906166188074, 98.1027810278103},
-		{14.837545098743803, 97.77877778777787},
-		{16.100193816829474, 97.5987759877599},
-		{16.929547532462166, 97.16317163171635},
-		{16.426857627272554, 96.50436504365047},
-		{15.714290455269776, 95.37035370353703},
-		{15.80399606124638, 94.80874808748086},
-		{16.037569148506194, 94.18954189541898},
-		{17.27652204614523, 94.53514535145354},
-		{18.21419951239116, 94.32634326343265},
-		{19.366831921260257, 93.54153541535419},
-		{19.727346903769984, 93.6639366393664},
-		{19.855981357623207, 93.07713077130774},
-		{20.670102045825914, 92.36792367923681},
-		{21.475759941011944, 92.30312303123031},
-		{21.32342966671206, 92.65232652326523},
-		{22.041074514524837, 92.67392673926742},
-		{22.278032718991312, 93.16713167131672},
-		{22.70286492842766, 93.05913059130592},
-		{23.043069207697386, 93.28593285932862},
-		{24.07891507293658, 93.32553325533257},
-		{23.850419661486754, 94.10674106741067},
-		{24.674695701309446, 94.5531455314553},
-		{25.16215257906906, 94.60354603546034},
-		{26.001661646321736, 95.15435154351542},
-		{26.573746454247953, 95.12555125551256},
-		{27.26431036440742, 96.41796417964179},
-		{27.08320659385089, 97.13437134371344},
-		{27.69929792546374, 97.05157051570518},
-		{27.88209425462361, 97.4007740077401},
-		{28.26122738176997, 97.32877328773287},
-		{28.335699960316575, 97.91197911979123},
-		{27.74668956635705, 98.24678246782469},
-		{27.508038803287235, 98.68238682386823},
-		{26.74300231458116, 98.71118711187114},
-		{25.91872627475847, 98.67158671586719},
-		{25.084294883315792, 97.72477724777247},
-		{23.89781130238005, 97.60597605976062},
-		{24.06368204550658, 98.6607866078661},
-		{23.142930165293976, 98.89838898388984},
-		{22.948285925910795, 99.53199531995318},
-		{22.118932210278103, 99.24039240392403},
-		{21.743184200338405, 99.98199981999824},
-		{21.55869531257521, 100.41760417604178},
-		{21.84981539234832, 101.14841148411483},
-		{21.43683109313531, 101.18081180811811},
-		{20.786888589455813, 100.32760327603279},
-		{20.41791081392944, 100.11520115201154},
-		{20.18603028527295, 99.54279542795427},
-		{19.752735282819955, 98.95958959589598},
-		{19.708728759133322, 98.25398253982542},
-		{18.62718381160417, 97.79677796777969},
-		{18.44608004104765, 97.37557375573755},
-		{17.56764212591834, 97.85797857978582},
-		{16.838149367882238, 98.49518495184952},
-		{16.178051512582755, 98.90198901989021},
-		{15.308076390470106, 98.53838538385384},
-		{15.123587502706911, 98.19278192781928},
-		{14.622590156120637, 98.43038430384303},
-		{13.827087612554593, 99.09639096390964},
-		{13.268543273455023, 99.21159211592118},
-		{12.804782216142058, 99.19719197191972},
-		{11.8924931289461, 99.58599585995859},
-		{10.95989333851017, 99.03879038790387},
-		{9.932510266287636, 98.55278552785529},
-	},
-	{
-		{41.877861345575994, 19.369993699936998},
-		{41.95571904132926, 19.161191611916138},
-		{42.280690293169016, 18.880388803888053},
-		{42.48041220836218, 18.448384483844848},
-		{42.64966806869539, 18.55998559985599},
-		{43.1997496147783, 18.707587075870777},
-		{43.4333227020381, 19.031590315903173},
-		{43.5230283080147, 19.218792187921878},
-		{43.35207988907818, 19.485194851948535},
-		{43.213290083604946, 19.629196291962927},
-		{43.106658891595046, 19.956799567995688},
-		{42.898474183385204, 20.338403384033853},
-		{42.812153694615276, 20.25920259202593},
-		{42.58873595897545, 20.072000720007196},
-		{42.50072291160218, 19.801998019980203},
-		{42.688596916572024, 19.737197371973735},
-		{42.19606236300241, 19.30519305193053},
-		{41.877861345575994, 19.369993699936998},
-	},
-	{
-		{49.29803826258352, 87.7526775267753},
-		{49.4706792401234, 88.80388803888042},
-		{50.332191569219376, 90.71190711907121},
-		{50.80272286094568, 92.23472234722351},
-		{50.49467719513926, 93.10593105931059},
-		{50.48113672631261, 94.14634146341467},
-		{50.01399055179296, 94.8159481594816},
-		{49.97675426251966, 95.8131581315813},
-		{49.72625558922651, 97.26037260372607},
-		{50.42189717519598, 98.23238232382323},
-		{51.01090756915552, 97.82557825578255},
-		{52.04675343439472, 98.86238862388626},
-		{51.63376913518171, 99.98199981999824},
-		{51.51698259155178, 100.88920889208896},
-		{51.259713683845334, 102.06642066420665},
-		{50.509910222569246, 102.25722257222571},
-		{50.090155688942914, 103.67563675636757},
-		{50.27464457670611, 104.62244622446224},
-		{50.406664147765994, 105.8860588605886},
-		{50.27464457670611, 106.89046890468904},
-		{49.793957933359806, 107.86967869678699},
-		{49.28280523515353, 108.47448474484747},
-		{49.29296058677353, 109.40329403294032},
-		{49.13047496085365, 110.66330663306633},
-		{49.37758851694012, 111.58131581315814},
-		{49.543459260066655, 112.89892898928991},
-		{50.247563639052785, 114.36414364143644},
-		{50.14093244704286, 114.9617496174962},
-		{49.805805843583116, 115.48735487354872},
-		{49.888741215146396, 116.67896678966792},
-		{49.13386007806032, 116.19296192961929},
-		{48.13525050209442, 115.48375483754836},
-		{47.72734387869143, 115.74295742957429},
-		{47.85259321533799, 116.30816308163082},
-		{47.69687782383146, 117.29457294572944},
-		{48.067548157961156, 118.0649806498065},
-		{47.7476545819314, 118.86778867788678},
-		{47.04862787875528, 119.77139771397714},
-		{46.69319057205557, 119.66339663396633},
-		{46.804899439875484, 118.8749887498875},
-		{46.67287986881557, 117.42057420574207},
-		{46.3885300234558, 116.71856718567187},
-		{45.72673960955298, 115.98415984159845},
-		{45.33914368938996, 114.46134461344616},
-		{44.80937284654705, 113.46413464134645},
-		{45.01247987894688, 112.43452434524346},
-		{45.10218548492348, 111.87291872918729},
-		{44.457320657053984, 111.34731347313476},
-		{44.07310985409761, 111.66771667716677},
-		{43.74306092644788, 111.82971829718298},
-		{43.4062417643848, 111.13131131311314},
-		{42.87139324573188, 110.41130411304113},
-		{42.519341056238815, 109.24489244892447},
-		{42.48210476696552, 107.74367743677436},
-		{42.135130253282455, 106.13086130861308},
-		{41.596896617422885, 104.96444964449648},
-		{41.908327400435965, 104.52164521645216},
-		{41.90663484183264, 103.3120331203312},
-		{42.51426338042883, 101.83241832418327},
-		{42.66320853752204, 100.84600846008459},
-		{42.52441873204883, 99.51759517595178},
-		{42.74952902629197, 97.45117451174514},
-		{42.72583320584532, 96.34956349563498},
-		{43.319921275614874, 95.76275762757626},
-		{44.24067315582748, 95.30555305553054},
-		{44.352382023647394, 94.68994689946902},
-		{44.97524358967358, 93.48033480334806},
-		{45.11572595375013, 92.13392133921343},
-		{45.286674372686676, 90.9459094590946},
-		{45.719969375139655, 90.58590585905858},
-		{46.887834811438736, 90.97110971109714},
-		{47.69349270662478, 90.27990279902798},
-		{48.069240716564494, 88.85428854288546},
-		{48.5990115594074, 88.01548015480154},
-		{49.29803826258352, 87.7526775267753},
-	},
-	{
-		{-11.520670030945595, 34.558545585455875},
-		{-11.439427217985653, 35.31095310953111},
-		{-11.720391946138761, 36.51336513365135},
-		{-11.5951426094922, 36.77616776167761},
-		{-11.56806167183889, 37.47097470974711},
-		{-11.26847879904912, 37.827378273782756},
-		{-11.285404385082444, 38.428584285842874},
-		{-10.896115906316084, 39.51939519395194},
-		{-10.317260863976529, 40.315003150031515},
-		{-10.765788893859508, 40.4770047700477},
-		{-11.761013352618733, 40.43740437404375},
-		{-12.639451267748044, 40.55980559805599},
-		{-14.2016828586235, 40.599405994059936},
-		{-14.692524853589774, 40.775807758077576},
-		{-15.40678458419589, 40.4770047700477},
-		{-16.100733611562006, 40.088200882008834},
-		{-16.720210060381532, 39.450994509945104},
-		{-17.10103574613123, 38.536585365853654},
-		{-17.58680006528752, 37.40977409774098},
-		{-18.65988221980001, 36.2829628296283},
-		{-18.842678548959867, 35.897758977589774},
-		{-19.553553162359307, 35.19935199351994},
-		{-19.78374113241246, 34.785347853478555},
-		{-20.496308304415237, 34.70254702547027},
-		{-21.254574558707986, 35.177751777517784},
-		{-21.840199835460858, 35.37215372153722},
-		{-22.13978270825062, 35.38655386553867},
-		{-22.090698508753995, 35.56295562955631},
-		{-23.070689940083227, 35.53415534155343},
-		{-23.53614355599953, 35.37215372153722},
-		{-23.707091974936063, 35.606156061560625},
-		{-24.12176883275241, 35.45855458554587},
-		{-24.47889869805546, 35.04095040950409},
-		{-24.815717860118532, 34.21654216542166},
-		{-25.35733661318477, 33.01413014130142},
-		{-25.728006947314483, 32.57492574925749},
-		{-26.147761480940822, 32.66132661326614},
-		{-26.215463825074103, 32.916929169291706},
-		{-26.741849550710356, 32.83052830528305},
-		{-26.733386757693694, 32.07092070920709},
-		{-26.291628962224046, 31.984519845198463},
-		{-25.84310093234106, 31.836918369183707},
-		{-25.484278508434677, 31.754117541175418},
-		{-24.368882388838877, 31.93051930519306},
-		{-23.659700334042768, 31.67131671316713},
-		{-22.251491576070535, 31.19251192511925},
-		{-21.115784753234763, 32.24372243722439},
-		{-20.394754788215323, 32.51012510125102},
-		{-20.30504918223872, 32.66132661326614},
-		{-19.716038788279178, 32.77292772927731},
-		{-19.419841032696084, 32.6109261092611},
-		{-18.671730130023334, 32.65412654126541},
-		{-17.979473661260542, 32.848528485284874},
-		{-16.713439825968194, 32.848528485284874},
-		{-16.391853691335115, 32.32652326523265},
-		{-16.319073671391834, 31.851318513185134},
-		{-16.071960115305373, 31.635316353163546},
-		{-15.86039028988887, 31.174511745117456},
-		{-15.880700993128855, 30.33930339303393},
-		{-15.508338100395804, 30.274502745027462},
-		{-14.795770928393026, 30.18090180901811},
-		{-13.971494888570348, 33.21573215732158},
-		{-14.452181531916636, 33.788137881378816},
-		{-14.360783367336708, 34.06534065340654},
-		{-14.61297459923317, 34.46134461344613},
-		{-15.014110988222853, 34.5189451894519},
-		{-15.477872045535833, 34.306543065430674},
-		{-16.183668983125273, 34.382143821438234},
-		{-16.80145287334146, 35.03375033750339},
-		{-16.107503845975344, 35.339753397534},
-		{-15.897626579162164, 35.771757717577174},
-		{-14.611282040629845, 35.68535685356855},
-		{-13.888559517007067, 35.26775267752677},
-		{-13.565280823770664, 34.90774907749079},
-		{-13.58051385120065, 34.558545585455875},
-		{-12.280628843841669, 34.281342813428154},
-		{-11.520670030945595, 34.558545585455875},
-	},
-	{
-		{14.617512480310637, -12.169921699216985},
-		{15.302998714660106, -12.832328323283235},
-		{16.03926170710953, -13.437134371343717},
-		{16.304993407832654, -14.099540995409939},
-		{16.597806046209087, -14.57834578345782},
-		{16.5876506945891, -15.136351363513626},
-		{16.369310634759273, -15.622356223562235},
-		{16.4556311235292, -16.11916119161191},
-		{16.13573754749946, -16.46476464764646},
-		{16.67397118335903, -16.551165511655114},
-		{17.16650573692864, -16.27036270362703},
-		{18.10926087898457, -16.147961479614793},
-		{19.096022544727134, -16.2559625596256},
-		{19.593634774106746, -16.378363783637838},
-		{20.092939562089697, -16.277562775627757},
-		{20.568548529625986, -16.536765367653658},
-		{21.000150973475655, -17.062370623706244},
-		{21.33358501833206, -16.846368463684627},
-		{21.32681478391872, -12.929529295292951},
-		{22.77056727256094, -13.12033120331202},
-		{23.28510508797386, -12.875528755287547},
-		{23.374810693950465, -11.935919359193576},
-		{25.933959302188455, -11.968319683196825},
-		{25.88148998548516, -8.688686886868851},
-		{27.39632993546732, -8.685086850868515},
-		{24.974278574099216, -4.923049230492296},
-		{24.957352988065892, -6.4530645306452925},
-		{20.641328549569266, -5.970659706597047},
-		{16.32530411107264, -5.48825488254883},
-		{16.201747333029402, -5.315453154531525},
-		{15.501028071249948, -5.53865538655387},
-		{15.485795043819962, -9.549095490954898},
-		{15.264069866783473, -9.700297002970018},
-		{15.330079652313415, -10.085500855008547},
-		{15.132050295723573, -10.650706507065053},
-		{15.411322465273358, -11.349113491134915},
-		{15.38762664482671, -11.665916659166584},
-		{14.79861625086717, -11.835118351183496},
-		{14.617512480310637, -12.169921699216985},
-	},
-	{
-		{-11.520670030945595, 34.558545585455875},
-		{-12.280628843841669, 34.281342813428154},
-		{-13.58051385120065, 34.558545585455875},
-		{-13.565280823770664, 34.90774907749079},
-		{-13.888559517007067, 35.26775267752677},
-		{-14.611282040629845, 35.68535685356855},
-		{-15.897626579162164, 35.771757717577174},
-		{-16.107503845975344, 35.339753397534},
-		{-16.80145287334146, 35.03375033750339},
-		{-16.183668983125273, 34.382143821438234},
-		{-15.477872045535833, 34.306543065430674},
-		{-15.014110988222853, 34.5189451894519},
-		{-14.61297459923317, 34.46134461344613},
-		{-14.360783367336708, 34.06534065340654},
-		{-14.452181531916636, 33.788137881378816},
-		{-13.971494888570348, 33.21573215732158},
-		{-13.712533422260549, 32.68652686526866},
-		{-12.783318749031267, 32.992529925299266},
-		{-12.436344235348216, 33.30573305733057},
-		{-11.606990519715524, 33.1149311493115},
-		{-10.796254948719493, 33.31653316533166},
-		{-10.52544557218637, 33.485734857348575},
-		{-9.677473711917031, 33.23013230132301},
-		{-9.230638240637376, 32.75852758527586},
-		{-9.416819687003908, 33.74133741337414},
-		{-9.694399297950355, 33.93933939339394},
-		{-10.159852913866658, 34.281342813428154},
-		{-11.520670030945595, 34.558545585455875},
-	},
-	{
-		{6.222421807783874, 102.14202142021423},
-		{6.127638525997284, 102.37242372423725},
-		{5.525087663211082, 102.96282962829628},
-		{4.854834456291613, 103.38043380433805},
-		{4.181196132165468, 103.43803438034382},
-		{3.7258978678691506, 103.33363333633338},
-		{3.3823084713927614, 103.4308343083431},
-		{2.7916055188298827, 103.50283502835032},
-		{2.515718466486774, 103.8556385563856},
-		{1.6305103169441253, 104.24804248042483},
-		{1.29369115488106, 104.23004230042301},
-		{1.2259888107477792, 103.52083520835208},
-		{1.9673294790071907, 102.57402574025741},
-		{2.7611394639699114, 101.389613896139},
-		{3.2705996035728475, 101.27441274412746},
-		{3.9391602518889925, 100.69480694806947},
-		{4.7668214089183465, 100.5580055800558},
-		{5.311825279191254, 100.1980019800198},
-		{6.041318037227342, 100.3060030600306},
-		{6.4644576880603495, 100.08640086400862},
-		{6.642176341410206, 100.25920259202593},
-		{6.2054962217505505, 101.07641076410766},
-		{5.690958406337614, 101.15561155611556},
-		{5.81113006717419, 101.81441814418145},
-		{6.222421807783874, 102.14202142021423},
-	},
-	{
-		{4.137189608478835, 117.88137881378816},
-		{4.306445468812029, 117.01377013770139},
-		{4.306445468812029, 115.86535865358655},
-		{3.1690460873729194, 115.519755197552},
-		{2.8220715736898683, 115.13455134551344},
-		{1.4307884017509451, 114.619746197462},
-		{1.2175260177311173, 113.80613806138064},
-		{1.4984907458842258, 112.85932859328597},
-		{1.4104776985109595, 112.38052380523806},
-		{0.9044026761146995, 111.79731797317976},
-		{0.9771826960579659, 111.160111601116},
-		{0.7723831050548, 110.51570515705157},
-		{1.337697678567693, 109.83169831698319},
-		{2.006258326883838, 109.66249662496625},
-		{1.6643614890107727, 110.39690396903973},
-		{1.850542935377291, 111.16731167311673},
-		{2.6968222370432926, 111.36891368913689},
-		{2.886388800616473, 111.79731797317976},
-		{3.1030363018429767, 112.99612996129963},
-		{3.8934611695990213, 113.71253712537128},
-		{4.526478087245195, 114.2057420574206},
-		{4.006862596022273, 114.65934659346595},
-		{4.348759433895339, 114.86814868148684},
-		{4.316600820432029, 115.3469534695347},
-		{4.954695413888189, 115.40455404554046},
-		{5.447229967457815, 115.45135451354514},
-		{6.14287155342727, 116.2217622176222},
-		{6.9248336281666525, 116.7257672576726},
-		{6.928218745373314, 117.12897128971292},
-		{6.422143722977054, 117.64377643776442},
-		{5.987156161920723, 117.69057690576909},
-		{5.707883992370938, 118.34938349383492},
-		{5.408301119581168, 119.18099180991811},
-		{5.015627523608146, 119.10899108991089},
-		{4.9665433241115124, 118.43938439384397},
-		{4.477393887748562, 118.61938619386194},
-		{4.137189608478835, 117.88137881378816},
-	},
-	{
-		{-28.576583076722258, 16.34596345963459},
-		{-27.821701939636178, 15.60075600756008},
-		{-27.090516622996752, 15.211952119521214},
-		{-26.117295426080844, 14.98874988749887},
-		{-25.392880343854742, 14.743947439474397},
-		{-23.852652014822617, 14.409144091440908},
-		{-22.656013082266888, 14.383943839438388},
-		{-22.11100921199398, 14.257942579425787},
-		{-21.699717471384297, 13.869138691386922},
-		{-20.87205631435495, 13.350733507335093},
-		{-19.673724823195883, 12.825128251282507},
-		{-19.04578558135971, 12.609126091260919},
-		{-18.069179267237132, 11.795517955179548},
-		{-17.302450219927735, 11.734317343173444},
-		{-17.111191097751217, 12.21672216722169},
-		{-16.941935237418022, 12.814328143281443},
-		{-16.97070873367467, 13.462334623346237},
-		{-17.42262188076431, 14.05994059940599},
-		{-17.353226978027692, 14.211142111421111},
-		{-17.30922045434106, 18.26478264782648},
-		{-17.78990709768736, 18.955989559895613},
-		{-17.93038946176391, 21.378813788137876},
-		{-17.5224828383609, 23.214832148321477},
-		{-17.29567998551441, 24.035640356403576},
-		{-17.353226978027692, 24.68364683646837},
-		{-17.578337272270858, 25.0760507605076},
-		{-17.661272643834124, 25.083250832508327},
-		{-17.886382938077276, 24.521645216452185},
-		{-17.88976805528395, 24.21564215642158},
-		{-18.280749092653636, 23.57843578435785},
-		{-17.869457352043966, 23.196831968319685},
-		{-18.219816982933693, 21.656016560165597},
-		{-18.25197559639699, 20.910809108091087},
-		{-21.81481145641088, 20.882008820088203},
-		{-21.84866262847752, 19.895598955989556},
-		{-24.768326219225237, 19.895598955989556},
-		{-28.461489091695682, 19.895598955989556},
-		{-28.97264178990195, 19.00279002790029},
-		{-29.045421809845223, 18.46638466384664},
-		{-28.855855246272036, 17.836378363783638},
-		{-28.783075226328762, 17.38637386373864},
-		{-28.356550458289092, 17.217172171721728},
-		{-28.082355964549308, 16.82476824768247},
-		{-28.576583076722258, 16.34596345963459},
-	},
-	{
-		{-21.080241022564778, 165.779857798578},
-		{-21.699717471384297, 166.6006660066601},
-		{-22.160093411490607, 167.1190711907119},
-		{-22.40043673316375, 166.74106741067413},
-		{-22.12962735663063, 166.19026190261906},
-		{-21.679406768144318, 165.4738547385474},
-		{-21.149635925301396, 164.82944829448297},
-		{-20.44553154631528, 164.16704167041672},
-		{-20.105327267045553, 164.03024030240306},
-		{-20.12056029447554, 164.45864458644587},
-		{-20.460764573745266, 165.02025020250204},
-		{-20.79927629441167, 165.45945459454595},
-		{-21.080241022564778, 165.779857798578},
-	},
-	{
-		{11.939884769839395, 2.1546215462154805},
-		{12.625371004188864, 2.1762217622176365},
-		{12.852173857035353, 1.0242102421024413},
-		{13.336245617588304, 0.991809918099193},
-		{13.989573238474463, 0.4302043020430233},
-		{14.44487150277078, 0.2970029700296948},
-		{14.928943263323731, 0.37620376203761907},
-		{14.967872111200364, 1.0170101701017131},
-		{15.323309417900091, 1.384213842138422},
-		{15.40962990667002, 2.74862748627487},
-		{15.568730415383229, 3.637836378363801},
-		{16.18482174699608, 3.724237242372425},
-		{16.8516898367089, 4.271442714427138},
-		{19.155262095843753, 4.267842678426803},
-		{19.600405008520084, 5.679056790567898},
-		{21.565465546988534, 8.573485734857343},
-		{23.47128653434038, 12.000720007200073},
-		{23.039684090490724, 13.58113581135811},
-		{22.491295103011154, 14.142741427414279},
-		{22.86365799574419, 14.851948519485205},
-		{21.308196639282073, 15.096750967509678},
-		{21.049235172972274, 15.471154711547115},
-		{20.731034155545856, 15.485554855548571},
-		{20.387444759069467, 15.90315903159032},
-		{19.957534873823136, 15.687156871568732},
-		{17.928157108428053, 15.301953019530202},
-		{16.626579542465734, 15.247952479524798},
-		{15.683824400409804, 13.973539735397367},
-		{14.3670138070175, 13.541535415354161},
-		{13.996343472887787, 13.955539555395575},
-		{13.353171203621628, 13.955539555395575},
-		{13.331167941778318, 14.59634596345964},
-		{12.858944091448677, 14.49554495544956},
-		{12.801397098935396, 14.214742147421475},
-		{12.483196081508979, 14.182341823418227},
-		{12.461192819665655, 13.995139951399523},
-		{13.55627823602147, 13.318333183331845},
-		{13.596899642501441, 13.084330843308436},
-		{13.036662744798548, 12.303123031230314},
-		{13.32947538317498, 11.52911529115292},
-		{13.387022375688275, 10.989109891098906},
-		{13.246540011611714, 10.701107011070121},
-		{13.277006066471685, 10.114301143011431},
-		{12.850481298432015, 9.523895238952406},
-		{12.826785477985368, 9.01629016290164},
-		{13.343015852001642, 7.803078030780313},
-		{13.09759485451849, 7.331473314733159},
-		{13.114520440551814, 6.82026820268203},
-		{13.491961009094851, 6.445864458644593},
-		{13.866016460431226, 5.441454414544154},
-		{13.747537358197988, 4.368643686436883},
-		{13.530889856971484, 4.109441094410954},
-		{12.955419931838605, 3.9690396903968974},
-		{12.552590984245583, 3.681036810368113},
-		{11.660612600289625, 3.6126361263612807},
-		{12.236082525422503, 2.8494284942849504},
-		{12.232697408215842, 2.489424894248941},
-		{11.939884769839395, 2.1546215462154805},
-	},
-	{
-		{4.771899084728332, 8.501485014850147},
-		{4.411384102218619, 7.461074610746124},
-		{4.463853418921914, 7.083070830708323},
-		{4.240435683282087, 6.6978669786697935},
-		{4.262438945125396, 5.898658986589879},
-		{4.888685628358246, 5.36225362253623},
-		{5.61140815198101, 5.034650346503469},
-		{6.269813448677169, 4.3254432544325425},
-		{6.2579655384538455, 3.5730357303573044},
-		{6.259658097057169, 2.691026910269102},
-		{7.870973887429244, 2.74862748627487},
-		{8.50737592228208, 2.72342723427235},
-		{9.137007722721592, 2.9106291062910827},
-		{9.443360829924686, 3.2202322023220233},
-		{10.062837278744198, 3.706237062370633},
-		{10.331954096673996, 3.6018360183601885},
-		{10.734783044267004, 3.796237962379621},
-		{11.327178555433207, 3.5730357303573044},
-		{11.660612600289625, 3.6126361263612807},
-		{12.552590984245583, 3.681036810368113},
-		{12.955419931838605, 3.9690396903968974},
-		{13.530889856971484, 4.109441094410954},
-		{13.747537358197988, 4.368643686436883},
-		{13.866016460431226, 5.441454414544154},
-		{13.491961009094851, 6.445864458644593},
-		{13.114520440551814, 6.82026820268203},
-		{13.09759485451849, 7.331473314733159},
-		{13.343015852001642, 7.803078030780313},
-		{12.826785477985368, 9.01629016290164},
-		{12.850481298432015, 9.523895238952406},
-		{13.277006066471685, 10.114301143011431},
-		{13.246540011611714, 10.701107011070121},
-		{13.387022375688275, 10.989109891098906},
-		{13.32947538317498, 11.52911529115292},
-		{13.036662744798548, 12.303123031230314},
-		{13.596899642501441, 13.084330843308436},
-		{13.55627823602147, 13.318333183331845},
-		{12.461192819665655, 13.995139951399523},
-		{12.483196081508979, 14.182341823418227},
-		{12.085444809725956, 14.578345783457848},
-		{11.904341039169424, 14.466744667446676},
-		{11.572599552916358, 14.416344163441636},
-		{10.799100271193623, 13.57393573935741},
-		{10.161005677737464, 13.307533075330753},
-		{9.641390186514528, 13.167131671316724},
-		{9.4179724508747, 12.954729547295472},
-		{8.71725318909526, 12.753127531275311},
-		{8.305961448485576, 12.220322203222025},
-		{7.7998864260893015, 12.06552065520657},
-		{7.397057478496279, 11.838718387183889},
-		{6.980688062076609, 11.745117451174508},
-		{6.643868900013544, 11.057510575105766},
-		{7.055160640623214, 10.495904959049597},
-		{7.038235054589904, 10.117901179011795},
-		{6.45430233644035, 9.523895238952406},
-		{6.444146984820364, 9.232292322923229},
-		{5.479388580921125, 8.757087570875711},
-		{4.771899084728332, 8.501485014850147},
-	},
-	{
-		{11.088527792363394, -85.71145711457115},
-		{11.40334369258315, -86.0570605706057},
-		{11.806172640176172, -86.5250652506525},
-		{12.144684360842575, -86.74466744667447},
-		{12.457807702458993, -87.16587165871658},
-		{12.909720849548648, -87.66987669876698},
-		{13.063743682451857, -87.55827558275583},
-		{12.914798525358634, -87.39267392673926},
-		{12.984193428095253, -87.3170731707317},
-		{13.026507393178548, -87.00747007470075},
-		{13.255002804628376, -86.88146881468815},
-		{13.263465597645038, -86.73386733867338},
-		{13.754307592611312, -86.75546755467555},
-		{13.77800341305796, -86.52146521465214},
-		{13.771233178644636, -86.31266312663126},
-		{14.038657437971096, -86.09666096660966},
-		{13.835550405571254, -85.80145801458015},
-		{13.960799742217816, -85.6970569705697},
-		{14.079278844451068, -85.51345513455134},
-		{14.355165896794176, -85.16425164251642},
-		{14.559965487797356, -85.14985149851498},
-		{14.551502694780694, -85.05265052650526},
-		{14.790153457850508, -84.9230492304923},
-		{14.818926954107155, -84.81864818648187},
-		{14.66659667980727, -84.64944649446494},
-		{14.620897597517299, -84.44784447844478},
-		{14.749532051370537, -84.22824228242283},
-		{14.749532051370537, -83.97623976239763},
-		{14.879859063827098, -83.6270362703627},
-		{15.016956310696997, -83.49023490234902},
-		{14.996645607457012, -83.14823148231481},
-		{14.900169767067084, -83.23463234632347},
-		{14.676752031427256, -83.2850328503285},
-		{14.311159373107543, -83.18063180631806},
-		{13.969262535234478, -83.4110341103411},
-		{13.568126146244794, -83.5190351903519},
-		{13.126368350775138, -83.55143551435513},
-		{12.869099443068677, -83.49743497434974},
-		{12.41887885458236, -83.47223472234722},
-		{12.320710455589108, -83.6270362703627},
-		{11.8924931289461, -83.72063720637206},
-		{11.628453986826315, -83.65223652236521},
-		{11.372877637723178, -83.85383853838538},
-		{11.103760819793393, -83.81063810638106},
-		{10.939582635270185, -83.65583655836558},
-		{10.726320251250343, -83.89343893438934},
-		{10.794022595383623, -84.18864188641886},
-		{10.998822186386803, -84.35424354243543},
-		{11.083450116553408, -84.67464674646746},
-		{10.953123104096832, -84.90144901449014},
-		{11.217162246216631, -85.56025560255603},
-		{11.088527792363394, -85.71145711457115},
-	},
-	{
-		{53.482043130020244, 6.906669066690682},
-		{53.143531409353855, 7.0902709027090225},
-		{52.227857204951235, 6.841868418684186},
-		{51.85210919501152, 6.589865898658985},
-		{51.85210919501152, 5.988659886598867},
-		{50.80441541954902, 6.15786157861578},
-		{51.037988506808844, 5.607056070560702},
-		{51.4746686264685, 4.973449734497365},
-		{51.26648391825866, 4.048240482404822},
-		{51.34603417261526, 3.313833138331404},
-		{51.62022866635503, 3.828638286382869},
-		{53.091062092650574, 4.707047070470708},
-		{53.510816626276906, 6.07506075060752},
-		{53.482043130020244, 6.906669066690682},
-	},
-	{
-		{69.5579647444677, 31.10251102511026},
-		{69.156828355478, 29.399693996939988},
-		{69.06543019089808, 28.593285932859345},
-		{69.76614945267751, 29.01449014490146},
-		{70.16390072446053, 27.7328773287733},
-		{69.82538900379414, 26.181261812618146},
-		{69.09251112855138, 25.68805688056881},
-		{68.6490607744784, 24.73404734047341},
-		{68.89109665475486, 23.66123661236614},
-		{68.84201245525826, 22.358023580235823},
-		{69.37009073949783, 21.245612456124576},
-		{69.10605159737803, 20.644406444064458},
-		{69.06543019089808, 20.02520025200252},
-		{68.40702489420191, 19.877598775987764},
-		{68.56781796151846, 17.994779947799486},
-		{68.01096618102223, 17.728377283772858},
-		{68.0143512982289, 16.76716767167673},
-		{67.30178412622611, 16.108361083610845},
-		{66.19315824104366, 15.10755107551077},
-		{64.78664204167474, 13.55593555935559},
-		{64.44474520380167, 13.919539195391962},
-		{64.04868649062199, 13.570335703357046},
-		{64.06561207665531, 12.580325803258035},
-		{63.12793461040938, 11.93231932319324},
-		{61.80096866539709, 11.993519935199345},
-		{61.293201084397495, 12.630726307263075},
-		{60.11856541368506, 12.29952299522995},
-		{59.43307917933559, 11.467914679146787},
-		{58.85591669559939, 11.028710287102882},
-		{59.470315468608916, 10.355503555035568},
-		{58.31260538392981, 8.382683826838274},
-		{58.07903229667001, 7.0470704707047105},
-		{58.588492436272915, 5.6646566465664705},
-		{59.663267149388744, 5.308253082530825},
-		{61.97022452573027, 4.991449914499157},
-		{62.61508935359977, 5.913059130591307},
-		{63.45459842085246, 8.551885518855187},
-		{64.48536661028164, 10.528305283052845},
-		{65.88003489942723, 12.357123571235718},
-		{67.81124426582905, 14.761947619476189},
-		{68.56274028570847, 16.435964359643606},
-		{69.81692621077748, 19.182791827918294},
-		{70.25529888904047, 21.378813788137876},
-		{70.2028295723372, 23.02403024030241},
-		{71.03049072936655, 24.546845468454705},
-		{70.9864842056799, 26.36846368463685},
-		{71.18620612087308, 28.164881648816504},
-		{70.45332824563032, 31.29331293312933},
-		{70.18590398630387, 30.00450004500047},
-		{69.5579647444677, 31.10251102511026},
-	},
-	{
-		{77.85319445939787, 24.723247232472346},
-		{77.44528783599485, 22.491224912249123},
-		{77.67716836465134, 20.727207272072718},
-		{77.93443727235781, 21.414814148141488},
-		{78.25433084838757, 20.81360813608137},
-		{78.45574532218406, 22.88362883628838},
-		{78.07999731224434, 23.279632796327974},
-		{77.85319445939787, 24.723247232472346},
-	},
-	{
-		{79.70146845423643, 18.25038250382505},
-		{78.95674266877033, 21.544415444154453},
-		{78.56237651419399, 19.02799027990281},
-		{77.82611352174455, 18.469984699847004},
-		{77.6382395167747, 17.59517595175953},
-		{76.80888580114201, 17.119971199712012},
-		{76.76995695326538, 15.913959139591412},
-		{77.38097060906823, 13.761137611376114},
-		{77.73640791576797, 14.668346683466837},
-		{78.02414287833439, 13.170731707317088},
-		{78.86872962139707, 11.223112231122315},
-		{79.6523842547398, 10.445504455044556},
-		{80.01120667864619, 13.170731707317088},
-		{79.66084704775646, 13.717937179371802},
-		{79.67438751658311, 15.143551435514354},
-		{80.01628435445618, 15.521555215552155},
-		{80.05013552652282, 16.990369903699047},
-		{79.70146845423643, 18.25038250382505},
-	},
-	{
-		{80.40726539182587, 25.4468544685447},
-		{80.05690576093615, 27.4088740887409},
-		{79.51867212507658, 25.92565925659258},
-		{79.40019302284331, 23.02403024030241},
-		{79.56606376596987, 20.07560075600756},
-		{79.84195081831298, 19.895598955989556},
-		{79.86056896294964, 18.462784627846275},
-		{80.31925234445261, 17.36837368373685},
-		{80.59852451400239, 20.457204572045725},
-		{80.35818119232924, 21.908019080190797},
-		{80.657764065119, 22.919629196291964},
-		{80.40726539182587, 25.4468544685447},
-	},
-	{
-		{27.87701657881361, 88.11988119881198},
-		{27.44541413496394, 88.04428044280445},
-		{26.810704658714442, 88.17388173881739},
-		{26.414645945534744, 88.0586805868059},
-		{26.397720359501434, 87.22707227072272},
-		{26.631293446761248, 86.02466024660248},
-		{26.726076728547838, 85.25065250652506},
-		{27.235536868150774, 84.67464674646749},
-		{27.36417132200401, 83.30303303033031},
-		{27.92610077831023, 81.9998199982},
-		{28.41525021467318, 81.05661056610569},
-		{28.794383341819554, 80.08820088200883},
-		{29.73036824946216, 80.4770047700477},
-		{30.18397395515514, 81.1106111061111},
-		{30.422624718224952, 81.52461524615245},
-		{30.11457905241852, 82.32742327423273},
-		{29.4629439901357, 83.33543335433353},
-		{29.320769067455814, 83.90063900639007},
-		{28.840082424109525, 84.23544235442353},
-		{28.64205306751967, 85.01305013050131},
-		{28.20368038925669, 85.82305823058232},
-		{27.973492419203538, 86.95346953469533},
-		{27.87701657881361, 88.11988119881198},
-	},
-	{
-		{-40.91872041221927, 173.01953019530197},
-		{-41.33170471143229, 173.24633246332462},
-		{-40.927183205235934, 173.9591395913959},
-		{-41.348630297465604, 174.24714247142475},
-		{-41.770077389695274, 174.24714247142475},
-		{-42.233838447008246, 173.87633876338765},
-		{-42.97010143945767, 173.22113221132213},
-		{-43.37293038705069, 172.709927099271},
-		{-43.853617030396975, 173.0807308073081},
-		{-43.865464940620306, 172.31032310323104},
-		{-44.24290550916334, 171.45351453514536},
-		{-44.897925688652826, 171.18351183511834},
-		{-45.90838317484204, 170.61830618306186},
-		{-46.35521864612169, 169.82989829898298},
-		{-46.6412610500848, 169.3330933309333},
-		{-46.619257788241484, 168.4114841148412},
-		{-46.29090141919507, 167.76347763477634},
-		{-46.21981395785513, 166.67626676266764},
-		{-45.85252874093208, 166.51066510665106},
-		{-45.11118807267266, 167.04707047070474},
-		{-44.1244264069301, 168.30348303483038},
-		{-43.93655240196025, 168.9478894788948},
-		{-43.55572671621054, 169.66789667896683},
-		{-43.03103354917762, 170.5247052470525},
-		{-42.51311061655802, 171.12591125911263},
-		{-41.76669227248861, 171.5687156871569},
-		{-41.514501040592144, 171.95031950319503},
-		{-40.955956701492575, 172.0979209792098},
-		{-40.49388820278294, 172.79992799928},
-		{-40.91872041221927, 173.01953019530197},
-	},
-	{
-		{-36.155860502443, 174.61074610746107},
-		{-37.20863195371551, 175.33795337953381},
-		{-36.52653083657271, 175.35595355953564},
-		{-36.799032771709165, 175.809558095581},
-		{-37.55560646739857, 175.95715957159575},
-		{-37.88057771923832, 176.7635676356764},
-		{-37.96182053219825, 177.44037440374404},
-		{-37.579302287845216, 178.00918009180094},
-		{-37.69608883147512, 178.5167851678517},
-		{-38.5829895396211, 178.27558275582754},
-		{-39.16692225777064, 177.969579695797},
-		{-39.14661155453066, 177.20637206372066},
-		{-39.44957954452709, 176.939969399694},
-		{-39.87948942977342, 177.03357033570336},
-		{-40.06567087613994, 176.8859688596886},
-		{-40.605597070602855, 176.50796507965083},
-		{-41.289390746348985, 176.01116011160116},
-		{-41.68883457673534, 175.2407524075241},
-		{-41.42648799321888, 175.0679506795068},
-		{-41.282620511935654, 174.65034650346507},
-		{-40.4600370307163, 175.22635226352264},
-		{-39.90826292603006, 174.8987489874899},
-		{-39.50881909564371, 173.82233822338225},
-		{-39.14661155453066, 173.8511385113851},
-		{-38.79794448224426, 174.57474574745748},
-		{-38.0278303177282, 174.74394743947443},
-		{-37.381272931255374, 174.69714697146975},
-		{-36.7110197243359, 174.29034290342906},
-		{-36.534993629589366, 174.31914319143192},
-		{-36.12200933037636, 173.84033840338407},
-		{-35.236801180833716, 173.05553055530555},
-		{-34.52931168464094, 172.63432634326347},
-		{-34.451453988887664, 173.00873008730088},
-		{-35.006613210780564, 173.55233552335523},
-		{-35.26557467709036, 174.329943299433},
-		{-36.155860502443, 174.61074610746107},
-	},
-	{
-		{16.65027536291238, 53.109531095310956},
-		{17.349302066088498, 52.781927819278195},
-		{18.99954670433722, 52.00072000720007},
-		{19.99984883890643, 54.99954999549996},
-		{22.000453108044866, 55.665556655566576},
-		{22.707942604237644, 55.20835208352085},
-		{23.110771551830666, 55.23355233552337},
-		{23.525448409647012, 55.52515525155252},
-		{23.93335503305002, 55.52875528755288},
-		{24.131384389639862, 55.982359823598244},
-		{24.2701741951131, 55.80235802358024},
-		{24.920116698792583, 55.88515885158853},
-		{24.925194374602583, 56.39636396363966},
-		{24.241400698856452, 56.846368463684655},
-		{23.8791931577434, 57.40437404374043},
-		{23.747173586683502, 58.135181351813515},
-		{23.566069816126983, 58.72918729187293},
-		{22.99229244959743, 59.17919179191793},
-		{22.66055096334435, 59.44919449194492},
-		{22.53360906809445, 59.80919809198093},
-		{22.31019133245462, 59.80559805598057},
-		{21.714410704081757, 59.441994419944194},
-		{21.43344597592865, 59.283592835928374},
-		{21.113552399898893, 58.86238862388626},
-		{20.429758724152762, 58.487984879848796},
-		{20.482228040856057, 58.034380343803434},
-		{20.243577277786244, 57.825578255782574},
-		{19.73580969678663, 57.667176671766725},
-		{19.0672490484705, 57.78957789577896},
-		{18.945384829030587, 57.69597695976961},
-		{18.94876994623725, 57.23517235172352},
-		{18.574714494900874, 56.60876608766088},
-		{18.087257617141262, 56.511565115651166},
-		{17.875687791724758, 56.284762847628485},
-		{17.88415058474142, 55.66195661956621},
-		{17.631959352844945, 55.269552695526954},
-		{17.229130405251937, 55.27315273152732},
-		{16.949858235702152, 54.79074790747907},
-		{17.044641517488742, 54.239942399423995},
-		{16.707822355425677, 53.570335703357046},
-		{16.65027536291238, 53.109531095310956},
-	},
-	{
-		{25.713926683755304, 56.259562595625965},
-		{26.05582352162837, 56.07236072360723},
-		{26.396027800898096, 56.360363603636046},
-		{26.309707312128168, 56.486364863648646},
-		{25.89672301291516, 56.392763927639294},
-		{25.713926683755304, 56.259562595625965},
-	},
-	{
-		{37.133619580436374, 75.15975159751599},
-		{36.66647340591673, 75.89775897758977},
-		{35.898051800004, 76.19296192961929},
-		{35.49353029380765, 77.83817838178385},
-		{34.654021226554974, 76.87336873368736},
-		{34.50507606946175, 75.75735757357575},
-		{34.748804508341564, 74.24174241742418},
-		{34.31720206449191, 73.74853748537487},
-		{33.44214926656926, 74.10494104941051},
-		{32.76512582523645, 74.45054450544507},
-		{32.2708987130635, 75.25695256952571},
-		{31.69204367072396, 74.40734407344075},
-		{30.979476498721183, 74.42174421744218},
-		{29.975789246945297, 73.44973449734499},
-		{28.961946643549425, 72.82332823328235},
-		{27.91256030948358, 71.77931779317794},
-		{27.988725446633524, 70.61650616506165},
-		{26.941031671171004, 69.5148951489515},
-		{26.492503641288025, 70.17010170101702},
-		{25.72238947677195, 70.28170281702819},
-		{25.214621895772353, 70.84330843308433},
-		{24.356494683883028, 71.04491044910449},
-		{24.35987980108969, 68.84168841688418},
-		{23.691319152773545, 68.17568175681757},
-		{23.945202943273344, 67.44487444874449},
-		{24.662847791086122, 67.14607146071461},
-		{25.42449916258552, 66.37206372063721},
-		{25.236625157615677, 64.52884528845289},
-		{25.218007012979015, 62.90522905229054},
-		{25.077524648902468, 61.49761497614978},
-		{26.24031240939155, 61.87561875618758},
-		{26.75654278340781, 63.315633156331586},
-		{27.216918723514127, 63.2328323283233},
-		{27.379404349433997, 62.754027540275416},
-		{28.259534823166646, 62.728827288272896},
-		{28.699600060032964, 61.77121771217713},
-		{29.30384348142249, 61.36801368013681},
-		{29.82853664845541, 60.8748087480875},
-		{29.319076508852476, 62.54882548825489},
-		{29.4680216659457, 63.549635496354966},
-		{29.3410797706958, 64.14724147241472},
-		{29.559419830525627, 64.34884348843488},
-		{29.47140678315236, 65.04725047250474},
-		{29.88777619957203, 66.3468634686347},
-		{30.739133177048032, 66.3828638286383},
-		{31.304447750560925, 66.93726937269375},
-		{31.3027551919576, 67.68247682476826},
-		{31.58371992011071, 67.7940779407794},
-		{31.71404693256727, 68.55728557285573},
-		{31.62095620938402, 68.9280892808928},
-		{31.901920937537128, 69.3168931689317},
-		{32.50277924171999, 69.2628926289263},
-		{33.10533010450618, 69.68769687696877},
-		{33.35921389500599, 70.3249032490325},
-		{34.01931175030548, 69.92889928899291},
-		{33.98884569544549, 70.88290882908831},
-		{34.34936067795522, 71.15651156511566},
-		{34.73357148091158, 71.11331113311135},
-		{35.153326014537924, 71.61371613716139},
-		{35.65093824391752, 71.49851498514985},
-		{36.07407789475053, 71.26091260912611},
-		{36.510758014410186, 71.84771847718477},
-		{36.720635281223366, 72.92052920529207},
-		{36.83572926624994, 74.0689406894069},
-		{37.02021815401312, 74.57654576545767},
-		{37.133619580436374, 75.15975159751599},
-	},
-	{
-		{7.224416500956423, -77.88137881378813},
-		{7.512151463522855, -78.21618216182162},
-		{8.052077657985777, -78.42858428584286},
-		{8.319501917312238, -78.18378183781837},
-		{8.387204261445504, -78.43578435784357},
-		{8.718945747698584, -78.62298622986229},
-		{8.99652535864503, -79.11979119791198},
-		{8.932208131718411, -79.5589955899559},
-		{8.58523361803536, -79.76059760597606},
-		{8.333042386138885, -80.16380163801638},
-		{8.299191214072252, -80.38340383403833},
-		{8.09100650586241, -80.48060480604806},
-		{7.5476951941928405, -80.00540005400053},
-		{7.419060740339603, -80.27540275402754},
-		{7.271808141849718, -80.41940419404193},
-		{7.221031383749761, -80.88740887408873},
-		{7.818504570725949, -81.06021060210601},
-		{7.647556151789416, -81.18981189811898},
-		{7.706795702906049, -81.5210152101521},
-		{8.109624650499057, -81.72261722617226},
-		{8.175634436029014, -82.1330213302133},
-		{8.292420979658914, -82.39222392223922},
-		{8.29072842105559, -82.82062820628207},
-		{8.074080919829086, -82.84942849428494},
-		{8.224718635525633, -82.96462964629646},
-		{8.42274799211549, -82.91422914229142},
-		{8.625855024515317, -82.83142831428314},
-		{8.80695879507185, -82.86742867428674},
-		{8.925437897305088, -82.71982719827199},
-		{9.07438305439831, -82.92862928629286},
-		{9.477212001991319, -82.93222932229322},
-		{9.566917607967923, -82.5470254702547},
-		{9.208095184061534, -82.1870218702187},
-		{8.994832800041706, -82.20862208622086},
-		{8.950826276355073, -81.8090180901809},
-		{9.032069089315002, -81.71541715417153},
-		{8.786648091831864, -81.43821438214381},
-		{8.857735553171807, -80.94860948609485},
-		{9.111619343671606, -80.52020520205201},
-		{9.313033817468124, -79.91539915399153},
-		{9.610924131654556, -79.57339573395734},
-		{9.553377139141261, -79.02259022590225},
-		{9.45520874014801, -79.05859058590586},
-		{9.419665009478038, -78.50058500585006},
-		{9.247024031938167, -78.05418054180541},
-		{8.947441159148411, -77.73017730177301},
-		{8.66986154820195, -77.35217352173521},
-		{8.524301508315403, -77.47457474574746},
-		{7.935291114355863, -77.24417244172442},
-		{7.637400800169431, -77.43137431374313},
-		{7.710180820112711, -77.75177751777517},
-		{7.224416500956423, -77.88137881378813},
-	},
-	{
-		{-17.58002983087418, -69.59049590495904},
-		{-18.09287508768378, -69.85689856898568},
-		{-18.348451436786917, -70.37170371703716},
-		{-17.772981511654038, -71.3761137611376},
-		{-17.363382329647692, -71.46251462514624},
-		{-16.359695077871805, -73.44613446134461},
-		{-15.266302220119329, -75.23895238952389},
-		{-14.64851832990314, -76.00936009360093},
-		{-13.822549731477125, -76.42336423364233},
-		{-13.534814768910678, -76.25776257762577},
-		{-12.223081851328374, -77.10737107371074},
-		{-10.378192973696486, -78.09378093780937},
-		{-8.386051497574712, -79.03699036990369},
-		{-7.930753233278395, -79.44739447394474},
-		{-7.194490240828969, -79.76059760597606},
-		{-6.541162619942824, -80.53820538205382},
-		{-6.136641113746464, -81.2510125101251},
-		{-5.689805642466823, -80.9270092700927},
-		{-4.736895148790893, -81.40941409414094},
-		{-4.036175887011439, -81.09981099810997},
-		{-3.404851527968603, -80.30420304203042},
-		{-3.8212209443882728, -80.18540185401854},
-		{-4.059871707458086, -80.46980469804697},
-		{-4.425464365777799, -80.4410044100441},
-		{-4.3459141114211945, -80.03060030600305},
-		{-4.4542378620344465, -79.62379623796238},
-		{-4.958620325827383, -79.20619206192062},
-		{-4.547328585217713, -78.6409864098641},
-		{-3.8736902610915678, -78.45018450184502},
-		{-3.0037151389789187, -77.83817838178382},
-		{-2.6093489844025584, -76.63576635766357},
-		{-1.5616552089400386, -75.5449554495545},
-		{-0.9117127052605554, -75.23535235352354},
-		{-0.1517538923644821, -75.37215372153722},
-		{-0.05697061057789199, -75.10575105751057},
-		{-0.5308870195108568, -74.44334443344432},
-		{-1.0031108698404836, -74.12294122941229},
-		{-1.2603797775469445, -73.65853658536585},
-		{-2.309766111612788, -73.07173071730718},
-		{-2.435015448259364, -72.32652326523265},
-		{-2.169283747536241, -71.77571775717757},
-		{-2.3436172836794356, -71.4121141211412},
-		{-2.257296794909493, -70.81450814508145},
-		{-2.724442969429134, -70.04770047700477},
-		{-3.7433632486350064, -70.69210692106921},
-		{-3.767059069081654, -70.39330393303932},
-		{-4.2985224705278995, -69.89289892898928},
-		{-4.251130829634604, -70.79650796507964},
-		{-4.4017685453311515, -70.92970929709297},
-		{-4.594720226111008, -71.74691746917469},
-		{-5.275128784650477, -72.89172891728917},
-		{-5.74058240056678, -72.96372963729637},
-		{-6.089249472853169, -73.21933219332193},
-		{-6.629175667316076, -73.11853118531185},
-		{-6.918603188485861, -73.72333723337233},
-		{-7.341742839318854, -73.72333723337233},
-		{-7.524539168478711, -73.98613986139861},
-		{-8.424980345451345, -73.57213572135721},
-		{-9.032608884047534, -73.01413014130141},
-		{-9.462518769293865, -73.22653226532265},
-		{-9.52006576180716, -72.56412564125641},
-		{-10.053221721856744, -72.18612186121861},
-		{-10.078610100906715, -71.3041130411304},
-		{-9.489599706947175, -70.48330483304832},
-		{-11.009517332739321, -70.5481054810548},
-		{-11.124611317765897, -70.09450094500944},
-		{-10.95197034022604, -69.52929529295292},
-		{-12.561593571994777, -68.66528665286653},
-		{-12.90010529266118, -68.88128881288813},
-		{-13.602517113043959, -68.9280892808928},
-		{-14.45387409051996, -68.94968949689496},
-		{-14.95317887850291, -69.33849338493384},
-		{-15.323849212632624, -69.1620916209162},
-		{-15.660668374695689, -69.38889388893888},
-		{-16.500177441948367, -68.96048960489604},
-		{-17.58002983087418, -69.59049590495904},
-	},
-	{
-		{8.414285199098828, 126.37746377463776},
-		{7.750802226592668, 126.47826478264784},
-		{7.188872770286451, 126.53586535865361},
-		{6.274891124487169, 126.19746197461978},
-		{7.293811403693027, 125.83025830258305},
-		{6.786043822693429, 125.36225362253623},
-		{6.0497808302440035, 125.68265682656829},
-		{5.580942097121039, 125.39825398253981},
-		{6.161489698063917, 124.22104221042213},
-		{6.885904780290019, 123.94023940239401},
-		{7.359821189222984, 124.24264242642425},
-		{7.833737598155949, 123.60903609036092},
-		{7.419060740339603, 123.29583295832958},
-		{7.457989588216236, 122.82422824228246},
-		{6.899445249116681, 122.08622086220862},
-		{7.192257887493113, 121.9206192061921},
-		{8.035152071952453, 122.31302313023133},
-		{8.316116800105561, 122.9430294302943},
-		{8.693557368648598, 123.48663486634865},
-		{8.239951662955633, 123.83943839438393},
-		{8.514146156695418, 124.60264602646026},
-		{8.960981627975059, 124.76464764647648},
-		{8.986370007025045, 125.47025470254704},
-		{9.759869288747765, 125.41265412654127},
-		{9.285952879814815, 126.22266222662228},
-		{8.783262974625202, 126.30546305463054},
-		{8.414285199098828, 126.37746377463776},
-	},
-	{
-		{10.279484779970701, 123.98343983439838},
-		{9.94943585232096, 123.62343623436237},
-		{9.31811149327811, 123.31023310233104},
-		{9.021913737695016, 122.9970299702997},
-		{9.714170206457808, 122.38142381423813},
-		{9.98159446578427, 122.58662586625866},
-		{10.26086663533404, 122.83862838628386},
-		{10.88203564275689, 122.94662946629467},
-		{10.941275193873508, 123.49743497434974},
-		{10.267636869747378, 123.3390333903339},
-		{11.232395273646617, 124.07704077040773},
-		{10.279484779970701, 123.98343983439838},
-	},
-	{
-		{9.316418934674786, 118.5041850418504},
-		{8.366893558205533, 117.1757717577176},
-		{9.067612819984973, 117.66537665376654},
-		{9.683704151597837, 118.38538385383856},
-		{10.37596062036063, 118.98658986589868},
-		{11.369492520516516, 119.51219512195121},
-		{10.553679273710486, 119.68859688596888},
-		{10.003597727627579, 119.02979029790299},
-		{9.316418934674786, 118.5041850418504},
-	},
-	{
-		{11.8924931289461, 121.88461884618846},
-		{11.582754904536344, 122.48222482224821},
-		{11.584447463139682, 123.11943119431197},
-		{11.166385488116674, 123.10143101431015},
-		{10.741553278680342, 122.6370263702637},
-		{10.440277847287234, 122.00342003420036},
-		{10.905731463203537, 121.96741967419678},
-		{11.415191602806473, 122.03942039420394},
-		{11.8924931289461, 121.88461884618846},
-	},
-	{
-		{12.163302505479223, 125.50265502655026},
-		{11.046213827280098, 125.78345783457837},
-		{11.311945528003221, 125.01305013050131},
-		{10.975126365940156, 125.03105031050313},
-		{10.359035034327306, 125.2758527585276},
-		{10.13392474008414, 124.80064800648006},
-		{10.838029119070256, 124.76104761047611},
-		{10.890498435773551, 124.45864458644587},
-		{11.494741857163078, 124.30384303843039},
-		{11.415191602806473, 124.89064890648905},
-		{11.794324729952848, 124.87624876248765},
-		{12.557668660055583, 124.2678426784268},
-		{12.535665398212274, 125.22545225452257},
-		{12.163302505479223, 125.50265502655026},
-	},
-	{
-		{13.068821358261857, 121.52821528215281},
-		{12.205616470562532, 121.26181261812621},
-		{12.704921258545468, 120.83340833408334},
-		{13.46657263004488, 120.32220322203221},
-		{13.42933634077157, 121.1790117901179},
-		{13.068821358261857, 121.52821528215281},
-	},
-	{
-		{18.50362703356093, 121.32301323013229},
-		{18.21927718820116, 121.93861938619386},
-		{18.47823865451096, 122.24462244622447},
-		{18.224354864011147, 122.33822338223382},
-		{17.809678006194815, 122.1726217262173},
-		{17.093725716985375, 122.51462514625149},
-		{16.26267944274936, 122.2518225182252},
-		{15.93093795649628, 121.66141661416617},
-		{15.12528006131025, 121.50661506615069},
-		{14.328084959140867, 121.72981729817297},
-		{14.218068649924291, 122.25902259022592},
-		{14.336547752157529, 122.7018270182702},
-		{13.781388530264621, 123.9510395103951},
-		{13.238077218595052, 123.85383853838539},
-		{12.9977338969219, 124.18144181441818},
-		{12.537357956815598, 124.07704077040773},
-		{13.028199951781886, 123.29943299432995},
-		{13.552893118814808, 122.92862928629285},
-		{13.185607901891757, 122.67302673026734},
-		{13.784773647471297, 122.03582035820358},
-		{13.637521048981412, 121.1250112501125},
-		{13.857553667414564, 120.62820628206282},
-		{14.270537966627572, 120.67860678606786},
-		{14.526114315730709, 120.9918099180992},
-		{14.75630228578386, 120.69300693006932},
-		{14.395787303274147, 120.56340563405632},
-		{14.971257228407026, 120.07020070200701},
-		{15.406244789463358, 119.92259922599226},
-		{16.364232958949273, 119.88299882998831},
-		{16.03418403129953, 120.28620286202863},
-		{17.599800739381635, 120.39060390603908},
-		{18.50531959216427, 120.71460714607144},
-		{18.50362703356093, 121.32301323013229},
-	},
-	{
-		{-6.8204347894925945, 155.879758797588},
-		{-6.920295747089185, 155.5989559895599},
-		{-6.536084944132824, 155.16695166951672},
-		{-5.901375467883312, 154.72774727747276},
-		{-5.139724096383915, 154.51534515345156},
-		{-5.043248255993987, 154.65214652146523},
-		{-5.339446011577095, 154.76014760147604},
-		{-5.566248864423585, 155.06255062550628},
-		{-6.200958340673083, 155.54855548555486},
-		{-6.539470061339486, 156.02016020160204},
-		{-6.8204347894925945, 155.879758797588},
-	},
-	{
-		{-5.478235817050319, 151.98451984519846},
-		{-5.559478630010247, 151.45891458914588},
-		{-5.84044335816337, 151.30051300513009},
-		{-6.084171797043169, 150.75330753307531},
-		{-6.317744884302996, 150.24210242102424},
-		{-6.316052325699658, 149.70929709297093},
-		{-6.026624804529888, 148.8884888848889},
-		{-5.747352634980103, 148.319683196832},
-		{-5.4376144105703474, 148.40248402484025},
-		{-5.5831744504568945, 149.29889298892988},
-		{-5.505316754703628, 149.84609846098465},
-		{-5.026322669960663, 149.99729997299977},
-		{-5.000934290910692, 150.14130141301416},
-		{-5.532397692356938, 150.23850238502388},
-		{-5.456232555206995, 150.80730807308072},
-		{-5.11433571733393, 151.08811088110883},
-		{-4.757205852030879, 151.64611646116464},
-		{-4.168195458071338, 151.53811538115383},
-		{-4.1495773134346905, 152.13572135721358},
-		{-4.313755497957899, 152.33732337323374},
-		{-4.867222161247454, 152.31932319323192},
-		{-5.478235817050319, 151.98451984519846},
-	},
-	{
-		{-9.117236814214138, 141.03321033210335},
-		{-5.859061502800017, 141.0188101881019},
-		{-2.6008861913858965, 141.00081000810007},
-		{-3.289757542942027, 142.73602736027362},
-		{-3.861842350868244, 144.5828458284583},
-		{-4.372995049074518, 145.2740527405274},
-		{-4.875684954264116, 145.82845828458284},
-		{-5.466387906826995, 145.98325983259832},
-		{-6.084171797043169, 147.64647646476465},
-		{-6.6139426398860905, 147.89127891278912},
-		{-6.7222663904993425, 146.969669696697},
-		{-7.387441921608826, 147.19287192871928},
-		{-8.044154659701647, 148.0856808568086},
-		{-9.105388903990814, 148.7336873368734},
-		{-9.071537731924167, 149.3060930609306},
-		{-9.51498808599716, 149.26649266492666},
-		{-9.684243946330355, 150.04050040500408},
-		{-9.872117951300211, 149.73809738097384},
-		{-10.293565043529881, 150.80010800108005},
-		{-10.582992564699651, 150.69210692106924},
-		{-10.65238746743627, 150.029700297003},
-		{-10.393426001126471, 149.78129781297815},
-		{-10.281717133306557, 148.92448924489247},
-		{-10.13107941761001, 147.9128791287913},
-		{-9.492984824153837, 147.13527135271352},
-		{-8.942903278070943, 146.56646566465668},
-		{-8.067850480148294, 146.04806048060482},
-		{-7.629477801885301, 144.7448474484745},
-		{-7.915520205848409, 143.8988389883899},
-		{-8.245569133498151, 143.2868328683287},
-		{-8.983524684550915, 143.41283412834127},
-		{-9.327114081027304, 142.62802628026282},
-		{-9.159550779297433, 142.070020700207},
-		{-9.117236814214138, 141.03321033210335},
-	},
-	{
-		{-4.499936944324418, 153.14013140131402},
-		{-4.7656686450475405, 152.8269282692827},
-		{-4.176658251088, 152.63972639726398},
-		{-3.7890623309249634, 152.4057240572406},
-		{-3.462398520481898, 151.95211952119524},
-		{-3.035873752442228, 151.38331383313835},
-		{-2.741368555462458, 150.66330663306633},
-		{-2.4993326751859826, 150.94050940509408},
-		{-2.780297403339091, 151.48051480514806},
-		{-3.000330021772257, 151.8189181891819},
-		{-3.240673343445394, 152.24012240122403},
-		{-3.66042787707174, 152.63972639726398},
-		{-3.9803214531014817, 153.02133021330212},
-		{-4.499936944324418, 153.14013140131402},
-	},
-	{
-		{51.10738340954545, 15.017550175501754},
-		{51.74547800300162, 14.607146071460733},
-		{52.090759958081335, 14.686346863468628},
-		{52.62560847673426, 14.437944379443792},
-		{52.98104578343397, 14.074340743407447},
-		{53.248470042760445, 14.351543515435168},
-		{53.75623762376004, 14.121141211412123},
-		{54.05074282073981, 14.801548015480165},
-		{54.512811319449455, 16.36396363963641},
-		{54.851323040115844, 17.623976239762413},
-		{54.68206717978266, 18.621186211862124},
-		{54.438338740902836, 18.696786967869684},
-		{54.426490830679526, 19.661596615966175},
-		{54.313089404256274, 20.892808928089295},
-		{54.32832243168626, 22.73242732427326},
-		{54.21999868107302, 23.24363243632436},
-		{53.911953015266576, 23.4848348483485},
-		{53.470195219796935, 23.52803528035281},
-		{53.089369534047236, 23.80523805238053},
-		{52.691618262264214, 23.798037980379803},
-		{52.48681867126102, 23.20043200432005},
-		{52.02305761394807, 23.506435064350654},
-		{51.57791470127175, 23.52803528035281},
-		{50.70624702055575, 24.028440284402848},
-		{50.425282292402656, 23.924039240392403},
-		{50.30849574877273, 23.42723427234273},
-		{49.477449474536726, 22.520025200252007},
-		{49.027228886050395, 22.775627756277572},
-		{49.08646843716703, 22.559625596255984},
-		{49.4706792401234, 21.60921609216092},
-		{49.32850431744349, 20.88920889208893},
-		{49.43175039224674, 20.417604176041777},
-		{49.216795449623575, 19.82359823598236},
-		{49.57223275632332, 19.319593195931958},
-		{49.43513550945342, 18.909189091890937},
-		{49.49606761917336, 18.85158851588517},
-		{49.98860217274297, 18.394383943839443},
-		{50.04953428246294, 17.649176491764933},
-		{50.36265762407935, 17.555575555755553},
-		{50.47436649189926, 16.86796867968681},
-		{50.215405025589476, 16.720367203672055},
-		{50.42189717519598, 16.176761767617677},
-		{50.69778422753909, 16.23796237962381},
-		{50.78410471630902, 15.49275492754927},
-		{51.10738340954545, 15.017550175501754},
-	},
-	{
-		{18.515474943784255, -66.2820628206282},
-		{18.42746189641099, -65.77085770857708},
-		{18.227739981217823, -65.59085590855908},
-		{17.975548749321348, -65.84645846458464},
-		{17.98231898373467, -66.59886598865988},
-		{17.9467752530647, -67.18567185671856},
-		{18.37499257970771, -67.24327243272432},
-		{18.520552619594255, -67.09927099270992},
-		{18.515474943784255, -66.2820628206282},
-	},
-	{
-		{42.39578427819559, 130.639906399064},
-		{42.21975818344906, 130.78030780307802},
-		{42.280690293169016, 130.39870398703988},
-		{41.94217857250261, 129.9666996669967},
-		{41.60028173462955, 129.66789667896683},
-		{40.88263688681677, 129.7038970389704},
-		{40.66260426838362, 129.18909189091892},
-		{40.48488561503375, 129.00909009090094},
-		{40.19038041805398, 128.63468634686348},
-		{40.02620223353077, 127.96867968679686},
-		{39.75708541560098, 127.53307533075332},
-		{39.32379041314799, 127.5006750067501},
-		{39.213774103931414, 127.38547385473856},
-		{39.05128847801154, 127.78507785077852},
-		{38.61291579974855, 128.35028350283505},
-		{38.370879919472074, 128.20628206282066},
-		{38.30487013394212, 127.78147781477816},
-		{38.2557859344455, 127.07227072270723},
-		{37.80556534595918, 126.68346683466837},
-		{37.84110907662915, 126.23706237062373},
-		{37.749710912049224, 126.1758617586176},
-		{37.939277475622404, 125.68985689856902},
-		{37.75140347065255, 125.56745567455675},
-		{37.66846809908928, 125.2758527585276},
-		{37.858034662662476, 125.23985239852402},
-		{37.949432827242404, 124.98064980649809},
-		{38.10853333595561, 124.71064710647107},
-		{38.54859857282193, 124.98424984249846},
-		{38.665385116451844, 125.2218522185222},
-		{38.8481814456117, 125.13185131851321},
-		{39.38810764007461, 125.38745387453878},
-		{39.55059326599448, 125.32265322653228},
-		{39.660609575211055, 124.73584735847362},
-		{39.928033834537516, 124.26424264242644},
-		{40.56951354520035, 125.08145081450817},
-		{41.107747181059935, 126.18306183061833},
-		{41.81692923585604, 126.87066870668707},
-		{41.50380589423962, 127.34227342273425},
-		{41.46656960496631, 128.20988209882103},
-		{41.99464788920591, 128.05148051480518},
-		{42.424557774452225, 129.5958959589596},
-		{42.98479467215513, 129.99549995499956},
-		{42.39578427819559, 130.639906399064},
-	},
-	{
-		{41.881246462782656, -9.034290342903432},
-		{42.135130253282455, -8.670686706867059},
-		{42.280690293169016, -8.263882638826374},
-		{41.791540856806066, -8.011880118801173},
-		{41.791540856806066, -7.4214742147421475},
-		{41.918482752055965, -7.252272522725235},
-		{41.882939021385994, -6.669066690666909},
-		{41.38194167479972, -6.3882638826388245},
-		{41.1111322982666, -6.85266852668525},
-		{40.33086278213054, -6.863468634686342},
-		{40.18530274224398, -7.0254702547025545},
-		{39.711386333311026, -7.065070650706502},
-		{39.63014352035108, -7.497074970749708},
-		{39.02928521616822, -7.097470974709751},
-		{38.3725724780754, -7.3746737467374714},
-		{38.076374722492304, -7.02907029070289},
-		{37.80387278735584, -7.165871658716583},
-		{37.428124777416144, -7.536675366753656},
-		{37.0980758497664, -7.453874538745367},
-		{36.839114383456604, -7.8570785707856885},
-		{36.97959674753315, -8.382683826838274},
-		{36.869580438316575, -8.89748897488974},
-		{37.65154251305596, -8.74628746287462},
-		{38.265941286065484, -8.839888398883971},
-		{38.35903200924875, -9.286292862928633},
-		{38.73816513639511, -9.527495274952742},
-		{39.39149275728127, -9.448294482944817},
-		{39.75539285699766, -9.04869048690486},
-		{40.159914363194005, -8.976689766897664},
-		{40.76077266737687, -8.767887678876775},
-		{41.18391231820986, -8.789487894878931},
-		{41.54273474211625, -8.991089910899092},
-		{41.881246462782656, -9.034290342903432},
-	},
-	{
-		{-22.249799017467204, -62.685626856268556},
-		{-21.051467526308144, -62.28962289622896},
-		{-20.51323389044856, -62.26442264422644},
-		{-19.63310341671591, -61.78561785617856},
-		{-19.341983336942803, -60.04320043200431},
-		{-19.357216364372803, -59.11439114391143},
-		{-19.868369062579063, -58.18198181981819},
-		{-20.176414728385495, -58.16758167581675},
-		{-20.733266508881727, -57.872378723787236},
-		{-22.090698508753995, -57.93717937179372},
-		{-22.281957630930513, -56.88236882368824},
-		{-22.085620832943995, -56.47196471964719},
-		{-22.356430209477118, -55.798757987579876},
-		{-22.656013082266888, -55.61155611556116},
-		{-23.5716872866695, -55.51795517955179},
-		{-23.9575906482292, -55.399153991539904},
-		{-24.001597171915833, -55.02835028350283},
-		{-23.839111545995962, -54.653946539465394},
-		{-24.020215316552488, -54.293942939429385},
-		{-24.570296862635388, -54.293942939429385},
-		{-25.16269237380159, -54.430744307443064},
-		{-25.739854857537807, -54.62514625146251},
-		{-26.621677889873787, -54.78714787147871},
-		{-27.388406937183184, -55.69435694356943},
-		{-27.549200004499724, -56.48636486364863},
-		{-27.395177171596515, -57.60957609576096},
-		{-27.124367795063392, -58.61758617586176},
-		{-25.604450169271246, -57.63477634776348},
-		{-25.16269237380159, -57.77877778777787},
-		{-24.7717113364319, -58.80838808388083},
-		{-24.03206322677581, -60.02880028800287},
-		{-23.881425511079257, -60.84600846008459},
-		{-22.249799017467204, -62.685626856268556},
-	},
-	{
-		{32.39445549110674, 35.54495544955449},
-		{31.781749276700552, 35.54495544955449},
-		{31.48893663832412, 35.39735397353974},
-		{31.353531950057558, 34.92574925749258},
-		{31.617571092177343, 34.968949689496895},
-		{31.754668339047242, 35.22455224552246},
-		{31.866377206867156, 34.976149761497624},
-		{32.53324529657996, 35.18495184951851},
-		{32.39445549110674, 35.54495544955449},
-	},
-	{
-		{24.75424595566605, 50.80910809108093},
-		{25.482046155098814, 50.74430744307443},
-		{26.006739322131736, 51.014310143101426},
-		{26.115063072744988, 51.28791287912881},
-		{25.801939731128556, 51.59031590315905},
-		{25.21631445437569, 51.608316083160844},
-		{24.62730406041615, 51.38871388713889},
-		{24.55621659907621, 51.11151111511117},
-		{24.75424595566605, 50.80910809108093},
-	},
-	{
-		{47.88136671159462, 22.710827108271076},
-		{48.09632165421779, 23.14283142831428},
-		{47.98630534500121, 23.76203762037622},
-		{47.98122766919123, 24.402844028440285},
-		{47.7374992303114, 24.86724867248674},
-		{47.89152206321462, 25.209252092520927},
-		{47.98630534500121, 25.947259472594737},
-		{48.22157099086438, 26.195661956619574},
-		{48.22157099086438, 26.62046620466205},
-		{48.12340259187111, 26.922869228692292},
-		{47.827204836288004, 27.23247232472326},
-		{47.405757744058334, 27.552875528755294},
-		{46.80997711568547, 28.12888128881289},
-		{46.37160443742248, 28.16128161281614},
-		{45.94507966938282, 28.05328053280533},
-		{45.488088846483166, 28.233282332823336},
-		{45.30359995871997, 28.67968679686797},
-		{45.46439302603652, 29.151291512915122},
-		{45.2934446071, 29.604896048960484},
-		{45.036175699393524, 29.626496264962668},
-		{44.81952819816702, 29.14049140491406},
-		{44.91431147995361, 28.838088380883818},
-		{43.707517195777896, 28.557285572855733},
-		{43.812455829184486, 27.970479704797043},
-		{44.17635592890086, 27.243272432724325},
-		{43.94278284164103, 26.06606066060661},
-		{43.688899051141235, 25.569255692556936},
-		{43.741368367844544, 24.100441004410044},
-		{43.89708375935108, 23.333633336333378},
-		{43.824303739407796, 22.944829448294485},
-		{44.235595480017494, 22.6568265682657},
-		{44.40992901616069, 22.47322473224733},
-		{44.57749231789056, 22.70722707227074},
-		{44.70274165453712, 22.458824588245903},
-		{44.477631360293955, 22.14562145621457},
-		{44.76875144006708, 21.562415624156245},
-		{45.181735739280086, 21.48321483214832},
-		{45.41700138514322, 20.874808748087474},
-		{45.73520240256964, 20.76320763207633},
-		{46.12787599854268, 20.21960219602198},
-		{46.31575000351252, 21.02241022410226},
-		{46.994466003448665, 21.62721627216274},
-		{47.67318200338481, 22.098820988209894},
-		{47.88136671159462, 22.710827108271076},
-	},
-	{
-		{50.74686842703572, 143.6468364683647},
-		{48.97645212795045, 144.6548465484655},
-		{49.30650105560018, 143.17523175231753},
-		{47.86105600835465, 142.55962559625596},
-		{46.837058053338794, 143.53523535235354},
-		{46.13803135016265, 143.50643506435068},
-		{46.740582212948866, 142.74682746827472},
-		{45.96708293122613, 142.0916209162092},
-		{46.806591998478794, 141.9080190801908},
-		{47.77981319539471, 142.01962019620197},
-		{48.859665584320524, 141.90441904419043},
-		{49.614546721406626, 142.1348213482135},
-		{50.95166801803889, 142.18162181621818},
-		{51.9350445665748, 141.59481594815952},
-		{53.302631918067064, 141.68121681216815},
-		{53.76131529957003, 142.60642606426063},
-		{54.22507635688301, 142.21042210422104},
-		{54.365558720959555, 142.6532265322653},
-		{53.703768307056734, 142.9160291602916},
-		{52.74070246176085, 143.26163261632615},
-		{51.75732591322493, 143.23643236432366},
-		{50.74686842703572, 143.6468364683647},
-	},
-	{
-		{54.32832243168626, 22.73242732427326},
-		{54.313089404256274, 20.892808928089295},
-		{54.426490830679526, 19.661596615966175},
-		{54.86655606754583, 19.888398883988856},
-		{55.18983476078226, 21.267212672126732},
-		{55.01550122463907, 22.314823148231483},
-		{54.85640071592586, 22.75762757627578},
-		{54.58220622218606, 22.649626496264972},
-		{54.32832243168626, 22.73242732427326},
-	},
-	{
-		{70.83246137277668, 180},
-		{70.78168461467673, 178.9019890198902},
-		{71.09819307349981, 178.7255872558726},
-		{71.51625504852281, -180},
-		{71.55687645500278, -179.87039870398704},
-		{71.55518389639947, -179.02439024390245},
-		{71.26914149243636, -177.5771757717577},
-		{71.13204424556646, -177.66357663576636},
-		{70.89339348249665, -178.69318693186932},
-		{70.83246137277668, -180},
-		{70.83246137277668, 180},
-	},
-	{
-		{73.21219876906149, 143.6036360363604},
-		{73.20542853464816, 142.08802088020883},
-		{73.31713740246808, 140.03960039600395},
-		{73.36960671917139, 139.86319863198634},
-		{73.76566543235107, 140.810008100081},
-		{73.85706359693098, 142.06282062820628},
-		{73.47454535257795, 143.48123481234813},
-		{73.21219876906149, 143.6036360363604},
-	},
-	{
-		{75.0841685843467, 150.7317073170732},
-		{74.68810987116701, 149.57609576095763},
-		{74.77781547714359, 147.9776797767978},
-		{75.17218163171997, 146.12006120061204},
-		{75.4971528835597, 146.3576635766358},
-		{75.34651516786316, 148.22248222482227},
-		{75.0841685843467, 150.7317073170732},
-	},
-	{
-		{75.56316266908966, 145.0868508685087},
-		{74.8201294422269, 144.29844298442987},
-		{74.84721037988022, 140.61560615606157},
-		{74.61194473401706, 138.9559895598956},
-		{75.26188723769657, 136.9759697596976},
-		{75.94906603064935, 137.51237512375127},
-		{76.13694003561922, 138.82998829988298},
-		{76.09293351193257, 141.47241472414726},
-		{75.56316266908966, 145.0868508685087},
-	},
-	{
-		{70.72075250495676, 57.5339753397534},
-		{70.63273945758353, 56.94356943569437},
-		{70.76306647004007, 53.678336783367854},
-		{71.20651682411305, 53.4119341193412},
-		{71.47394108343953, 51.601116011160116},
-		{72.01555983650577, 51.45711457114572},
-		{72.2288222205256, 52.479524795247954},
-		{72.77551864940183, 52.44352443524437},
-		{73.62687562687783, 54.42714427144273},
-		{73.75043240492109, 53.50913509135091},
-		{74.62717776144704, 55.90315903159032},
-		{75.08078346714002, 55.63315633156333},
-		{75.60886175137962, 57.868778687786886},
-		{76.25203402064578, 61.170011700117016},
-		{76.43821546701231, 64.50004500045},
-		{76.81057835974535, 66.210062100621},
-		{76.93921281359859, 68.15768157681578},
-		{76.54484665902221, 68.85248852488525},
-		{76.23341587600915, 68.17928179281793},
-		{75.73749620523286, 64.6368463684637},
-		{75.26019467909323, 61.5840158401584},
-		{74.30897674402063, 58.47718477184773},
-		{73.33237042989806, 56.98676986769868},
-		{72.37099714320549, 55.420754207542075},
-		{71.53995086896946, 55.622356223562235},
-		{70.72075250495676, 57.5339753397534},
-	},
-	{
-		{42.21975818344906, 130.78030780307802},
-		{42.39578427819559, 130.639906399064},
-		{42.90355185919519, 130.63270632706326},
-		{42.93063279684851, 131.1439114391144},
-		{44.11203870197424, 131.28791287912878},
-		{44.96847335526026, 131.02511025110255},
-		{45.320525544753295, 131.88191881918823},
-		{45.14449945000676, 133.09873098730986},
-		{46.11772064692268, 133.76833768337684},
-		{47.21280606327848, 134.1139411394114},
-		{47.57839872159819, 134.49914499144995},
-		{48.478839898570826, 135.02475024750248},
-		{48.18264214298772, 133.37233372333725},
-		{47.78827598841137, 132.50832508325084},
-		{47.78996854701471, 130.98550985509854},
-		{48.72933857186398, 130.58230582305822},
-		{49.4402131852634, 129.3978939789398},
-		{49.76010676129316, 127.65907659076589},
-		{50.7400981926224, 127.28827288272885},
-		{51.354496965631924, 126.93906939069393},
-		{51.784406850878256, 126.56466564665647},
-		{52.79317177846413, 125.94545945459458},
-		{53.16045699538718, 125.06705067050672},
-		{53.4583473095736, 123.57303573035733},
-		{53.4312663719203, 122.24462244622447},
-		{53.25185515996709, 121.00261002610029},
-		{52.754242930587495, 120.17820178201782},
-		{52.51559216751768, 120.72540725407254},
-		{51.963818062831436, 120.73980739807399},
-		{51.64392448680168, 120.18180181801819},
-		{50.58269024251253, 119.27819278192783},
-		{50.142625005646195, 119.28899288992892},
-		{49.511300646603345, 117.8777787777878},
-		{49.888741215146396, 116.67896678966792},
-		{49.805805843583116, 115.48735487354872},
-		{50.14093244704286, 114.9617496174962},
-		{50.247563639052785, 114.36414364143644},
-		{49.543459260066655, 112.89892898928991},
-		{49.37758851694012, 111.58131581315814},
-		{49.13047496085365, 110.66330663306633},
-		{49.29296058677353, 109.40329403294032},
-		{49.28280523515353, 108.47448474484747},
-		{49.793957933359806, 107.86967869678699},
-		{50.27464457670611, 106.89046890468904},
-		{50.406664147765994, 105.8860588605886},
-		{50.27464457670611, 104.62244622446224},
-		{50.090155688942914, 103.67563675636757},
-		{50.509910222569246, 102.25722257222571},
-		{51.259713683845334, 102.06642066420665},
-		{51.51698259155178, 100.88920889208896},
-		{51.63376913518171, 99.98199981999824},
-		{52.04675343439472, 98.86238862388626},
-		{51.01090756915552, 97.82557825578255},
-		{50.42189717519598, 98.23238232382323},
-		{49.72625558922651, 97.26037260372607},
-		{49.97675426251966, 95.8131581315813},
-		{50.01399055179296, 94.8159481594816},
-		{50.48113672631261, 94.14634146341467},
-		{50.49467719513926, 93.10593105931059},
-		{50.80272286094568, 92.23472234722351},
-		{50.332191569219376, 90.71190711907121},
-		{49.4706792401234, 88.80388803888042},
-		{49.29803826258352, 87.7526775267753},
-		{49.215102891020265, 87.36027360273602},
-		{49.826116546823116, 86.83106831068312},
-		{49.69240441715989, 85.54225542255426},
-		{50.11723662659621, 85.1138511385114},
-		{50.311880865979404, 84.41544415444156},
-		{50.88904334971561, 83.93663936639365},
-		{51.068454561668815, 83.38223382233821},
-		{50.81287821256568, 81.94581945819459},
-		{51.38834813769857, 80.56700567005669},
-		{50.86534752926896, 80.03420034200343},
-		{53.40418543426698, 77.80217802178021},
-		{54.17768471598971, 76.52416524165244},
-		{54.490808057606145, 76.89136891368912},
-		{53.54636035694686, 74.38574385743857},
-		{53.490505923036906, 73.42453424534247},
-		{54.03550979330983, 73.50733507335073},
-		{54.37740663118289, 72.22572225722257},
-		{54.133678192303066, 71.18171181711818},
-		{55.16952405754226, 70.86490864908649},
-		{55.38447900016543, 69.06849068490686},
-		{54.96980214234908, 68.16848168481687},
-		{54.60082436682272, 65.66645666456665},
-		{54.353710810736246, 65.17685176851771},
-		{54.006736297053166, 61.436414364143644},
-		{53.6648394591801, 60.97920979209792},
-		{52.97935322483066, 61.69921699216994},
-		{52.72039175852085, 60.741607416074174},
-		{52.447889823384386, 60.92880928809288},
-		{51.96043294562479, 59.96759967599678},
-		{51.27325415267198, 61.587615876158765},
-		{50.79933774373903, 61.33921339213393},
-		{50.84165170882231, 59.93159931599317},
-		{50.5454539532392, 59.643596435964355},
-		{51.0633768858588, 58.361983619836195},
-		{51.04306618261883, 56.777967779677795},
-		{50.62161909038916, 55.715957159571616},
-		{51.026140596585506, 54.53154531545317},
-		{51.7183970653483, 52.328323283232834},
-		{51.69300868629831, 50.76590765907659},
-		{50.604693504355836, 48.70308703087031},
-		{49.87520074631975, 48.57708577085771},
-		{50.45405578865929, 47.55107551075511},
-		{49.35558525509681, 46.7518675186752},
-		{49.152478222696985, 47.043470434704346},
-		{48.394211968404235, 46.46746467464675},
-		{47.71549596846809, 47.31347313473137},
-		{47.74426946472475, 48.05868058680588},
-		{47.07570881640861, 48.69588695886961},
-		{46.56117100099566, 48.59148591485916},
-		{46.3986853750758, 49.102691026910264},
-		{45.80628986390958, 48.64548645486457},
-		{45.64211167938639, 47.67707677076771},
-		{44.60965093135387, 46.683466834668366},
-		{43.6601255548846, 47.59067590675909},
-		{42.98648723075847, 47.493474934749344},
-		{41.808466442839375, 48.584285842858435},
-		{41.40563749524637, 47.98667986679868},
-		{41.15175370474657, 47.813878138781405},
-		{41.21945604887985, 47.37467374673747},
-		{41.82708458747604, 46.6870668706687},
-		{41.86093575954267, 46.406264062640645},
-		{42.09281628819916, 45.77625776257764},
-		{42.50241547020549, 45.47025470254704},
-		{42.71229273701867, 44.537845378453795},
-		{42.5548847869088, 43.92943929439295},
-		{42.741066233275305, 43.75663756637567},
-		{43.22006031801827, 42.39582395823959},
-		{43.382545943938155, 40.92340923409236},
-		{43.55349436287467, 40.07740077400774},
-		{43.435015260641435, 39.954999549995506},
-		{44.27960200370411, 38.680586805868074},
-		{44.657042572247164, 37.53937539375394},
-		{45.24436040760337, 36.67536675366753},
-		{45.40515347491991, 37.40257402574028},
-		{46.24127742496593, 38.23418234182341},
-		{46.63733613814561, 37.67257672576727},
-		{47.04524276154861, 39.148591485914864},
-		{47.26358282137845, 39.11979119791198},
-		{47.1027897540619, 38.22338223382235},
-		{47.54624010813488, 38.2557825578256},
-		{47.825512277684666, 38.77058770587706},
-		{47.89829229762795, 39.73899738997392},
-		{48.23172634248435, 39.89739897398974},
-		{48.783500447170596, 39.67419674196742},
-		{49.30819361420352, 40.081000810008106},
-		{49.60100625257995, 40.070200702007014},
-		{49.92597750441969, 38.59418594185942},
-		{49.91582215279972, 38.010980109801096},
-		{50.384660885922685, 37.39177391773919},
-		{50.225560377209476, 36.62496624966249},
-		{50.57761256670251, 35.35775357753579},
-		{50.773949364689045, 35.379353793537945},
-		{51.207244367142025, 35.0229502295023},
-		{51.25632856663866, 34.223742237422385},
-		{51.566066791048414, 34.1409414094141},
-		{51.76917382344827, 34.3929439294393},
-		{52.33448839696116, 33.75213752137523},
-		{52.238012556571235, 32.715327153271545},
-		{52.28878931467118, 32.412924129241304},
-		{52.0619864618247, 32.1609216092161},
-		{52.100915309701335, 31.786517865178666},
-		{52.74239502036416, 31.541715417154165},
-		{53.07413650661725, 31.30411304113042},
-		{53.1672272298005, 31.498514985149853},
-		{53.133376057733855, 32.304923049230496},
-		{53.3517161175637, 32.69372693726939},
-		{53.617447818286806, 32.405724057240576},
-		{53.79347391303334, 31.732517325173262},
-		{53.97457768358986, 31.79011790117903},
-		{54.15737401274971, 31.383313833138345},
-		{54.81239419223921, 30.756907569075707},
-		{55.081511010168995, 30.972909729097296},
-		{55.55034974329196, 30.87570875708758},
-		{55.78900050636177, 29.89649896498966},
-		{55.670521404128536, 29.370893708937103},
-		{55.91763496021501, 29.230492304923047},
-		{56.1698261921115, 28.175681756817568},
-		{56.75883658607101, 27.855278552785535},
-		{57.2446009052273, 27.768877688776882},
-		{57.47478887528045, 27.286472864728665},
-		{57.79129733410356, 27.718477184771842},
-		{58.72389712453948, 27.419674196741965},
-		{59.30105960827571, 28.132481324813256},
-		{59.4753931444189, 27.981279812798135},
-		{60.02885980770846, 29.118891188911903},
-		{60.502776216641436, 28.071280712807123},
-		{61.78065796215709, 30.209702097020966},
-		{62.357820445893324, 31.138511385113873},
-		{62.86728058549626, 31.516515165151645},
-		{63.5527668198457, 30.03690036900369},
-		{64.20440188212855, 30.443704437044374},
-		{64.94912766759462, 29.54369543695438},
-		{65.80556232088061, 30.216902169021694},
-		{66.94465426092306, 29.054090540905406},
-		{67.6978428394058, 29.975699756997585},
-		{68.36471092911863, 28.44568445684459},
-		{69.06543019089808, 28.593285932859345},
-		{69.156828355478, 29.399693996939988},
-		{69.5579647444677, 31.10251102511026},
-		{69.90663181675409, 32.13212132121322},
-		{69.30069583676122, 33.77373773737739},
-		{69.06373763229475, 36.51336513365135},
-		{67.93310848526896, 40.29340293402936},
-		{67.45749951773267, 41.060210602106025},
-		{66.79232398662317, 41.12501125011252},
-		{66.26593826098691, 40.01620016200164},
-		{66.0002065602638, 38.3817838178382},
-		{66.76016537315986, 33.91773917739178},
-		{66.63322347790997, 33.183331833318334},
-		{65.9003456026672, 34.81414814148141},
-		{65.43658454535425, 34.87894878948791},
-		{64.4142791489417, 34.943749437494375},
-		{64.10961860034193, 36.23256232562326},
-		{63.85065713403215, 37.013770137701385},
-		{64.3347288945851, 37.14337143371435},
-		{64.76463877983143, 36.53856538565387},
-		{65.14377190697779, 37.1757717577176},
-		{64.52091034095163, 39.5949959499595},
-		{64.76463877983143, 40.43380433804339},
-		{65.4975166550742, 39.76419764197644},
-		{66.4758155278001, 42.09342093420935},
-		{66.4182685352868, 43.0150301503015},
-		{66.06960146300041, 43.951039510395105},
-		{66.75678025595322, 44.53064530645307},
-		{67.35256088432608, 43.699036990369905},
-		{67.95003407130228, 44.18864188641888},
-		{68.57120307872513, 43.45423454234543},
-		{68.24961694409204, 46.25146251462516},
-		{67.68938004638916, 46.82026820268203},
-		{67.56582326834591, 45.55665556655566},
-		{67.01066404645302, 45.560255602556026},
-		{66.66707464997661, 46.34866348663488},
-		{66.88372215120312, 47.89307893078933},
-		{67.52181674465929, 48.1378813788138},
-		{67.99911827079892, 50.225902259022604},
-		{68.85724548268824, 53.7179371793718},
-		{68.80816128319161, 54.47034470344704},
-		{68.2005327445954, 53.48753487534876},
-		{68.09728666979215, 54.725947259472605},
-		{68.43918350766522, 55.44235442354423},
-		{68.46626444531854, 57.31797317973181},
-		{68.88094130313489, 58.80118801188013},
-		{68.27839044034867, 59.94239942399426},
-		{68.9401808542515, 61.07641076410766},
-		{69.52072845519437, 60.028800288002884},
-		{69.85077738284413, 60.55080550805508},
-		{69.5478093928477, 63.50283502835029},
-		{69.23468605123126, 64.8888488884889},
-		{68.09220899398217, 68.51048510485106},
-		{68.61520960241175, 69.180091800918},
-		{69.14498044525469, 68.1648816488165},
-		{69.35655027067118, 68.13608136081362},
-		{69.45471866966444, 66.93006930069302},
-		{69.9286350785974, 67.26127261272615},
-		{70.70890459473345, 66.7248672486725},
-		{71.02879817076321, 66.69606696066961},
-		{71.93431702354583, 68.53928539285394},
-		{72.84322099353513, 69.19809198091983},
-		{73.03955779152163, 69.93969939699397},
-		{72.77551864940183, 72.58932589325894},
-		{72.22035942750894, 72.79452794527947},
-		{71.40962385651291, 71.84771847718477},
-		{71.08973028048315, 72.47052470524707},
-		{70.39070357730704, 72.7909279092791},
-		{69.02142366721144, 72.56412564125642},
-		{68.40871745280525, 73.66933669336694},
-		{67.7401568044891, 73.23733237332374},
-		{66.32010013629356, 71.2789127891279},
-		{66.17284753780366, 72.42372423724237},
-		{66.53336252031339, 72.81972819728199},
-		{66.78893886941653, 73.92133921339214},
-		{67.28485854019279, 74.18774187741877},
-		{67.7604675077291, 75.05175051750518},
-		{68.32916719844864, 74.46854468544686},
-		{68.98926505374813, 74.93654936549368},
-		{69.07220042531141, 73.84213842138422},
-		{69.6273596472043, 73.60093600936011},
-		{70.63104689898019, 74.40014400144003},
-		{71.4468601457862, 73.10053100531007},
-		{72.12049846991236, 74.88974889748897},
-		{72.83306564191513, 74.65934659346595},
-		{72.85506890375844, 75.15975159751599},
-		{72.29990968186554, 75.68175681756819},
-		{71.33515127796629, 75.28935289352896},
-		{71.15235494880643, 76.35856358563586},
-		{71.87338491382589, 75.90135901359014},
-		{72.26775106840223, 77.57537575375756},
-		{72.32022038510551, 79.6525965259653},
-		{71.74982813578264, 81.49941499414996},
-		{72.58256696862198, 80.61020610206106},
-		{73.64887888872114, 80.50940509405098},
-		{73.85029336251766, 82.24822248222483},
-		{73.80628683883104, 84.65664656646567},
-		{73.93661385128759, 86.8238682386824},
-		{74.45961445971717, 86.01026010260102},
-		{75.11632719781001, 87.16587165871658},
-		{75.14340813546333, 88.31428314283141},
-		{75.63932780623959, 90.25830258302585},
-		{75.77303993590282, 92.90072900729007},
-		{76.04723442964261, 93.23553235532358},
-		{76.14032515282587, 95.85995859958598},
-		{75.91521485858273, 96.67716677166771},
-		{76.44667826002897, 98.9235892358924},
-		{76.42975267399565, 100.75960759607597},
-		{76.86135511784532, 101.03681036810372},
-		{77.28787988588498, 101.99081990819911},
-		{77.69747906789131, 104.35244352443527},
-		{77.3742003746549, 106.06606066060664},
-		{77.12708681856843, 104.70524705247055},
-		{76.97475654426854, 106.969669696697},
-		{76.4805294320956, 107.23967239672396},
-		{76.72256531237208, 108.15408154081541},
-		{76.71071740214877, 111.07731077310774},
-		{76.22156796578581, 113.33093330933309},
-		{75.84751251444943, 114.13374133741337},
-		{75.3278970232265, 113.88533885338853},
-		{75.03169926764342, 112.78012780127801},
-		{74.4765400457505, 110.1521015210152},
-		{74.18034229016742, 109.39969399693996},
-		{74.03985992609084, 110.6417064170642},
-		{73.78766869419438, 112.11772117721176},
-		{73.97723525776756, 113.02133021330212},
-		{73.33575554710474, 113.52893528935289},
-		{73.59471701341452, 113.96813968139685},
-		{73.7521249635244, 115.56655566555668},
-		{73.5879467790012, 118.77778777787779},
-		{73.12080060448157, 119.0189901899019},
-		{72.97185544738836, 123.20223202232023},
-		{73.7351993774911, 123.25623256232564},
-		{73.55917328274457, 125.38025380253805},
-		{73.56594351715789, 126.97506975069751},
-		{73.03786523291829, 128.59148591485916},
-		{72.39807808085881, 129.05229052290525},
-		{71.98001610583579, 128.45828458284586},
-		{71.1929763552864, 129.7146971469715},
-		{70.78676229048672, 131.28791287912878},
-		{71.83614862455256, 132.25272252722527},
-		{71.38592803606626, 133.85833858338583},
-		{71.65504485399605, 135.5611556115561},
-		{71.34699918818963, 137.4979749797498},
-		{71.62796391634272, 138.23238232382323},
-		{71.48748155226617, 139.87039870398706},
-		{72.41669622549544, 139.14679146791468},
-		{72.84999122794845, 140.46800468004682},
-		{72.20004872426897, 149.50049500495004},
-		{71.60596065449941, 150.350103501035},
-		{70.84261672439668, 152.96732967329672},
-		{71.03218328796986, 157.00657006570066},
-		{70.86631254484332, 158.99738997389977},
-		{70.45332824563032, 159.8289982899829},
-		{69.72214292899089, 159.710197101971},
-		{69.43779308363112, 160.94140941409415},
-		{69.64259267463429, 162.28062280622805},
-		{69.66798105368427, 164.05184051840519},
-		{69.47164425569774, 165.94185941859422},
-		{69.58335312351765, 167.83547835478356},
-		{68.69306729816502, 169.57789577895778},
-		{69.01296087419477, 170.81630816308166},
-		{69.65274802625429, 170.00990009900102},
-		{70.09619838032727, 170.45270452704528},
-		{69.81692621077748, 173.64233642336427},
-		{69.87785832049742, 175.72315723157232},
-		{69.4005567943578, 178.59958599585997},
-		{68.96387667469814, -180},
-		{68.2005327445954, -177.54837548375482},
-		{67.20530828583618, -174.92754927549277},
-		{66.58413927841333, -175.0139501395014},
-		{66.33533316372355, -174.34074340743408},
-		{67.06144080455296, -174.57114571145712},
-		{66.91249564745975, -171.85671856718568},
-		{65.97651073981716, -169.89829898298984},
-		{65.54152317876081, -170.8919089190892},
-		{65.43827710395756, -172.529925299253},
-		{64.45997823123166, -172.55512555125551},
-		{64.25348608162516, -172.95472954729547},
-		{64.28225957788182, -173.89073890738908},
-		{64.6309266501682, -174.65394653946538},
-		{64.9220467299413, -175.98235982359824},
-		{65.35703429099765, -176.20556205562056},
-		{65.5195199169175, -177.22437224372243},
-		{65.39088546306427, -178.35838358383583},
-		{65.74124509395399, -178.9019890198902},
-		{66.11191542808372, -178.6859868598686},
-		{65.87495722361724, -179.8847988479885},
-		{65.40442593189094, -179.43119431194313},
-		{64.9795937224546, -180},
-		{64.97451604664461, 179.99279992799927},
-		{64.53445080977828, 178.70758707587078},
-		{64.6089233883249, 177.4115741157412},
-		{64.07576742827531, 178.31158311583118},
-		{63.25149138845261, 178.90918909189094},
-		{62.98237457052282, 179.36999369993703},
-		{62.569390271309814, 179.4851948519485},
-		{62.30365857058668, 179.229592295923},
-		{62.52199863041652, 177.3647736477365},
-		{61.768810051933784, 174.56754567545676},
-		{61.652023508303856, 173.67833678336785},
-		{60.94961168792109, 172.14832148321483},
-		{60.3369054735149, 170.69750697506976},
-		{59.881607209218586, 170.33030330303302},
-		{60.57386367798138, 168.90108901089013},
-		{59.788516486035334, 166.2946629466295},
-		{60.15918682016503, 165.84105841058414},
-		{59.73096949352204, 164.87624876248765},
-		{59.86806674039194, 163.54063540635406},
-		{59.2113540022991, 163.2166321663217},
-		{58.2432104811932, 162.01782017820182},
-		{57.838688974996856, 162.0538205382054},
-		{57.61527123935703, 163.19143191431914},
-		{56.1596708404915, 163.05823058230584},
-		{56.12243455121818, 162.12942129421293},
-		{55.28631060117219, 161.70101701017012},
-		{54.85470815732252, 162.1186211862119},
-		{54.343555459116246, 160.3690036900369},
-		{53.20277096047046, 160.02340023400234},
-		{52.95904252159066, 158.52938529385295},
-		{51.943507359591464, 158.23058230582308},
-		{51.01090756915552, 156.79056790567904},
-		{51.69977892071164, 156.419764197642},
-		{53.15876443678384, 155.99135991359913},
-		{55.38109388295878, 155.43335433354332},
-		{56.767299379087675, 155.9157591575916},
-		{57.36477256606388, 156.75816758167582},
-		{57.83191874058353, 156.80856808568086},
-		{58.05533647622336, 158.36378363783638},
-		{59.314600077102355, 160.14940149401497},
-		{60.34367570792823, 161.87381873818737},
-		{61.14087081009761, 163.67023670236705},
-		{62.55077212667315, 164.47304473044733},
-		{62.46614419650656, 163.259832598326},
-		{61.641868156683884, 162.65862658626588},
-		{60.54339762312141, 160.12060120601205},
-		{61.77388772774377, 159.30339303393038},
-		{61.43368344847404, 156.72216722167224},
-		{59.758050431175334, 154.21654216542169},
-		{59.145344216769146, 155.04455044550446},
-		{58.88469019185602, 152.8125281252813},
-		{58.78144411705277, 151.26451264512644},
-		{59.504166640675535, 151.33651336513367},
-		{59.65649691497542, 149.78489784897852},
-		{59.16396236140581, 148.54648546485464},
-		{59.336603338945665, 145.48645486454865},
-		{59.040405583362585, 142.19602196021964},
-		{57.08888551372077, 138.95958959589598},
-		{54.72945882067596, 135.12555125551256},
-		{54.60420948402937, 136.70236702367026},
-		{53.97796280079653, 137.1919719197192},
-		{53.754545065156705, 138.16398163981643},
-		{54.25384985313964, 138.8047880478805},
-		{54.18953262621302, 139.90279902799028},
-		{53.089369534047236, 141.34641346413463},
-		{52.238012556571235, 141.3788137881379},
-		{51.239402980605334, 140.59760597605975},
-		{50.04614916525627, 140.5148051480515},
-		{48.446681285107516, 140.06120061200613},
-		{46.99954367925865, 138.55638556385566},
-		{46.30728721049586, 138.21798217982183},
-		{45.14280689140345, 136.8607686076861},
-		{43.98848192393102, 135.51435514355143},
-		{43.39777897136814, 134.869948699487},
-		{42.812153694615276, 133.53793537935383},
-		{42.7986132257886, 132.9079290792908},
-		{43.28437754494489, 132.27792277922782},
-		{42.55319222830546, 130.9351093510935},
-		{42.21975818344906, 130.78030780307802},
-	},
-	{
-		{78.30680016509085, 105.0760507605076},
-		{77.92089680353114, 99.43839438394383},
-		{79.23432227971678, 101.26361263612637},
-		{79.34603114753669, 102.08802088020883},
-		{79.28171392061007, 102.8368283682837},
-		{78.71301422989053, 105.37125371253711},
-		{78.30680016509085, 105.0760507605076},
-	},
-	{
-		{80.54774775590242, 51.13671136711369},
-		{80.41572818484254, 49.7938979389794},
-		{80.33956304769258, 48.893888938889404},
-		{80.17538486316938, 48.75348753487535},
-		{80.00951412004285, 47.587075870758724},
-		{80.24647232450933, 46.50346503465036},
-		{80.55959566612576, 47.07227072270723},
-		{80.59006172098573, 44.847448474484764},
-		{80.77116549154225, 46.798667986679874},
-		{80.7847059603689, 48.317883178831806},
-		{80.51389658383577, 48.5230852308523},
-		{80.75423990550892, 49.095490954909565},
-		{80.91841809003215, 50.03870038700387},
-		{80.7000780302023, 51.52191521915219},
-		{80.54774775590242, 51.13671136711369},
-	},
-	{
-		{78.8805775316204, 99.93879938799387},
-		{78.75702075357715, 97.75717757177574},
-		{79.0447557161436, 94.97434974349744},
-		{79.42727396049663, 93.31113311133112},
-		{80.14322624970607, 92.54432544325442},
-		{80.34125560629592, 91.17991179911797},
-		{81.02504928204205, 93.77913779137793},
-		{81.25015957628521, 95.93915939159393},
-		{80.7474696710956, 97.88317883178831},
-		{79.7793261499897, 100.1872018720187},
-		{78.8805775316204, 99.93879938799387},
-	},
-	{
-		{-1.135130440900383, 30.418504185041854},
-		{-1.698752455809938, 30.814508145081447},
-		{-2.2877628497694786, 30.756907569075707},
-		{-2.41301218641604, 30.468904689046894},
-		{-2.3486949594894355, 29.939699396993973},
-		{-2.9173946502089905, 29.633696336963368},
-		{-2.83953695445571, 29.02529025290255},
-		{-2.2928405255794786, 29.118891188911903},
-		{-2.214982829826198, 29.255692556925567},
-		{-1.6208947600566574, 29.29169291692918},
-		{-1.341622590506887, 29.579695796957964},
-		{-1.443176106706801, 29.8208982089821},
-		{-1.135130440900383, 30.418504185041854},
-	},
-	{
-		{27.656983960380444, -8.667086670866695},
-		{27.589281616247163, -8.66348663486633},
-		{27.39632993546732, -8.685086850868515},
-		{25.88148998548516, -8.688686886868851},
-		{25.933959302188455, -11.968319683196825},
-		{23.374810693950465, -11.935919359193576},
-		{23.28510508797386, -12.875528755287547},
-		{22.77056727256094, -13.12033120331202},
-		{21.32681478391872, -12.929529295292951},
-		{21.33358501833206, -16.846368463684627},
-		{21.000150973475655, -17.062370623706244},
-		{21.421598065705325, -17.019170191701903},
-		{21.419905507101987, -17.00117001170011},
-		{21.50114832006193, -14.751147511475097},
-		{21.861663302571642, -14.632346323463224},
-		{22.31019133245462, -14.221942219422175},
-		{23.691319152773545, -13.890738907389078},
-		{24.769478983096036, -12.50112501125011},
-		{26.030435142578384, -12.029520295202957},
-		{26.104907721124988, -11.719917199171988},
-		{26.883484678657723, -11.392313923139227},
-		{26.990115870667637, -10.549905499054972},
-		{26.8614814168144, -10.189901899018992},
-		{26.8614814168144, -9.73629736297363},
-		{27.08828426966089, -9.412294122941233},
-		{27.1204428831242, -8.793087930879295},
-		{27.656983960380444, -8.818288182881815},
-		{27.656983960380444, -8.667086670866695},
-	},
-	{
-		{16.34730737291595, 42.78102781027812},
-		{16.77383214095562, 42.64782647826479},
-		{17.075107572348713, 42.34902349023491},
-		{17.474551402735074, 42.26982269822699},
-		{17.833373826641463, 41.75501755017552},
-		{18.671190335290802, 41.22221222212224},
-		{19.487003582096833, 40.93780937809379},
-		{20.174182375049625, 40.246602466024655},
-		{20.338360559572834, 39.80019800198002},
-		{21.29127105324875, 39.1377913779138},
-		{21.986912639218218, 39.02259022590226},
-		{22.57930815038442, 39.065790657906575},
-		{23.687934035566883, 38.49338493384934},
-		{24.07891507293658, 38.02538025380255},
-		{24.285407222543085, 37.48537485374854},
-		{24.859184589072626, 37.154171541715414},
-		{25.084294883315792, 37.20817208172082},
-		{25.60221781593539, 36.9309693096931},
-		{25.825635551575203, 36.63936639366395},
-		{26.57036133704129, 36.250562505625055},
-		{27.376019232227335, 35.638556385563874},
-		{28.063198025180128, 35.13095130951311},
-		{28.058120349370128, 34.63054630546307},
-		{28.608201895453035, 34.78894788947889},
-		{28.956868967739425, 34.83214832148323},
-		{29.356312798125785, 34.95454954549547},
-		{29.197212289412576, 36.07056070560705},
-		{29.505257955218994, 36.502565025650256},
-		{29.86577293772872, 36.74016740167403},
-		{30.004562743201944, 37.50337503375036},
-		{30.337996788058348, 37.66897668976691},
-		{30.50894520699488, 38.00018000180003},
-		{31.509247341564105, 37.00297002970029},
-		{32.01024468815038, 39.00459004590047},
-		{32.16088240384693, 39.19539195391954},
-		{31.890073027313804, 40.40140401404014},
-		{31.18935376553435, 41.88821888218882},
-		{29.17859414477593, 44.71064710647107},
-		{29.099043890419324, 46.56826568265683},
-		{29.002568050029396, 47.461074610746124},
-		{28.52526652388977, 47.70947709477096},
-		{28.55234746154308, 48.41508415084152},
-		{27.689142573843753, 48.80748807488075},
-		{27.460647162393926, 49.30069300693009},
-		{27.110287531504213, 49.469894698947},
-		{26.68884043927453, 50.15390153901541},
-		{26.27754869866486, 50.21150211502115},
-		{25.944114653808455, 50.11430114301143},
-		{25.607295491745376, 50.24030240302403},
-		{25.328023322195605, 50.528305283052845},
-		{24.999666953149188, 50.661506615066145},
-		{24.75424595566605, 50.80910809108093},
-		{24.55621659907621, 51.11151111511117},
-		{24.62730406041615, 51.38871388713889},
-		{24.244785816063114, 51.57951579515796},
-		{24.014597846009963, 51.61911619116191},
-		{23.00075524261409, 52.00072000720007},
-		{22.49637277882114, 55.00675006750069},
-		{22.707942604237644, 55.20835208352085},
-		{22.000453108044866, 55.665556655566576},
-		{19.99984883890643, 54.99954999549996},
-		{18.99954670433722, 52.00072000720007},
-		{18.617028459984184, 49.11709117091172},
-		{18.166807871497866, 48.18468184681848},
-		{17.117421537432023, 47.468274682746824},
-		{16.949858235702152, 47.000270002700034},
-		{17.283292280558555, 46.748267482674834},
-		{17.2325155224586, 46.36666366663667},
-		{17.334069038658512, 45.39825398253984},
-		{17.433929996255102, 45.21825218252184},
-		{17.410234175808455, 44.06264062640628},
-		{17.320528569831865, 43.792637926379285},
-		{17.579490036141664, 43.382233822338236},
-		{17.088648041175375, 43.11583115831158},
-		{16.667200948945705, 43.21663216632166},
-		{16.34730737291595, 42.78102781027812},
-	},
-	{
-		{9.463671533164671, 33.96453964539646},
-		{9.483982236404657, 33.82413824138243},
-		{9.98159446578427, 33.84213842138422},
-		{10.325183862260658, 33.72333723337235},
-		{10.719550016837019, 33.208532085320854},
-		{11.440579981856459, 33.08613086130862},
-		{12.178535532909208, 33.208532085320854},
-		{12.247930435645827, 32.74412744127443},
-		{12.024512700006, 32.67572675726757},
-		{11.973735941906043, 32.07452074520745},
-		{11.680923303529596, 32.31572315723159},
-		{11.080064999346746, 32.39852398523985},
-		{10.531676011867162, 31.851318513185134},
-		{9.810646046847737, 31.35451354513546},
-		{9.707399972044485, 30.83610836108363},
-		{10.291332690194025, 29.99729997299974},
-		{10.084840540587521, 29.61929619296194},
-		{9.793720460814413, 29.514895148951496},
-		{9.604153897241233, 29.00009000090003},
-		{9.397661747634714, 28.967689676896782},
-		{9.397661747634714, 27.970479704797043},
-		{9.604153897241233, 27.83367833678338},
-		{9.638005069307866, 27.11367113671136},
-		{9.467056650371333, 26.75366753667538},
-		{9.553377139141261, 26.47646476464766},
-		{10.135617298687478, 25.961659616596165},
-		{10.411504351030601, 25.78885788857889},
-		{10.274407104160701, 25.0688506885069},
-		{9.810646046847737, 24.795247952479542},
-		{8.916975104288426, 24.536045360453613},
-		{8.729101099318584, 24.194041940419424},
-		{8.619084790101994, 23.88803888038882},
-		{8.666476430995289, 23.80523805238053},
-		{8.954211393561735, 23.45963459634598},
-		{9.26564217657483, 23.394833948339482},
-		{9.682011592994499, 23.556835568355694},
-		{10.089918216397507, 23.55323553235533},
-		{10.714472341027019, 22.977229772297733},
-		{11.142689667670027, 22.86562865628656},
-		{11.384725547946502, 22.876428764287652},
-		{11.679230744926272, 22.509225092250944},
-		{12.259778345869151, 22.49842498424985},
-		{12.64568170742885, 22.289622896228963},
-		{12.588134714915554, 21.93681936819368},
-		{12.955419931838605, 22.037620376203762},
-		{13.371789348258275, 22.29682296822969},
-		{13.786466206074621, 22.181621816218183},
-		{14.092819313277715, 22.51282512825128},
-		{14.326392400537529, 22.30402304023042},
-		{14.944176290753717, 22.566825668256683},
-		{15.680439283203143, 23.02403024030241},
-		{15.611044380466524, 23.88803888038882},
-		{19.5800943052801, 23.83763837638378},
-		{19.99984883890643, 23.848438484384843},
-		{20.003233956113093, 25.00045000450004},
-		{22.000453108044866, 25.00045000450004},
-		{22.000453108044866, 29.021690216902186},
-		{22.000453108044866, 32.898928989289914},
-		{22.000453108044866, 36.86616866168663},
-		{21.018769118112303, 37.190171901719026},
-		{20.83766534755577, 36.97056970569707},
-		{19.808589716729912, 37.114571145711466},
-		{18.61364334277752, 37.481774817748175},
-		{18.36822234529437, 37.86337863378634},
-		{17.997552011164657, 38.41058410584105},
-		{17.42715976184178, 37.902979029790316},
-		{17.26298157731857, 37.16857168571687},
-		{16.956628470115476, 36.85176851768517},
-		{16.291452939005993, 36.75456754567546},
-		{14.822312071313817, 36.32256322563225},
-		{14.422868240927457, 36.43056430564306},
-		{13.563048470434794, 36.268562685626875},
-		{12.577979363295569, 35.865358653586554},
-		{12.082059692519294, 35.26055260552607},
-		{11.31871576241656, 34.83214832148323},
-		{10.910809139013537, 34.73134731347315},
-		{10.629844410860429, 34.256142561425634},
-		{9.583843194001247, 33.96093960939609},
-		{9.463671533164671, 33.96453964539646},
-	},
-	{
-		{9.463671533164671, 33.96453964539646},
-		{8.68509457563195, 33.97533975339755},
-		{8.378741468428856, 33.82413824138243},
-		{8.355045647982209, 33.29493294932951},
-		{7.784653398659316, 32.95292952929529},
-		{7.713565937319373, 33.56853568535686},
-		{7.226109059559747, 34.07614076140763},
-		{6.8266652291734005, 34.248942489424905},
-		{6.594784700516911, 34.70614706147063},
-		{5.506469518574434, 35.296552965529656},
-		{4.846371663274951, 34.61974619746198},
-		{4.250591034902072, 34.00414004140043},
-		{3.7902150947957693, 33.38853388533886},
-		{3.7919076533991074, 32.68652686526866},
-		{3.55833456613928, 31.88011880118802},
-		{3.7817523017791075, 31.246512465124653},
-		{3.509250366642661, 30.832508325083268},
-		{4.174425897752144, 29.95409954099543},
-		{4.6009506657918, 29.716497164971656},
-		{4.38938084037531, 29.15849158491585},
-		{4.4553906259052525, 28.69768697686979},
-		{4.287827324175382, 28.42768427684277},
-		{4.407998985011957, 27.981279812798135},
-		{5.233967583437973, 27.37287372873729},
-		{5.550476042261067, 27.21447214472144},
-		{5.9465347554407515, 26.465664656646567},
-		{6.547393059623616, 26.213662136621366},
-		{6.978995503473286, 25.796057960579617},
-		{7.500303553299531, 25.122851228512303},
-		{7.825274805139287, 25.115651156511575},
-		{8.229796311335633, 24.56844568445686},
-		{8.619084790101994, 23.88803888038882},
-		{8.729101099318584, 24.194041940419424},
-		{8.916975104288426, 24.536045360453613},
-		{9.810646046847737, 24.795247952479542},
-		{10.274407104160701, 25.0688506885069},
-		{10.411504351030601, 25.78885788857889},
-		{10.135617298687478, 25.961659616596165},
-		{9.553377139141261, 26.47646476464766},
-		{9.467056650371333, 26.75366753667538},
-		{9.638005069307866, 27.11367113671136},
-		{9.604153897241233, 27.83367833678338},
-		{9.397661747634714, 27.970479704797043},
-		{9.397661747634714, 28.967689676896782},
-		{9.604153897241233, 29.00009000090003},
-		{9.793720460814413, 29.514895148951496},
-		{10.084840540587521, 29.61929619296194},
-		{10.291332690194025, 29.99729997299974},
-		{9.707399972044485, 30.83610836108363},
-		{9.810646046847737, 31.35451354513546},
-		{10.531676011867162, 31.851318513185134},
-		{11.080064999346746, 32.39852398523985},
-		{11.680923303529596, 32.31572315723159},
-		{11.973735941906043, 32.07452074520745},
-		{12.024512700006, 32.67572675726757},
-		{12.247930435645827, 32.74412744127443},
-		{12.178535532909208, 33.208532085320854},
-		{11.440579981856459, 33.08613086130862},
-		{10.719550016837019, 33.208532085320854},
-		{10.325183862260658, 33.72333723337235},
-		{9.98159446578427, 33.84213842138422},
-		{9.483982236404657, 33.82413824138243},
-		{9.463671533164671, 33.96453964539646},
-	},
-	{
-		{13.595207083898103, -16.713167131671298},
-		{14.373784041430824, -17.12717127171271},
-		{14.729221348130551, -17.623976239762385},
-		{14.918787911703731, -17.18477184771848},
-		{15.621199732086524, -16.702367023670234},
-		{16.13573754749946, -16.46476464764646},
-		{16.4556311235292, -16.11916119161191},
-		{16.369310634759273, -15.622356223562235},
-		{16.5876506945891, -15.136351363513626},
-		{16.597806046209087, -14.57834578345782},
-		{16.304993407832654, -14.099540995409939},
-		{16.03926170710953, -13.437134371343717},
-		{15.302998714660106, -12.832328323283235},
-		{14.617512480310637, -12.169921699216985},
-		{13.994650914284463, -12.12312123121231},
-		{13.422566106358246, -11.928719287192877},
-		{13.141601378205124, -11.55431554315544},
-		{12.754005458042101, -11.467914679146787},
-		{12.442574675029007, -11.514715147151463},
-		{12.38672024111905, -11.658716587165856},
-		{12.466270495475655, -12.202322023220233},
-		{12.354561627655741, -12.277922779227794},
-		{12.332558365812432, -12.497524975249746},
-		{12.57628680469223, -13.217532175321736},
-		{12.58644215631223, -13.699936999369982},
-		{12.628756121395526, -15.546755467554675},
-		{12.515354694972288, -15.816758167581668},
-		{12.547513308435597, -16.147961479614793},
-		{12.385027682515712, -16.677166771667714},
-		{13.151756729825124, -16.842768427684263},
-		{13.1297534679818, -15.931959319593176},
-		{13.270235832058361, -15.690756907569067},
-		{13.278698625075023, -15.510755107551063},
-		{13.508886595128175, -15.13995139951399},
-		{13.299009328315009, -14.711547115471149},
-		{13.280391183678347, -14.27594275942758},
-		{13.505501477921513, -13.843938439384374},
-		{13.793236440487945, -14.045540455404534},
-		{13.625673138758088, -14.37674376743766},
-		{13.630750814568074, -14.686346863468628},
-		{13.876171812051226, -15.082350823508222},
-		{13.860938784621226, -15.399153991539919},
-		{13.62398058015475, -15.6259562595626},
-		{13.595207083898103, -16.713167131671298},
-	},
-	{
-		{-10.483131607103076, 162.1186211862119},
-		{-10.826721003579465, 162.39942399423995},
-		{-10.819950769166141, 161.70101701017012},
-		{-10.205551996156615, 161.31941319413193},
-		{-10.445895317829766, 161.91701917019174},
-		{-10.483131607103076, 162.1186211862119},
-	},
-	{
-		{-9.872117951300211, 160.85140851408516},
-		{-9.895813771746859, 160.4626046260463},
-		{-9.794260255546945, 159.8505985059851},
-		{-9.640237422643722, 159.6417964179642},
-		{-9.2424861508607, 159.70299702997033},
-		{-9.399894100970585, 160.36180361803622},
-		{-9.60977136778375, 160.68940689406895},
-		{-9.872117951300211, 160.85140851408516},
-	},
-	{
-		{-9.599616016163765, 161.67941679416793},
-		{-9.784104903926945, 161.52821528215281},
-		{-8.917514899020958, 160.78660786607867},
-		{-8.320041712044755, 160.58140581405814},
-		{-8.320041712044755, 160.91980919809197},
-		{-9.1206219314208, 161.27981279812798},
-		{-9.599616016163765, 161.67941679416793},
-	},
-	{
-		{-8.33696729807808, 159.87579875798758},
-		{-8.538381771874583, 159.9189991899919},
-		{-8.113549562438251, 159.13419134191344},
-		{-7.754727138531862, 158.58698586985872},
-		{-7.421293093675459, 158.21258212582126},
-		{-7.319739577475545, 158.360183601836},
-		{-7.560082899148682, 158.8209882098821},
-		{-8.020458839255, 159.6417964179642},
-		{-8.33696729807808, 159.87579875798758},
-	},
-	{
-		{-7.348513073732192, 157.53937539375397},
-		{-7.404367507642149, 157.3377733777338},
-		{-7.17756465479566, 156.9021690216902},
-		{-6.766272914185976, 156.49176491764916},
-		{-6.598709612456105, 156.5421654216542},
-		{-7.021849263289113, 157.13977139771401},
-		{-7.348513073732192, 157.53937539375397},
-	},
-	{
-		{6.786043822693429, -11.439114391143903},
-		{6.860516401240034, -11.709117091170896},
-		{7.263345348833056, -12.429124291242914},
-		{7.798193867485978, -12.947529475294743},
-		{8.16378652580569, -13.123931239312384},
-		{8.903434635461778, -13.24633246332462},
-		{9.343499872328096, -12.713527135271335},
-		{9.619386924671218, -12.598325983259826},
-		{9.836034425897708, -12.42552425524255},
-		{9.858037687741032, -12.151921519215193},
-		{10.047604251314212, -11.917919179191784},
-		{10.045911692710874, -11.11871118711187},
-		{9.688781827407823, -10.837908379083785},
-		{9.267334735178153, -10.621906219062197},
-		{8.977907214008383, -10.654306543065417},
-		{8.715560630491922, -10.495904959049597},
-		{8.34827541356887, -10.50670506705066},
-		{8.405822406082166, -10.22950229502294},
-		{7.938676231562525, -10.693906939069393},
-		{7.397057478496279, -11.147511475114754},
-		{7.105937398723185, -11.201512015120159},
-		{6.786043822693429, -11.439114391143903},
-	},
-	{
-		{13.383637258481599, -87.79227792277922},
-		{13.148371612618462, -87.90387903879038},
-		{13.163604640048447, -88.48348483484834},
-		{13.260080480438361, -88.84348843488435},
-		{13.458109837028218, -89.25749257492575},
-		{13.520734505351498, -89.81189811898119},
-		{13.735689447974664, -90.09630096300963},
-		{13.881249487861211, -90.06390063900639},
-		{14.133440719757687, -89.72189721897219},
-		{14.2451495875776, -89.53469534695347},
-		{14.3619361312075, -89.58869588695886},
-		{14.424560799530795, -89.35469354693547},
-		{14.33993286936419, -89.05949059490595},
-		{14.14021095417101, -88.84348843488435},
-		{13.979417886854478, -88.54108541085411},
-		{13.84570575719124, -88.50508505085051},
-		{13.964184859424478, -88.06588065880659},
-		{13.893097398084535, -87.86067860678607},
-		{13.784773647471297, -87.72387723877239},
-		{13.383637258481599, -87.79227792277922},
-	},
-	{
-		{8.002993458489144, 47.788677886778885},
-		{7.99622322407582, 46.949869498694994},
-		{9.184399363614887, 43.67743677436775},
-		{9.539836670314614, 43.295832958329584},
-		{10.022215872264226, 42.928629286292875},
-		{10.572297418347134, 42.5578255782558},
-		{10.926042166443523, 42.777427774277754},
-		{11.462583243699768, 43.14463144631446},
-		{11.278094355936588, 43.472234722347224},
-		{10.863417498120242, 43.666636666366685},
-		{10.445355523097234, 44.11664116641168},
-		{10.441970405890572, 44.613446134461356},
-		{10.69754675499371, 45.55665556655566},
-		{10.816025857226947, 46.64386643866439},
-		{11.127456640240041, 47.52587525875259},
-		{11.193466425769984, 48.022680226802265},
-		{11.37626275492984, 48.37908379083791},
-		{11.410113926996488, 48.94788947889481},
-		{11.394880899566488, 48.94068940689408},
-		{10.98189660035348, 48.937089370893716},
-		{9.973131672767607, 48.937089370893716},
-		{9.451823622941347, 48.937089370893716},
-		{8.837424849931821, 48.48708487084872},
-		{8.002993458489144, 47.788677886778885},
-	},
-	{
-		{-1.6835194283799524, 41.58581585815858},
-		{-0.8575508299539223, 40.99180991809919},
-		{2.784835284416559, 40.9810098100981},
-		{3.918849548649007, 41.855818558185604},
-		{4.233665448868763, 42.12942129421296},
-		{4.25228359350541, 42.770227702277026},
-		{4.958080531094865, 43.65943659436596},
-		{5.002087054781498, 44.96264962649627},
-		{8.002993458489144, 47.788677886778885},
-		{8.837424849931821, 48.48708487084872},
-		{9.451823622941347, 48.937089370893716},
-		{9.973131672767607, 48.937089370893716},
-		{10.98189660035348, 48.937089370893716},
-		{11.394880899566488, 48.94068940689408},
-		{11.410113926996488, 48.94788947889481},
-		{11.430424630236459, 49.26829268292684},
-		{11.579369787329682, 49.72909729097293},
-		{11.679230744926272, 50.25830258302585},
-		{12.021127582799338, 50.73350733507337},
-		{12.024512700006, 51.11151111511117},
-		{11.748625647662877, 51.13311133111333},
-		{11.166385488116674, 51.04311043110431},
-		{10.641692321083752, 51.046710467104674},
-		{10.279484779970701, 50.83430834308345},
-		{9.197939832441548, 50.553505535055365},
-		{8.082543712845748, 50.07110071100712},
-		{6.804661967330077, 49.45189451894521},
-		{5.338906216844563, 48.59508595085953},
-		{4.220124980042101, 47.74187741877421},
-		{2.8559227457565015, 46.564665646656465},
-		{2.0451871747604713, 45.56385563855639},
-		{1.0533478332079085, 44.069840698407006},
-		{0.2916964617085114, 43.13743137431376},
-		{-0.9184829396738792, 42.04302043020431},
-		{-1.4465612239134629, 41.81261812618126},
-		{-1.6835194283799524, 41.58581585815858},
-	},
-	{
-		{44.235595480017494, 22.6568265682657},
-		{44.00879262717099, 22.4120241202412},
-		{43.64319996885128, 22.49842498424985},
-		{43.21159752500161, 22.98442984429846},
-		{42.898474183385204, 22.60642606426066},
-		{42.580273165958786, 22.43722437224372},
-		{42.46179406372555, 22.545225452254527},
-		{42.31961914104565, 22.37962379623798},
-		{42.304386113615664, 21.91881918819189},
-		{42.245146562499045, 21.5768157681577},
-		{42.31961914104565, 21.544415444154453},
-		{42.43979080188221, 21.663216632166325},
-		{42.68351924076204, 21.774817748177497},
-		{42.676749006348714, 21.63441634416344},
-		{42.86293045271522, 21.44001440014401},
-		{42.910322093608514, 21.27441274412746},
-		{43.06942260232172, 21.144811448114496},
-		{43.130354712041694, 20.957609576095763},
-		{43.27252963472158, 20.81360813608137},
-		{43.21667520081162, 20.633606336063366},
-		{42.88493371455853, 20.4968049680497},
-		{42.812153694615276, 20.25920259202593},
-		{42.898474183385204, 20.338403384033853},
-		{43.106658891595046, 19.956799567995688},
-		{43.213290083604946, 19.629196291962927},
-		{43.35207988907818, 19.485194851948535},
-		{43.5230283080147, 19.218792187921878},
-		{43.56872739030466, 19.452794527945287},
-		{44.03925868203096, 19.600396003960043},
-		{44.423469484987336, 19.117991179911797},
-		{44.86353472185367, 19.366393663936634},
-		{44.86014960464699, 19.006390063900653},
-		{45.235897614586705, 19.391593915939154},
-		{45.52194001854981, 19.07119071190712},
-		{45.909535938712835, 18.829988299883013},
-		{46.171882522229296, 19.59679596795968},
-		{46.12787599854268, 20.21960219602198},
-		{45.73520240256964, 20.76320763207633},
-		{45.41700138514322, 20.874808748087474},
-		{45.181735739280086, 21.48321483214832},
-		{44.76875144006708, 21.562415624156245},
-		{44.477631360293955, 22.14562145621457},
-		{44.70274165453712, 22.458824588245903},
-		{44.57749231789056, 22.70722707227074},
-		{44.40992901616069, 22.47322473224733},
-		{44.235595480017494, 22.6568265682657},
-	},
-	{
-		{5.973615693094061, -57.14877148771487},
-		{5.772201219297557, -55.949959499594996},
-		{5.953304989854075, -55.84195841958419},
-		{6.026085009797356, -55.031950319503196},
-		{5.756968191867571, -53.95913959139591},
-		{4.897148421374908, -54.477544775447754},
-		{4.213354745628777, -54.39834398343983},
-		{3.6192666758592367, -54.00594005940059},
-		{3.189356790612905, -54.18234182341823},
-		{2.732365967713264, -54.268742687426865},
-		{2.312611434086932, -54.52434524345243},
-		{2.524181259503422, -55.096750967509664},
-		{2.42093518470017, -55.56835568355683},
-		{2.5106407906767743, -55.97155971559715},
-		{2.221213269507004, -56.07236072360723},
-		{2.0214913543138238, -55.90675906759067},
-		{1.8183843219139817, -55.99675996759967},
-		{1.89962713487391, -56.540365403654036},
-		{2.7696022569865733, -57.14877148771487},
-		{3.3332242718961282, -57.281972819728196},
-		{3.3349168304994663, -57.60237602376023},
-		{4.061024471328892, -58.04518045180451},
-		{4.577254845345152, -57.86157861578616},
-		{4.8125204912083035, -57.91557915579155},
-		{5.0731745161214405, -57.307173071730716},
-		{5.973615693094061, -57.14877148771487},
-	},
-	{
-		{49.49606761917336, 18.85158851588517},
-		{49.43513550945342, 18.909189091890937},
-		{49.57223275632332, 19.319593195931958},
-		{49.216795449623575, 19.82359823598236},
-		{49.43175039224674, 20.417604176041777},
-		{49.32850431744349, 20.88920889208893},
-		{49.4706792401234, 21.60921609216092},
-		{49.08646843716703, 22.559625596255984},
-		{48.825814412253905, 22.282422824228263},
-		{48.42298546466087, 22.084420844208438},
-		{48.31973938985762, 21.872018720187214},
-		{48.62439993845739, 20.80280802808028},
-		{48.563467828737444, 20.475204752047517},
-		{48.32820218287428, 20.237602376023773},
-		{48.20295284622772, 19.769597695976955},
-		{48.267270073154336, 19.661596615966175},
-		{48.111554681647775, 19.175591755917566},
-		{48.0810886267878, 18.77598775987761},
-		{47.88136671159462, 18.696786967869684},
-		{47.7578099335514, 17.857978579785794},
-		{47.867826242767975, 17.48717487174872},
-		{48.12340259187111, 16.979569795697955},
-		{48.470377105554164, 16.878768787687875},
-		{48.59731900080406, 16.961569615696163},
-		{48.81735161923724, 17.10197101971019},
-		{48.80042603320392, 17.54477544775449},
-		{48.90367210800717, 17.886778867788678},
-		{48.99676283119042, 17.911979119791198},
-		{49.04415447208372, 18.10638106381066},
-		{49.27095732493022, 18.171181711817127},
-		{49.31496384861684, 18.40158401584017},
-		{49.49437506057005, 18.556385563855656},
-		{49.49606761917336, 18.85158851588517},
-	},
-	{
-		{46.508701684292376, 13.80793807938079},
-		{46.43253654714242, 14.632346323463253},
-		{46.65933939998892, 15.136351363513654},
-		{46.68303522043557, 16.01116011160113},
-		{46.85229108076878, 16.201962019620197},
-		{46.84213572914878, 16.37116371163711},
-		{46.50362400848239, 16.56556565565657},
-		{46.23789230775925, 15.769957699576992},
-		{45.83337080156291, 15.672756727567275},
-		{45.73181728536299, 15.323553235532358},
-		{45.45254511581321, 15.327153271532723},
-		{45.47116326044984, 14.934749347493494},
-		{45.635341444973065, 14.59634596345964},
-		{45.466085584639856, 14.412744127441272},
-		{45.499936756706504, 13.714337143371438},
-		{45.59133492128642, 13.937539375393754},
-		{46.01616713072276, 13.696336963369646},
-		{46.508701684292376, 13.80793807938079},
-	},
-	{
-		{58.85591669559939, 11.028710287102882},
-		{59.43307917933559, 11.467914679146787},
-		{60.11856541368506, 12.29952299522995},
-		{61.293201084397495, 12.630726307263075},
-		{61.80096866539709, 11.993519935199345},
-		{63.12793461040938, 11.93231932319324},
-		{64.06561207665531, 12.580325803258035},
-		{64.04868649062199, 13.570335703357046},
-		{64.44474520380167, 13.919539195391962},
-		{64.78664204167474, 13.55593555935559},
-		{66.19315824104366, 15.10755107551077},
-		{67.30178412622611, 16.108361083610845},
-		{68.0143512982289, 16.76716767167673},
-		{68.01096618102223, 17.728377283772858},
-		{68.56781796151846, 17.994779947799486},
-		{68.40702489420191, 19.877598775987764},
-		{69.06543019089808, 20.02520025200252},
-		{69.10605159737803, 20.644406444064458},
-		{68.61690216101509, 21.980019800198022},
-		{67.93649360247561, 23.538835388353903},
-		{66.39626527344349, 23.56763567635676},
-		{66.00697679467713, 23.902439024390247},
-		{65.7243195079207, 22.181621816218183},
-		{65.02529280474455, 21.213212132121328},
-		{64.4142791489417, 21.368013680136812},
-		{63.610313812358996, 19.780397803978047},
-		{62.74880148326302, 17.84717847178473},
-		{61.34059272529079, 17.119971199712012},
-		{60.63648834630466, 17.832778327783274},
-		{60.081329124411766, 18.786787867878672},
-		{58.954085094592656, 17.868778687786886},
-		{58.72051200733283, 16.828368283682835},
-		{57.04149387282747, 16.44676446764467},
-		{56.103816406581544, 15.881558815588164},
-		{56.20029224697147, 14.668346683466837},
-		{55.408174820612075, 14.099540995409967},
-		{55.36247573832212, 12.943929439294408},
-		{56.30692343898137, 12.623526235262347},
-		{57.44263026181717, 11.788317883178848},
-		{58.85591669559939, 11.028710287102882},
-	},
-	{
-		{-26.733386757693694, 32.07092070920709},
-		{-27.17852967037002, 31.869318693186926},
-		{-27.285160862379932, 31.282512825128265},
-		{-26.743542109313687, 30.68490684906851},
-		{-26.39826015423396, 30.677706777067783},
-		{-26.022512144294254, 30.95130951309514},
-		{-25.731392064521145, 31.044910449104492},
-		{-25.660304603181203, 31.332913329133305},
-		{-25.84310093234106, 31.836918369183707},
-		{-26.291628962224046, 31.984519845198463},
-		{-26.733386757693694, 32.07092070920709},
-	},
-	{
-		{33.379524598245965, 38.79218792187922},
-		{32.31321267814681, 36.83376833768338},
-		{32.709271391326496, 35.721357213572134},
-		{32.71604162573982, 35.69975699756998},
-		{32.868371900039705, 35.83655836558367},
-		{33.27797108204605, 35.822158221582214},
-		{33.824667510922296, 36.066960669606715},
-		{34.20210807946533, 36.610566105661064},
-		{34.59308911683502, 36.44856448564485},
-		{34.64555843353831, 35.998559985599854},
-		{35.410594922244385, 35.9049590495905},
-		{35.821886662854055, 36.149761497614975},
-		{36.0402267226839, 36.41616416164163},
-		{36.26025934111705, 36.686166861668625},
-		{36.81711112161328, 36.74016740167403},
-		{36.6224668822301, 37.06777067770679},
-		{36.901739051779884, 38.169381693816945},
-		{36.712172488206704, 38.698586985869866},
-		{36.715557605413366, 39.5229952299523},
-		{37.091305615353065, 40.675006750067496},
-		{37.074380029319755, 41.211412114121146},
-		{37.23009542082629, 42.34902349023491},
-		{36.605541296196776, 41.83781837818378},
-		{36.358427740110315, 41.29061290612907},
-		{35.62893498207421, 41.38421384213842},
-		{34.41875558069182, 41.00621006210062},
-		{33.379524598245965, 38.79218792187922},
-	},
-	{
-		{12.858944091448677, 14.49554495544956},
-		{13.331167941778318, 14.59634596345964},
-		{13.353171203621628, 13.955539555395575},
-		{13.996343472887787, 13.955539555395575},
-		{14.3670138070175, 13.541535415354161},
-		{15.683824400409804, 13.973539735397367},
-		{16.626579542465734, 15.247952479524798},
-		{17.928157108428053, 15.301953019530202},
-		{19.957534873823136, 15.687156871568732},
-		{20.387444759069467, 15.90315903159032},
-		{20.731034155545856, 15.485554855548571},
-		{21.049235172972274, 15.471154711547115},
-		{21.308196639282073, 15.096750967509678},
-		{22.86365799574419, 14.851948519485205},
-		{23.410354424620436, 15.859958599586008},
-		{21.49437808564859, 19.84879848798488},
-		{19.5800943052801, 23.83763837638378},
-		{15.611044380466524, 23.88803888038882},
-		{15.680439283203143, 23.02403024030241},
-		{14.944176290753717, 22.566825668256683},
-		{14.326392400537529, 22.30402304023042},
-		{14.092819313277715, 22.51282512825128},
-		{13.786466206074621, 22.181621816218183},
-		{13.371789348258275, 22.29682296822969},
-		{12.955419931838605, 22.037620376203762},
-		{12.588134714915554, 21.93681936819368},
-		{12.64568170742885, 22.289622896228963},
-		{12.259778345869151, 22.49842498424985},
-		{11.679230744926272, 22.509225092250944},
-		{11.384725547946502, 22.876428764287652},
-		{11.142689667670027, 22.86562865628656},
-		{10.971741248733494, 22.232022320223223},
-		{10.567219742537134, 21.724417244172457},
-		{9.475519443387995, 21.000810008100075},
-		{9.013450944678354, 20.061200612006132},
-		{9.07438305439831, 19.092790927909277},
-		{8.982984889818383, 18.811988119881192},
-		{8.630932700325317, 18.912789127891273},
-		{8.28057306943559, 18.39078390783908},
-		{7.89128459066923, 17.965979659796602},
-		{7.508766346316193, 16.7059670596706},
-		{7.735569199162683, 16.457564575645762},
-		{7.7541873437993445, 16.291962919629214},
-		{7.496918436092869, 16.10476104761048},
-		{7.422445857546265, 15.280352803528046},
-		{7.693255234079388, 15.435154351543531},
-		{8.382126585635518, 15.121951219512198},
-		{8.796803443451864, 14.98154981549817},
-		{8.966059303785059, 14.5459454594546},
-		{9.5499920219346, 13.955539555395575},
-		{10.022215872264226, 14.171541715417163},
-		{9.920662356064312, 14.628746287462889},
-		{9.991749817404255, 14.909549095490974},
-		{9.98159446578427, 15.46755467554675},
-		{10.890498435773551, 14.923949239492401},
-		{11.555673966883035, 14.959949599496014},
-		{12.21915693938918, 14.895148951489517},
-		{12.858944091448677, 14.49554495544956},
-	},
-	{
-		{6.14287155342727, 1.8666186661866675},
-		{5.929609169407428, 1.0602106021060251},
-		{6.279968800297155, 0.8370083700837085},
-		{6.914678276546667, 0.5706057060570799},
-		{7.412290505926279, 0.49140491404915565},
-		{8.3127316828989, 0.7110071100711082},
-		{8.676631782615289, 0.4626046260462715},
-		{9.465364091767995, 0.3654036540365553},
-		{10.191471732597435, 0.36900369003691935},
-		{10.707702106613695, -0.04860048600485811},
-		{11.019132889626789, 0.02340023400233804},
-		{10.997129627783465, 0.8982089820898409},
-		{10.47074390214722, 0.7722077220772405},
-		{10.17623870516745, 1.078210782107817},
-		{9.825879074277722, 1.4238142381423984},
-		{9.335037079311434, 1.4634146341463463},
-		{9.12854492970493, 1.665016650166507},
-		{6.8317429049834, 1.6182161821618308},
-		{6.14287155342727, 1.8666186661866675},
-	},
-	{
-		{12.18699832592587, 102.5848258482585},
-		{12.64568170742885, 101.68841688416887},
-		{12.627063562792202, 100.83160831608319},
-		{13.412410754738247, 100.97920979209795},
-		{13.407333078928247, 100.09720097200972},
-		{12.307169986762446, 100.01800018000182},
-		{10.846491912086918, 99.47799477994784},
-		{9.962976321147607, 99.15399153991541},
-		{9.238561238921505, 99.22239222392227},
-		{9.208095184061534, 99.87399873998743},
-		{8.295806096865576, 100.28080280802811},
-		{7.429216091959589, 100.46080460804609},
-		{6.857131284033372, 101.0188101881019},
-		{6.740344740403472, 101.62361623616238},
-		{6.222421807783874, 102.14202142021423},
-		{5.81113006717419, 101.81441814418145},
-		{5.690958406337614, 101.15561155611556},
-		{6.2054962217505505, 101.07641076410766},
-		{6.642176341410206, 100.25920259202593},
-		{6.4644576880603495, 100.08640086400862},
-		{6.84866849101671, 99.69039690396903},
-		{7.34289560318966, 99.52119521195215},
-		{7.908210176702553, 98.98838988389883},
-		{8.382126585635518, 98.50238502385025},
-		{7.7948087502793015, 98.34038340383404},
-		{8.349967972172209, 98.14958149581497},
-		{8.97452209680172, 98.25758257582578},
-		{9.932510266287636, 98.55278552785529},
-		{10.95989333851017, 99.03879038790387},
-		{11.8924931289461, 99.58599585995859},
-		{12.804782216142058, 99.19719197191972},
-		{13.268543273455023, 99.21159211592118},
-		{13.827087612554593, 99.09639096390964},
-		{14.622590156120637, 98.43038430384303},
-		{15.123587502706911, 98.19278192781928},
-		{15.308076390470106, 98.53838538385384},
-		{16.178051512582755, 98.90198901989021},
-		{16.838149367882238, 98.49518495184952},
-		{17.56764212591834, 97.85797857978582},
-		{18.44608004104765, 97.37557375573755},
-		{18.62718381160417, 97.79677796777969},
-		{19.708728759133322, 98.25398253982542},
-		{19.752735282819955, 98.95958959589598},
-		{20.18603028527295, 99.54279542795427},
-		{20.41791081392944, 100.11520115201154},
-		{20.109865148123006, 100.54720547205471},
-		{19.509006843940142, 100.60480604806048},
-		{19.463307761650185, 101.28161281612819},
-		{18.40884375177434, 101.03681036810372},
-		{17.511787692008383, 101.05841058410584},
-		{18.10926087898457, 102.11322113221132},
-		{17.933234784238053, 102.4120241202412},
-		{17.962008280494686, 102.99882998829992},
-		{18.30898279417775, 103.20043200432008},
-		{18.24128045004447, 103.95643956439568},
-		{17.428852320445102, 104.71604716047159},
-		{16.442090654702554, 104.78084780847809},
-		{15.570422973986567, 105.58725587255873},
-		{14.724143672320551, 105.54405544055442},
-		{14.273923083834248, 105.22005220052199},
-		{14.416098006514133, 104.28044280442805},
-		{14.226531442940939, 102.98802988029882},
-		{13.393792610101599, 102.34722347223476},
-		{12.18699832592587, 102.5848258482585},
-	},
-	{
-		{40.24454229336061, 71.01251012510127},
-		{39.93649662755418, 70.6489064890649},
-		{40.10236737068071, 69.55809558095581},
-		{39.52689744554783, 69.46449464494646},
-		{39.6047551413011, 70.54810548105482},
-		{39.279783889461356, 71.7829178291783},
-		{39.4304216051579, 73.67653676536767},
-		{38.506284607738635, 73.92853928539287},
-		{38.60614556533521, 74.25614256142563},
-		{38.379342712488736, 74.86454864548645},
-		{37.99005423372236, 74.82854828548287},
-		{37.41966198439948, 74.97974979749799},
-		{37.421354543002806, 73.95013950139503},
-		{37.495827121549425, 73.2589325893259},
-		{37.04729909166643, 72.63612636126362},
-		{36.94913069267318, 72.19332193321935},
-		{36.737560867256676, 71.84411844118443},
-		{37.06591723630309, 71.44811448114481},
-		{37.90542630355577, 71.5417154171542},
-		{37.952817944449066, 71.23931239312395},
-		{38.25917105165216, 71.34731347313473},
-		{38.48597390449865, 70.80730807308075},
-		{38.138999390815584, 70.37530375303754},
-		{37.73447788461924, 70.2709027090271},
-		{37.58891784473268, 70.11610116101161},
-		{37.60922854797266, 69.51849518495186},
-		{37.15054516646968, 69.19449194491946},
-		{37.34349684724954, 68.85968859688597},
-		{37.023603271219784, 68.13608136081362},
-		{37.1454674906597, 67.83007830078301},
-		{38.15761753545223, 68.39168391683918},
-		{38.90234332091832, 68.17568175681757},
-		{39.139301525384795, 67.44127441274415},
-		{39.58105932085445, 67.70047700477005},
-		{39.533667679961155, 68.53568535685358},
-		{40.0854417846474, 69.0108901089011},
-		{40.72861405391356, 69.33129331293313},
-		{40.96049458257005, 70.66690666906669},
-		{40.49673352525707, 70.45810458104583},
-		{40.219153914310624, 70.60210602106022},
-		{40.24454229336061, 71.01251012510127},
-	},
-	{
-		{35.6492456853142, 61.20961209612096},
-		{36.49213986977354, 61.12321123211234},
-		{36.52768360044351, 60.3780037800378},
-		{37.412891749986144, 59.233192331923334},
-		{37.522908059202734, 58.437584375843755},
-		{38.02898308159901, 57.32877328773287},
-		{38.12207380478226, 56.619566195661974},
-		{37.93589235841574, 56.18036180361804},
-		{37.96466585467239, 55.51075510755109},
-		{37.39258104674617, 54.801548015480165},
-		{37.19962936596632, 53.92313923139233},
-		{37.90542630355577, 53.735937359373594},
-		{38.95142752041495, 53.879938799388015},
-		{39.289939241081356, 53.10233102331023},
-		{39.97542547543081, 53.35793357933579},
-		{40.032972467944106, 52.69552695526957},
-		{40.875866652403445, 52.915129151291524},
-		{40.63044565492031, 53.85833858338583},
-		{40.95033923095005, 54.7367473674737},
-		{41.551197535132914, 54.00954009540095},
-		{42.12328234305913, 53.721537215372166},
-		{41.86770599395601, 52.915129151291524},
-		{41.134828118713244, 52.81432814328144},
-		{41.783078063789404, 52.50112501125011},
-		{42.11651210864581, 52.94392943929441},
-		{42.32469681685565, 54.07794077940781},
-		{42.04373208870253, 54.75474754747549},
-		{41.260077455359806, 55.45675456754569},
-		{41.30916165485644, 55.96795967959682},
-		{41.3227021236831, 57.09477094770949},
-		{41.8253920288727, 56.93276932769328},
-		{42.170673983952426, 57.7859778597786},
-		{42.751221584895305, 58.62838628386285},
-		{42.22314330065572, 59.97479974799748},
-		{41.42594819848635, 60.08280082800829},
-		{41.22114860748317, 60.46440464404645},
-		{41.266847689773144, 61.54801548015482},
-		{41.08405136061329, 61.88281882818828},
-		{40.05328317118409, 62.376023760237615},
-		{39.36271926102462, 63.51723517235172},
-		{38.892187969298334, 64.1688416884169},
-		{38.40303853293538, 65.21645216452166},
-		{37.974821206292376, 66.54486544865449},
-		{37.36211499188619, 66.51966519665197},
-		{37.3942736053495, 66.21726217262173},
-		{37.66169786467596, 65.74565745657458},
-		{37.30456799937291, 65.58725587255873},
-		{37.11161631859305, 64.7448474484745},
-		{36.312728657820344, 64.5468454684547},
-		{36.00806810922059, 63.98163981639817},
-		{35.85743039352404, 63.19323193231932},
-		{35.40382468783106, 62.98442984429846},
-		{35.270112558167824, 62.23202232022322},
-		{35.6492456853142, 61.20961209612096},
-	},
-	{
-		{-8.892126519970986, 124.969849698497},
-		{-8.656860874107835, 125.08505085050854},
-		{-8.43175057986467, 125.94545945459458},
-		{-8.397899407798036, 126.64386643866442},
-		{-8.27265007115146, 126.9570695706957},
-		{-8.397899407798036, 127.33507335073352},
-		{-8.668708784331159, 126.96786967869679},
-		{-9.105388903990814, 125.92745927459276},
-		{-9.39312386655726, 125.0886508865089},
-		{-9.090155876560829, 125.07065070650708},
-		{-8.892126519970986, 124.969849698497},
-	},
-	{
-		{10.76017142331699, -61.68121681216812},
-		{10.890498435773551, -61.10521105211052},
-		{10.85495470510358, -60.89640896408963},
-		{10.110228919637493, -60.93600936009359},
-		{10.000212610420917, -61.77121771217712},
-		{10.089918216397507, -61.951219512195124},
-		{10.36580526874063, -61.65961659616596},
-		{10.76017142331699, -61.68121681216812},
-	},
-	{
-		{30.307530733198377, 9.480694806948065},
-		{32.10333541133363, 9.055890558905588},
-		{32.506164358926654, 8.440284402844043},
-		{32.74820023920313, 8.42948429484295},
-		{33.34398086757599, 7.612276122761244},
-		{34.09716944605874, 7.525875258752592},
-		{34.65571378515831, 8.141481414814166},
-		{35.47998982498099, 8.375483754837546},
-		{36.43290031865692, 8.217082170821726},
-		{36.94574557546652, 8.422284222842222},
-		{37.350267081662864, 9.50949509495095},
-		{37.23009542082629, 10.211502115021148},
-		{36.72402039843003, 10.179101791017928},
-		{37.091305615353065, 11.028710287102882},
-		{36.900046493176546, 11.100711007110078},
-		{36.40920449821027, 10.600306003060041},
-		{35.94713599950063, 10.593105931059313},
-		{35.69832988481083, 10.938709387093866},
-		{34.83343243850817, 10.80910809108093},
-		{34.330742533318556, 10.150301503015044},
-		{33.78573866304565, 10.341103411034112},
-		{33.76881307701234, 10.855908559085606},
-		{33.29320410947604, 11.107911079110806},
-		{33.13748871796949, 11.489514895148972},
-		{32.369067112056754, 11.431914319143203},
-		{32.08133214949032, 10.945909459094594},
-		{31.761438573460566, 10.636306363063625},
-		{31.375535211900868, 9.948699486994883},
-		{30.96255091268786, 10.056700567005663},
-		{30.539411261854852, 9.970299702997039},
-		{30.307530733198377, 9.480694806948065},
-	},
-	{
-		{41.53596450770293, 41.55341553415536},
-		{41.58335614859622, 42.619026190261906},
-		{41.092514153629935, 43.5838358383584},
-		{40.740461964136884, 43.75303753037531},
-		{40.25300508637726, 43.65583655836559},
-		{40.00419897168746, 44.4010440104401},
-		{39.71307889191435, 44.79344793447936},
-		{39.42872904655458, 44.109441094410954},
-		{38.28117431349547, 44.42264422644226},
-		{37.971436089085714, 44.22464224642246},
-		{37.17085586970967, 44.771847718477204},
-		{37.001600009376475, 44.29304293042932},
-		{37.255483799876274, 43.943839438394406},
-		{37.385810812332835, 42.777427774277754},
-		{37.23009542082629, 42.34902349023491},
-		{37.074380029319755, 41.211412114121146},
-		{37.091305615353065, 40.675006750067496},
-		{36.715557605413366, 39.5229952299523},
-		{36.712172488206704, 38.698586985869866},
-		{36.901739051779884, 38.169381693816945},
-		{36.6224668822301, 37.06777067770679},
-		{36.81711112161328, 36.74016740167403},
-		{36.26025934111705, 36.686166861668625},
-		{36.0402267226839, 36.41616416164163},
-		{35.821886662854055, 36.149761497614975},
-		{36.275492368547035, 35.782557825578266},
-		{36.65124037848675, 36.16056160561607},
-		{36.56491988971682, 35.55215552155522},
-		{36.79510785976997, 34.71334713347133},
-		{36.21963793463708, 34.02574025740259},
-		{36.10792906681718, 32.51012510125102},
-		{36.64447014407342, 31.700117001170014},
-		{36.67832131614006, 30.620106201062015},
-		{36.26364445832371, 30.38970389703897},
-		{36.14516535609047, 29.698496984969864},
-		{36.67662875753673, 28.733687336873373},
-		{36.65801061290007, 27.642876428764282},
-		{37.653235071659296, 27.048870488704893},
-		{38.20839429355219, 26.31806318063181},
-		{38.985278692481586, 26.80406804068042},
-		{39.46427277722455, 26.170461704617054},
-		{40.42056838810713, 27.279272792727937},
-		{40.459497235983775, 28.820088200882026},
-		{41.21945604887985, 29.24129241292414},
-		{41.08743647781995, 31.145711457114572},
-		{41.73568642289611, 32.34812348123481},
-		{42.018343709652555, 33.51453514535146},
-		{42.040346971495865, 35.16695166951669},
-		{41.334550033906424, 36.912969129691305},
-		{40.948646672346726, 38.34938349383495},
-		{41.102669505249935, 39.51219512195124},
-		{41.01296389927333, 40.372603726037255},
-		{41.53596450770293, 41.55341553415536},
-	},
-	{
-		{40.82339733570015, 26.055260552605546},
-		{40.9367987621234, 26.29286292862929},
-		{41.561352886752914, 26.60246602466026},
-		{41.82708458747604, 26.11646116461165},
-		{42.14190048769579, 27.135271352713545},
-		{42.008188358032555, 27.995679956799563},
-		{41.62228499647286, 28.114481144811464},
-		{41.30069886183978, 28.989289892898938},
-		{41.05527786435664, 28.80568805688057},
-		{40.99942343044668, 27.617676176761762},
-		{40.69137776464025, 27.192871928719285},
-		{40.15145157017734, 26.357663576635787},
-		{40.618597744696984, 26.044460444604454},
-		{40.82339733570015, 26.055260552605546},
-	},
-	{
-		{24.393730973156323, 121.77661776617765},
-		{22.79087797580091, 121.17541175411753},
-		{21.969987053184894, 120.74700747007472},
-		{22.814573796247558, 120.22140221402213},
-		{23.555914464506984, 120.10620106201065},
-		{24.539291013042885, 120.69300693006932},
-		{25.295864708732296, 121.4958149581496},
-		{24.997974394545864, 121.95301953019532},
-		{24.393730973156323, 121.77661776617765},
-	},
-	{
-		{-0.9506415531371886, 33.90333903339035},
-		{-1.0606578623537644, 34.072540725407265},
-		{-3.096805862162171, 37.69777697776979},
-		{-3.6773534631050495, 37.766177661776624},
-		{-4.675963039070936, 39.20259202592027},
-		{-5.90814570229665, 38.74178741787418},
-		{-6.475152834412867, 38.79938799387995},
-		{-6.84074549273258, 39.44019440194404},
-		{-7.099706959042379, 39.468994689946896},
-		{-7.703950380431905, 39.19539195391954},
-		{-8.008610929031676, 39.25299252992531},
-		{-8.485912455171302, 39.18819188191884},
-		{-9.112159138404138, 39.53739537395376},
-		{-10.098920804146701, 39.95139951399514},
-		{-10.317260863976529, 40.315003150031515},
-		{-10.896115906316084, 39.51939519395194},
-		{-11.285404385082444, 38.428584285842874},
-		{-11.26847879904912, 37.827378273782756},
-		{-11.56806167183889, 37.47097470974711},
-		{-11.5951426094922, 36.77616776167761},
-		{-11.720391946138761, 36.51336513365135},
-		{-11.439427217985653, 35.31095310953111},
-		{-11.520670030945595, 34.558545585455875},
-		{-10.159852913866658, 34.281342813428154},
-		{-9.694399297950355, 33.93933939339394},
-		{-9.416819687003908, 33.74133741337414},
-		{-9.230638240637376, 32.75852758527586},
-		{-8.93105536784762, 32.19332193321935},
-		{-8.76179950751441, 31.55611556115562},
-		{-8.59423620578454, 31.156511565115665},
-		{-8.340352415284741, 30.738907389073887},
-		{-7.0793962558023935, 30.1989019890199},
-		{-6.5191593580995, 29.61929619296194},
-		{-5.94030431575996, 29.421294212942144},
-		{-5.420688824537024, 29.51849518495186},
-		{-4.499936944324418, 29.338493384933855},
-		{-4.452545303431123, 29.75249752497527},
-		{-4.090337762318072, 30.116101161011613},
-		{-3.569029712491812, 30.504905049050507},
-		{-3.3591524456786317, 30.753307533075343},
-		{-3.03418119383889, 30.74250742507425},
-		{-2.8073783409924005, 30.526505265052663},
-		{-2.41301218641604, 30.468904689046894},
-		{-2.2877628497694786, 30.756907569075707},
-		{-1.698752455809938, 30.814508145081447},
-		{-1.135130440900383, 30.418504185041854},
-		{-1.0149587800638074, 30.771307713077135},
-		{-1.0268066902871311, 31.86571865718659},
-		{-0.9506415531371886, 33.90333903339035},
-	},
-	{
-		{-1.135130440900383, 30.418504185041854},
-		{-1.443176106706801, 29.8208982089821},
-		{-1.341622590506887, 29.579695796957964},
-		{-0.5867414534207995, 29.586895868958692},
-		{-0.20591576767110098, 29.8208982089821},
-		{0.5980495689116054, 29.874898748987505},
-		{1.0618106262245703, 30.08730087300873},
-		{1.5831186760508302, 30.468904689046894},
-		{1.848850376773953, 30.854108541085424},
-		{2.20428768347368, 31.174511745117456},
-		{2.3396923717402416, 30.7749077490775},
-		{3.509250366642661, 30.832508325083268},
-		{3.7817523017791075, 31.246512465124653},
-		{3.55833456613928, 31.88011880118802},
-		{3.7919076533991074, 32.68652686526866},
-		{3.7902150947957693, 33.38853388533886},
-		{4.250591034902072, 34.00414004140043},
-		{3.554949448932618, 34.47934479344795},
-		{3.0539521023463436, 34.59454594545946},
-		{1.906397369287248, 35.037350373503756},
-		{1.176904611251146, 34.67374673746738},
-		{0.5151141973483391, 34.18054180541807},
-		{0.11059269115197878, 33.89253892538926},
-		{-0.9506415531371886, 33.90333903339035},
-		{-1.0268066902871311, 31.86571865718659},
-		{-1.0149587800638074, 30.771307713077135},
-		{-1.135130440900383, 30.418504185041854},
-	},
-	{
-		{52.100915309701335, 31.786517865178666},
-		{52.0619864618247, 32.1609216092161},
-		{52.28878931467118, 32.412924129241304},
-		{52.238012556571235, 32.715327153271545},
-		{52.33448839696116, 33.75213752137523},
-		{51.76917382344827, 34.3929439294393},
-		{51.566066791048414, 34.1409414094141},
-		{51.25632856663866, 34.223742237422385},
-		{51.207244367142025, 35.0229502295023},
-		{50.773949364689045, 35.379353793537945},
-		{50.57761256670251, 35.35775357753579},
-		{50.225560377209476, 36.62496624966249},
-		{50.384660885922685, 37.39177391773919},
-		{49.91582215279972, 38.010980109801096},
-		{49.92597750441969, 38.59418594185942},
-		{49.60100625257995, 40.070200702007014},
-		{49.30819361420352, 40.081000810008106},
-		{48.783500447170596, 39.67419674196742},
-		{48.23172634248435, 39.89739897398974},
-		{47.89829229762795, 39.73899738997392},
-		{47.825512277684666, 38.77058770587706},
-		{47.54624010813488, 38.2557825578256},
-		{47.1027897540619, 38.22338223382235},
-		{47.02154694110196, 37.424174241742435},
-		{46.698268247865556, 36.75816758167582},
-		{46.645798931162275, 35.822158221582214},
-		{46.27343603842921, 34.961749617496196},
-		{45.65057447240305, 35.019350193501936},
-		{45.4102311507299, 35.50895508955091},
-		{45.46947070184653, 36.53136531365314},
-		{45.11403339514679, 36.33336333363334},
-		{44.939699859003596, 35.23895238952392},
-		{44.360844816664056, 33.88173881738817},
-		{44.56564440766722, 33.327333273332755},
-		{45.034483140790186, 33.54693546935471},
-		{45.32729577916662, 32.45252452524525},
-		{45.51855490134314, 32.63252632526326},
-		{45.85198894619954, 33.586535865358655},
-		{46.08048435764938, 33.29853298532987},
-		{46.33267558954584, 31.743317433174326},
-		{46.70673104088222, 31.674916749167494},
-		{46.583174262838995, 30.74970749707498},
-		{46.03309271675609, 30.378903789037906},
-		{45.2934446071, 29.604896048960484},
-		{45.46439302603652, 29.151291512915122},
-		{45.30359995871997, 28.67968679686797},
-		{45.488088846483166, 28.233282332823336},
-		{45.59641259709643, 28.485284852848537},
-		{45.94000199357281, 28.661686616866177},
-		{46.258203010999225, 28.935289352893534},
-		{46.43761422295243, 28.863288632886338},
-		{46.51716447730904, 29.072090720907227},
-		{46.38006723043914, 29.169291692916943},
-		{46.34960117557917, 29.75969759697597},
-		{46.424073754125786, 30.026100261002625},
-		{46.5256272703257, 29.838898388983893},
-		{46.67457242741891, 29.907299072990725},
-		{46.92845621791871, 29.558095580955808},
-		{47.34651819294173, 29.414094140941415},
-		{47.510696377464924, 29.05049050490507},
-		{47.849208098131314, 29.122491224912267},
-		{48.11832491606113, 28.67248672486727},
-		{48.15556120533442, 28.258482584825856},
-		{48.466991988347516, 27.52407524075241},
-		{48.36882358935425, 26.858068580685824},
-		{48.22157099086438, 26.62046620466205},
-		{48.22157099086438, 26.195661956619574},
-		{47.98630534500121, 25.947259472594737},
-		{47.89152206321462, 25.209252092520927},
-		{47.7374992303114, 24.86724867248674},
-		{47.98122766919123, 24.402844028440285},
-		{47.98630534500121, 23.76203762037622},
-		{48.09632165421779, 23.14283142831428},
-		{47.88136671159462, 22.710827108271076},
-		{48.150483529524436, 22.642426424264244},
-		{48.42298546466087, 22.084420844208438},
-		{48.825814412253905, 22.282422824228263},
-		{49.08646843716703, 22.559625596255984},
-		{49.027228886050395, 22.775627756277572},
-		{49.477449474536726, 22.520025200252007},
-		{50.30849574877273, 23.42723427234273},
-		{50.425282292402656, 23.924039240392403},
-		{50.70624702055575, 24.028440284402848},
-		{51.57791470127175, 23.52803528035281},
-		{51.616843549148385, 24.00684006840069},
-		{51.88765292568151, 24.554045540455405},
-		{51.911348746128155, 25.3280532805328},
-		{51.83179849177155, 26.339663396633966},
-		{51.59314772870174, 27.455674556745578},
-		{51.57283702546174, 28.240482404824064},
-		{51.427276985575205, 28.618486184861865},
-		{51.6016105217184, 28.992889928899302},
-		{51.36803743445857, 29.255692556925567},
-		{51.41542907535187, 30.15570155701559},
-		{51.31895323496194, 30.555305553055547},
-		{51.82333569875489, 30.620106201062015},
-		{52.0416757585847, 30.92610926109262},
-		{52.100915309701335, 31.786517865178666},
-	},
-	{
-		{-30.216672363350973, -57.6239762397624},
-		{-30.11004117134106, -56.97596975969759},
-		{-30.883540453063787, -55.97155971559715},
-		{-30.85307439820381, -55.600756007560065},
-		{-31.494554108866645, -54.571145711457106},
-		{-32.04802077215621, -53.786337863378634},
-		{-32.728429330695676, -53.21033210332102},
-		{-33.20234573962864, -53.649536495364956},
-		{-33.76766031314153, -53.372333723337235},
-		{-34.39729211358104, -53.80793807938079},
-		{-34.95245133547394, -54.934749347493465},
-		{-34.75272942028076, -55.672756727567275},
-		{-34.85936061229068, -56.216362163621625},
-		{-34.43114328564768, -57.13797137971379},
-		{-34.46330189911099, -57.81837818378183},
-		{-33.90983523582142, -58.42678426784268},
-		{-33.26327784934859, -58.35118351183512},
-		{-33.03986011370877, -58.13158131581315},
-		{-32.044635654949545, -58.14238142381423},
-		{-31.017252582727018, -57.8759787597876},
-		{-30.216672363350973, -57.6239762397624},
-	},
-	{
-		{19.08417463450381, -155.54135541355413},
-		{18.91661133277394, -155.68895688956889},
-		{19.05878625545384, -155.93735937359372},
-		{19.33805842500361, -155.90855908559087},
-		{19.703651083323336, -156.07416074160741},
-		{19.813667392539912, -156.02376023760237},
-		{19.97784557706312, -155.8509585095851},
-		{20.174182375049625, -155.91935919359193},
-		{20.26727309823289, -155.8617586175862},
-		{20.248654953596244, -155.7861578615786},
-		{20.079399093263035, -155.4009540095401},
-		{19.993078604493107, -155.22455224552246},
-		{19.85936647482987, -155.06255062550625},
-		{19.509006843940142, -154.80694806948068},
-		{19.4531524100302, -154.8321483214832},
-		{19.239890026010357, -155.2209522095221},
-		{19.08417463450381, -155.54135541355413},
-	},
-	{
-		{20.644713666775928, -156.07776077760778},
-		{20.571933646832647, -156.4161641616416},
-		{20.78350347224915, -156.58536585365854},
-		{20.864746285209094, -156.70056700567005},
-		{20.927370953532375, -156.71136711367114},
-		{21.01199888369898, -156.61416614166143},
-		{20.917215601912375, -156.25776257762578},
-		{20.764885327612504, -155.9949599495995},
-		{20.644713666775928, -156.07776077760778},
-	},
-	{
-		{21.176177068222174, -156.75816758167582},
-		{21.06954587621226, -156.79056790567904},
-		{21.098319372468907, -157.3269732697327},
-		{21.220183591908807, -157.25137251372513},
-		{21.176177068222174, -156.75816758167582},
-	},
-	{
-		{21.321737108108735, -157.65457654576545},
-		{21.26419011559544, -157.70857708577086},
-		{21.277730584422102, -157.7769777697777},
-		{21.313274315092073, -158.12618126181263},
-		{21.538384609335225, -158.2521825218252},
-		{21.579006015815196, -158.29178291782918},
-		{21.71779582128842, -158.02538025380255},
-		{21.6534785943618, -157.94257942579426},
-		{21.321737108108735, -157.65457654576545},
-	},
-	{
-		{21.981834963408218, -159.34659346593466},
-		{21.883666564414966, -159.46539465394653},
-		{22.064770334971485, -159.80019800198002},
-		{22.137550354914765, -159.74979749797498},
-		{22.235718753908017, -159.5949959499595},
-		{22.21540805066803, -159.36459364593645},
-		{21.981834963408218, -159.34659346593466},
-	},
-	{
-		{45.13772921559344, -67.13887138871388},
-		{44.80937284654705, -66.9660696606966},
-		{44.32530108599407, -68.03168031680316},
-		{43.98001913091436, -69.06129061290612},
-		{43.68382137533125, -70.1161011610116},
-		{43.08973330556172, -70.64530645306452},
-		{42.86462301131856, -70.81450814508145},
-		{42.334852168475635, -70.82530825308253},
-		{41.80508132563271, -70.4941049410494},
-		{41.77969294658274, -70.080100801008},
-		{42.145285604902455, -70.18450184501845},
-		{41.923560427865965, -69.88569885698857},
-		{41.63751802390284, -69.9648996489965},
-		{41.47503239798297, -70.64170641706417},
-		{41.49365054261963, -71.12051120511205},
-		{41.319317006476425, -71.85851858518585},
-		{41.270232806979806, -72.2941229412294},
-		{41.22114860748317, -72.87732877328773},
-		{40.9317210863134, -73.70893708937089},
-		{41.11959509128326, -72.24012240122401},
-		{40.930028527710064, -71.94491944919449},
-		{40.63044565492031, -73.34533345333453},
-		{40.62875309631697, -73.98253982539825},
-		{40.75061731575687, -73.95373953739536},
-		{40.47303770481042, -74.2561425614256},
-		{40.427338622520466, -73.96093960939609},
-		{39.70969377470769, -74.1769417694177},
-		{38.93957961019163, -74.90774907749078},
-		{39.19684851789809, -74.97974979749797},
-		{39.24762527599805, -75.19935199351993},
-		{39.498123949291184, -75.52695526955269},
-		{38.959890313431615, -75.32175321753218},
-		{38.782171660081744, -75.07335073350733},
-		{38.40473109153871, -75.05535055350553},
-		{38.01544261277235, -75.37575375753757},
-		{37.21655495199964, -75.94095940959409},
-		{37.25717635847961, -76.03096030960309},
-		{37.93758491701908, -75.72135721357213},
-		{38.31841060276878, -76.23256232562325},
-		{39.149456877004795, -76.35136351363514},
-		{38.717854433155125, -76.54216542165422},
-		{38.08314495690563, -76.32976329763298},
-		{38.2405529070155, -76.98856988569885},
-		{37.917274213779095, -76.3009630096301},
-		{36.9660562787065, -76.25776257762577},
-		{36.896661375969884, -75.97335973359733},
-		{36.55137942089016, -75.86895868958689},
-		{35.55107728632095, -75.72855728557285},
-		{34.80804405945818, -76.36216362163621},
-		{34.51184630387509, -77.3989739897399},
-		{33.92622102712221, -78.05418054180541},
-		{33.86190380019559, -78.55458554585546},
-		{33.494618583272555, -79.06219062190621},
-		{33.157799421209475, -79.20259202592025},
-		{32.509549476133316, -80.30060300603006},
-		{32.03394050859703, -80.86580865808658},
-		{31.439852438827486, -81.33741337413373},
-		{30.73067038403137, -81.49221492214922},
-		{30.035028798061916, -81.31221312213121},
-		{29.180286703379252, -80.9810098100981},
-		{28.472797207186474, -80.53460534605345},
-		{28.03950220473348, -80.5310053100531},
-		{26.880099561451047, -80.05580055800557},
-		{26.206461237324916, -80.08820088200882},
-		{25.817172758558556, -80.13140131401313},
-		{25.20615910275569, -80.37980379803798},
-		{25.079217207505792, -80.67860678606786},
-		{25.201081426945706, -81.17181171811718},
-		{25.639454105208685, -81.33021330213302},
-		{25.869642075261837, -81.70821708217082},
-		{26.7294618457545, -82.2410224102241},
-		{27.494498334460573, -82.70542705427054},
-		{27.88547937183027, -82.85662856628566},
-		{28.55065490293974, -82.65142651426514},
-		{29.100736449022648, -82.92862928629286},
-		{29.936860399068664, -83.70983709837098},
-		{30.08919067336855, -84.09864098640986},
-		{29.63558496767557, -85.11025110251101},
-		{29.686361725775527, -85.28665286652866},
-		{30.15181534169183, -85.77265772657726},
-		{30.40062145638163, -86.3990639906399},
-		{30.27367956113173, -87.52947529475294},
-		{30.385388428951643, -88.41868418684186},
-		{30.31599352621504, -89.18189181891819},
-		{30.16027813470849, -89.59229592295922},
-		{29.89454643398537, -89.41229412294122},
-		{29.488332369185684, -89.43029430294303},
-		{29.290303012595828, -89.21789217892179},
-		{29.159976000139267, -89.40869408694087},
-		{29.307228598629152, -89.77949779497794},
-		{29.11766203505597, -90.1539015390154},
-		{29.148128089915943, -90.88110881108811},
-		{29.676206374155527, -91.62631626316262},
-		{29.552649596112303, -92.49752497524975},
-		{29.78453012476878, -93.22473224732246},
-		{29.713442663428836, -93.84753847538475},
-		{29.479869576169023, -94.68994689946899},
-		{28.738528907909597, -95.60075600756008},
-		{28.30692646405994, -96.59436594365944},
-		{27.829624937920315, -97.14157141571415},
-		{27.379404349433997, -97.36837368373683},
-		{26.690532997877867, -97.37917379173791},
-		{26.209846354531578, -97.32877328773287},
-		{25.869642075261837, -97.14157141571415},
-		{25.839176020401865, -97.53037530375303},
-		{26.05920863883503, -98.23958239582396},
-		{26.37063942184811, -99.02079020790208},
-		{26.83947815497109, -99.30159301593015},
-		{27.54019741675053, -99.52119521195212},
-		{28.110589666073423, -100.11160111601116},
-		{28.696214942826302, -100.45720457204571},
-		{29.380008618572433, -100.95760957609576},
-		{29.779452448958793, -101.66321663216632},
-		{29.76083430432213, -102.48042480424805},
-		{28.970409436566086, -103.11043110431105},
-		{29.269992309355857, -103.93843938439385},
-		{29.57126774074895, -104.45684456844567},
-		{30.121349286831858, -104.70524705247053},
-		{30.644349895261442, -105.03645036450364},
-		{31.08441513212776, -105.63045630456304},
-		{31.399231032347515, -106.14166141661417},
-		{31.754668339047242, -106.50886508865088},
-		{31.754668339047242, -108.24048240482405},
-		{31.341684039834234, -108.24048240482405},
-		{31.341684039834234, -109.0360903609036},
-		{31.334913805420896, -111.02331023310232},
-		{32.03901818440701, -113.30573305733057},
-		{32.5247825035633, -114.81414814148141},
-		{32.72111930154982, -114.72054720547206},
-		{32.61279555093657, -115.99135991359914},
-		{32.5349378551833, -117.1289712897129},
-		{33.04609055338956, -117.29457294572946},
-		{33.621560478522454, -117.94257942579426},
-		{33.741732139359016, -118.41058410584105},
-		{34.027774543322124, -118.51858518585186},
-		{34.078551301422095, -119.08019080190802},
-		{34.34766811935188, -119.44019440194401},
-		{34.44752907694847, -120.36900369003689},
-		{34.60832214426502, -120.62460624606246},
-		{35.156711131744586, -120.74340743407434},
-		{36.1620909421238, -121.71541715417155},
-		{37.55168155545938, -122.54702547025471},
-		{37.78356208411586, -122.5110251102511},
-		{38.1136110117656, -122.95382953829538},
-		{38.95142752041495, -123.72783727837279},
-		{39.76724076722098, -123.86463864638645},
-		{40.313937196097214, -124.39744397443974},
-		{41.14159835312657, -124.17784177841779},
-		{41.99972556501589, -124.21384213842138},
-		{42.76645461232529, -124.53424534245343},
-		{43.709209754381234, -124.14184141841417},
-		{44.61642116576719, -124.01944019440194},
-		{45.52363257715315, -123.90063900639007},
-		{46.86413899099209, -124.08064080640807},
-		{47.720573644278105, -124.39744397443974},
-		{48.184334701591055, -124.68544685446855},
-		{48.37897894097425, -124.56664566645667},
-		{48.04046722030783, -123.11943119431194},
-		{47.09601951964858, -122.58662586625866},
-		{47.36005866176838, -122.33822338223382},
-		{48.17925702578107, -122.50022500225002},
-		{49.0001479483971, -122.83862838628386},
-		{49.0001479483971, -120.00180001800018},
-		{49.0001479483971, -117.03177031770318},
-		{49.0001479483971, -116.0489604896049},
-		{49.0001479483971, -112.99972999729997},
-		{49.0001479483971, -110.05130051300513},
-		{49.0001479483971, -107.04887048870488},
-		{49.0001479483971, -104.050040500405},
-		{49.0001479483971, -100.65160651606516},
-		{49.0001479483971, -97.22797227972279},
-		{49.0001479483971, -95.1579515795158},
-		{49.384358751353446, -95.15435154351543},
-		{49.38943642716346, -94.8159481594816},
-		{48.83935488108055, -94.63954639546395},
-		{48.670099020747344, -94.329943299433},
-		{48.6091669110274, -93.63153631536315},
-		{48.45006640231419, -92.6091260912609},
-		{48.140328177904436, -91.64071640716406},
-		{48.27065519036098, -90.83070830708307},
-		{48.01000116544786, -89.59949599495995},
-		{48.02015651706786, -89.27189271892719},
-		{48.30281380382429, -88.37908379083791},
-		{47.940606262711256, -87.43947439474394},
-		{47.553010342548234, -86.46026460264602},
-		{47.21957629769183, -85.65385653856538},
-		{46.899682721662074, -84.87624876248762},
-		{46.63733613814561, -84.7790477904779},
-		{46.53916773915235, -84.5450454504545},
-		{46.43930678155577, -84.60624606246063},
-		{46.40884072669577, -84.33624336243362},
-		{46.512086801499024, -84.14184141841417},
-		{46.27512859703255, -84.09144091440913},
-		{46.11772064692268, -83.88983889838899},
-		{46.11772064692268, -83.61623616236162},
-		{45.994163868879454, -83.46863468634686},
-		{45.81644521552958, -83.59463594635946},
-		{45.34760648240662, -82.55062550625506},
-		{44.44039507102066, -82.33822338223382},
-		{43.570419948908, -82.13662136621366},
-		{42.97971699634512, -82.42822428224282},
-		{42.42963545026224, -82.89982899828998},
-		{42.0792758193725, -83.11943119431194},
-		{41.976029744569246, -83.1410314103141},
-		{41.83216226328602, -83.02943029430294},
-		{41.67475431317615, -82.6910269102691},
-		{41.67475431317615, -82.4390243902439},
-		{42.20960283182907, -81.27621276212761},
-		{42.367010781938944, -80.24660246602465},
-		{42.86293045271522, -78.93978939789397},
-		{42.96448396891513, -78.92178921789217},
-		{43.27083707611824, -79.01179011790117},
-		{43.467173874104745, -79.17019170191702},
-		{43.624581824214616, -78.72018720187201},
-		{43.62965950002463, -77.73737737377374},
-		{43.62796694142129, -76.81936819368194},
-		{44.01894797879099, -76.49896498964989},
-		{44.09680567454426, -76.37656376563766},
-		{44.81614308096037, -75.31815318153181},
-		{45.00063196872354, -74.86814868148682},
-		{45.00740220313689, -73.34893348933488},
-		{45.00740220313689, -71.50571505715057},
-		{45.254515759223366, -71.40491404914049},
-		{45.30529251732331, -71.08451084510845},
-		{45.45931535022653, -70.65970659706596},
-		{45.91461361452285, -70.30330303303033},
-		{46.69319057205557, -70.00090000900009},
-		{47.448071709141644, -69.23769237692376},
-		{47.18572512562518, -68.90648906489065},
-		{47.35498098595839, -68.23328233282332},
-		{47.06555346478861, -67.79047790477904},
-		{45.70304378910633, -67.79047790477904},
-		{45.13772921559344, -67.13887138871388},
-	},
-	{
-		{57.11596645137409, -153.0069300693007},
-		{56.735140765624365, -154.0041400414004},
-		{56.99240967333084, -154.51534515345153},
-		{57.461248406453805, -154.67014670146702},
-		{57.81668571315352, -153.7629376293763},
-		{57.9690159874534, -153.230132301323},
-		{57.90131364332014, -152.5641256412564},
-		{57.59157541891038, -152.14292142921428},
-		{57.11596645137409, -153.0069300693007},
-	},
-	{
-		{59.91038070547522, -165.57825578255782},
-		{59.75466531396869, -166.1938619386194},
-		{59.94084676033519, -166.8490684906849},
-		{60.21334869547165, -167.4538745387454},
-		{60.3842971144082, -166.46746467464675},
-		{60.292898949828256, -165.67545675456753},
-		{59.91038070547522, -165.57825578255782},
-	},
-	{
-		{63.78295478989888, -171.73071730717308},
-		{63.59169566772236, -171.1151111511115},
-		{63.694941742525614, -170.49230492304923},
-		{63.430902600405815, -169.68229682296823},
-		{63.29719047074259, -168.68868688686888},
-		{63.188866720129326, -168.77148771487714},
-		{62.977296894712836, -169.5310953109531},
-		{63.19394439593934, -170.29070290702907},
-		{63.37504816649586, -170.67230672306724},
-		{63.31750117398256, -171.55431554315544},
-		{63.40551422135583, -171.79191791917918},
-		{63.78295478989888, -171.73071730717308},
-	},
-	{
-		{69.71198757737089, -140.98640986409865},
-		{66.0002065602638, -140.99360993609935},
-		{60.30643941865493, -140.9972099720997},
-		{60.27766592239827, -140.01440014400146},
-		{60.000086311451824, -139.03879038790387},
-		{59.56171363318883, -138.34038340383404},
-		{58.90500089509602, -137.4511745117451},
-		{59.463545234195564, -136.47916479164792},
-		{59.788516486035334, -135.47475474754748},
-		{59.270593553415736, -134.94554945549456},
-		{58.860994371409376, -134.27234272342724},
-		{58.41077378292307, -133.35433354333543},
-		{57.693128935110295, -132.73152731527315},
-		{56.55234443646451, -131.70911709117092},
-		{55.91594240161167, -130.00630006300062},
-		{55.28461804256885, -129.9810998109981},
-		{54.80223884061921, -130.53550535505354},
-		{55.17967940916226, -131.08631086310862},
-		{55.49788042658868, -131.96831968319682},
-		{56.36954810730465, -132.2491224912249},
-		{57.17859111969737, -133.5379353793538},
-		{58.123038820356626, -134.07794077940778},
-		{58.187356047283245, -135.0391503915039},
-		{58.21274442633323, -136.62676626766267},
-		{58.50047938889968, -137.80037800378005},
-		{59.53801781274218, -139.86679866798667},
-		{59.72758437631536, -140.82440824408243},
-		{60.084714241618414, -142.5740257402574},
-		{59.998393752848486, -143.960039600396},
-		{60.45876969295479, -145.92565925659255},
-		{60.88529446099447, -147.11367113671136},
-		{60.673724635577955, -148.2260822608226},
-		{59.978083049608514, -148.01728017280172},
-		{59.913765822681896, -148.57168571685716},
-		{59.705581114472054, -149.72729727297272},
-		{59.368761952408974, -150.60930609306092},
-		{59.155499568389146, -151.7181171811718},
-		{59.74450996234869, -151.85851858518586},
-		{60.726193952281264, -151.40851408514084},
-		{61.03423961808768, -150.34650346503466},
-		{61.28473829138083, -150.62010620106201},
-		{60.7278865108846, -151.89451894518945},
-		{60.06101842117177, -152.57852578525785},
-		{59.35014380777234, -154.01854018540186},
-		{58.86437948861605, -153.28773287732878},
-		{58.146734640803274, -154.23094230942309},
-		{57.72698010717694, -155.30735307353075},
-		{57.42231955857717, -156.30816308163082},
-		{56.98056176310753, -156.55656556565566},
-		{56.46433138909124, -158.1189811898119},
-		{55.99380009736497, -158.4321843218432},
-		{55.567275329325284, -159.60219602196022},
-		{55.64344046647524, -160.289802898029},
-		{55.364168296925456, -161.2222122221222},
-		{55.02396401765573, -162.23742237422374},
-		{54.6905299727993, -163.0690306903069},
-		{54.40448756883619, -164.78624786247863},
-		{54.57205087056606, -164.94104941049412},
-		{55.039197045085714, -163.84663846638466},
-		{55.34724271089213, -162.8710287102871},
-		{55.8956316983717, -161.80541805418054},
-		{56.007340566191615, -160.56340563405635},
-		{56.418632306801285, -160.070200702007},
-		{57.01610549377749, -158.6841868418684},
-		{57.217519967574006, -158.4609846098461},
-		{57.56957215706707, -157.7229772297723},
-		{58.32783841135979, -157.550175501755},
-		{58.91854136392267, -157.04257042570424},
-		{58.61557337392624, -158.19458194581946},
-		{58.788214351466095, -158.51858518585186},
-		{58.42431425174972, -159.05859058590585},
-		{58.93208183274932, -159.710197101971},
-		{58.57325940884293, -159.98019980199803},
-		{59.070871638222556, -160.35460354603546},
-		{58.671427807836196, -161.35541355413554},
-		{58.671427807836196, -161.96741967419675},
-		{59.26720843620906, -162.05382053820537},
-		{59.63280109452877, -161.87381873818737},
-		{59.989930959831824, -162.51822518225183},
-		{59.798671837655306, -163.81783817838178},
-		{60.26751057077827, -164.6638466384664},
-		{60.50785389245142, -165.34785347853477},
-		{61.073168465964315, -165.35145351453514},
-		{61.499693234004, -166.1218612186122},
-		{62.075163159136864, -165.7330573305733},
-		{62.63370749823643, -164.91944919449193},
-		{63.146552755046045, -164.56304563045632},
-		{63.2193327749893, -163.7530375303753},
-		{63.06023226627609, -163.06543065430654},
-		{63.54261146822573, -162.2590225902259},
-		{63.4562909794558, -161.53541535415354},
-		{63.76602920386556, -160.7722077220772},
-		{64.22302002676518, -160.95940959409594},
-		{64.40243123871839, -161.51741517415175},
-		{64.78833460027808, -160.77940779407794},
-		{64.77648669005477, -161.39141391413915},
-		{64.55983918882826, -162.45342453424536},
-		{64.33811401179177, -162.75942759427593},
-		{64.55983918882826, -163.54783547835478},
-		{64.44643776240501, -164.9590495904959},
-		{64.68678108407816, -166.42426424264244},
-		{65.08961003167117, -166.84546845468455},
-		{65.67015763261405, -168.10908109081092},
-		{66.08821960763707, -166.7050670506705},
-		{66.57736904400001, -164.4730447304473},
-		{66.57736904400001, -163.65223652236523},
-		{66.07637169741373, -163.7890378903789},
-		{66.11530054529037, -161.67941679416793},
-		{66.73477699410991, -162.48942489424894},
-		{67.1156026798596, -163.72063720637206},
-		{67.61660002644588, -164.429844298443},
-		{68.04312479448554, -165.3910539105391},
-		{68.35963325330862, -166.76266762667626},
-		{68.88263386173823, -166.20466204662046},
-		{68.91479247520151, -164.429844298443},
-		{69.37178329810116, -163.16983169831698},
-		{69.85754761725745, -162.9322293222932},
-		{70.33315658479374, -161.90981909819098},
-		{70.44825056982033, -160.93420934209342},
-		{70.89170092389331, -159.04059040590406},
-		{70.82399857976002, -158.1189811898119},
-		{71.3571545398096, -156.58176581765818},
-		{71.14727727299645, -155.0661506615066},
-		{70.69705668451012, -154.34254342543426},
-		{70.89000836528997, -153.89973899739},
-		{70.83076881417337, -152.21132211322114},
-		{70.60058084412022, -152.26892268922688},
-		{70.42963242518367, -150.7389073890739},
-		{70.52949338278027, -149.72009720097202},
-		{70.2146774825605, -147.6140761407614},
-		{70.11989420077391, -145.69165691656917},
-		{69.98956718831734, -144.9212492124921},
-		{70.15205281423722, -143.58923589235891},
-		{69.85246994144747, -142.07362073620737},
-		{69.71198757737089, -140.98640986409865},
-	},
-	{
-		{37.36211499188619, 66.51966519665197},
-		{37.974821206292376, 66.54486544865449},
-		{38.40303853293538, 65.21645216452166},
-		{38.892187969298334, 64.1688416884169},
-		{39.36271926102462, 63.51723517235172},
-		{40.05328317118409, 62.376023760237615},
-		{41.08405136061329, 61.88281882818828},
-		{41.266847689773144, 61.54801548015482},
-		{41.22114860748317, 60.46440464404645},
-		{41.42594819848635, 60.08280082800829},
-		{42.22314330065572, 59.97479974799748},
-		{42.751221584895305, 58.62838628386285},
-		{42.170673983952426, 57.7859778597786},
-		{41.8253920288727, 56.93276932769328},
-		{41.3227021236831, 57.09477094770949},
-		{41.30916165485644, 55.96795967959682},
-		{44.99555429291355, 55.92835928359284},
-		{45.58625724547643, 58.50238502385025},
-		{45.499936756706504, 58.689586895868956},
-		{44.78398446749706, 60.24120241202414},
-		{44.40654389895401, 61.05841058410584},
-		{43.50441016337807, 62.01242012420124},
-		{43.6499702032646, 63.18603186031862},
-		{43.72782789901787, 64.89964899648999},
-		{42.99833514098178, 66.09846098460986},
-		{41.99464788920591, 66.0228602286023},
-		{41.98787765479257, 66.50886508865091},
-		{41.16867929077988, 66.7140671406714},
-		{41.13652067731657, 67.9848798487985},
-		{40.66260426838362, 68.25848258482586},
-		{40.66937450279694, 68.6328863288633},
-		{41.38363423340304, 69.06849068490686},
-		{42.080968377975836, 70.38970389703897},
-		{42.26545726573903, 70.96210962109623},
-		{42.167288866745764, 71.26091260912611},
-		{41.52073148027294, 70.41850418504185},
-		{41.143290911729906, 71.15651156511566},
-		{41.392097026419705, 71.86931869318695},
-		{40.865711300783445, 73.05373053730537},
-		{40.14637389436734, 71.77571775717757},
-		{40.24454229336061, 71.01251012510127},
-		{40.219153914310624, 70.60210602106022},
-		{40.49673352525707, 70.45810458104583},
-		{40.96049458257005, 70.66690666906669},
-		{40.72861405391356, 69.33129331293313},
-		{40.0854417846474, 69.0108901089011},
-		{39.533667679961155, 68.53568535685358},
-		{39.58105932085445, 67.70047700477005},
-		{39.139301525384795, 67.44127441274415},
-		{38.90234332091832, 68.17568175681757},
-		{38.15761753545223, 68.39168391683918},
-		{37.1454674906597, 67.83007830078301},
-		{37.355344757472864, 67.07407074070741},
-		{37.36211499188619, 66.51966519665197},
-	},
-	{
-		{11.7757065853162, -71.33291332913329},
-		{11.540440939453049, -71.36171361713616},
-		{11.423654395823135, -71.94851948519485},
-		{10.970048690130156, -71.62091620916209},
-		{10.447048081700572, -71.63171631716317},
-		{9.864807922154355, -72.07452074520745},
-		{9.072690495794973, -71.69651696516965},
-		{9.137007722721592, -71.26451264512644},
-		{9.859730246344355, -71.04131041310413},
-		{10.21178243583742, -71.35091350913508},
-		{10.968356131526832, -71.40131401314012},
-		{11.37626275492984, -70.15570155701556},
-		{11.846794046656143, -70.29250292502924},
-		{12.161609946875899, -69.94329943299432},
-		{11.459198126493106, -69.58329583295833},
-		{11.44396509906312, -68.88128881288813},
-		{10.885420759963552, -68.23328233282332},
-		{10.55537183231381, -68.19368193681936},
-		{10.545216480693824, -67.29727297272973},
-		{10.648462555497076, -66.22806228062281},
-		{10.20162708421742, -65.65565655656556},
-		{10.07637774757086, -64.88884888848888},
-		{10.389501089187277, -64.33084330843307},
-		{10.641692321083752, -64.31644316443165},
-		{10.700931872200371, -63.0780307803078},
-		{10.716164899630357, -61.87921879218791},
-		{10.419967144047249, -62.72882728827288},
-		{9.947743293717622, -62.38682386823868},
-		{9.873270715171017, -61.58761587615875},
-		{9.380736161601405, -60.83160831608316},
-		{8.58015594222536, -60.66960669606695},
-		{8.60215920406867, -60.15120151201512},
-		{8.366893558205533, -59.75879758797588},
-		{7.779575722849316, -60.55080550805508},
-		{7.415675623132941, -60.637206372063716},
-		{7.04331273039989, -60.29520295202951},
-		{6.857131284033372, -60.54360543605435},
-		{6.696338216716839, -61.15921159211592},
-		{6.234269718007198, -61.14121141211412},
-		{5.958382665664075, -61.411214112141124},
-		{5.20011641137134, -60.73440734407343},
-		{4.9174591246148935, -60.60120601206012},
-		{4.536633438865195, -60.96840968409684},
-		{4.16257798752882, -62.08442084420844},
-		{4.006862596022273, -62.80442804428044},
-		{3.7699043915557837, -63.09243092430924},
-		{4.020403064848921, -63.888038880388805},
-		{4.149037518702158, -64.62964629646297},
-		{4.055946795518892, -64.81684816848168},
-		{3.796985329209093, -64.36684366843669},
-		{3.1267321222896243, -64.410044100441},
-		{2.4971003218501124, -64.26964269642696},
-		{2.410779833080184, -63.423634236342366},
-		{2.2009025662670183, -63.36963369633696},
-		{1.9165527209072337, -64.08244082440824},
-		{1.49341307007424, -64.19764197641976},
-		{1.3292348855510312, -64.61164611646116},
-		{1.0956617982912178, -65.35325353253532},
-		{0.7893086910881237, -65.54765547655477},
-		{0.724991464161505, -66.32526325263252},
-		{1.2530697484010886, -66.8760687606876},
-		{2.249986765763637, -67.1820718207182},
-		{2.6003463966533644, -67.44847448474485},
-		{2.82037901508653, -67.80847808478084},
-		{3.3179912444661426, -67.30447304473044},
-		{3.543101538709294, -67.33687336873368},
-		{3.8392992942924025, -67.62127621276213},
-		{4.504474825401886, -67.82287822878229},
-		{5.220427114611326, -67.74367743677436},
-		{5.557246276674391, -67.52047520475205},
-		{6.095479912533975, -67.34047340473404},
-		{6.268120890073831, -67.69687696876969},
-		{6.1530269050472555, -68.26568265682657},
-		{6.2071887803538885, -68.98568985689856},
-		{6.1005575883439604, -69.38889388893888},
-		{6.960377358836624, -70.09450094500944},
-		{7.087319254086523, -70.6741067410674},
-		{6.990843413696609, -71.95931959319593},
-		{7.341203044586322, -72.19692196921969},
-		{7.424138416149603, -72.44532445324452},
-		{7.632323124359431, -72.48132481324812},
-		{8.002993458489144, -72.36252362523625},
-		{8.405822406082166, -72.43812438124381},
-		{8.625855024515317, -72.66132661326613},
-		{9.084538406018297, -72.78732787327873},
-		{9.152240750151577, -73.30573305733057},
-		{9.736173468301118, -73.02853028530285},
-		{10.450433198907234, -72.90612906129061},
-		{10.82279609164027, -72.61452614526145},
-		{11.10883849560338, -72.22932229322292},
-		{11.60814328358633, -71.97371973719737},
-		{11.7757065853162, -71.33291332913329},
-	},
-	{
-		{21.551925078161887, 108.04968049680497},
-		{20.697182983479223, 106.71406714067143},
-		{19.752735282819955, 105.88245882458824},
-		{19.05878625545384, 105.66285662856632},
-		{18.004322245577995, 106.42606426064259},
-		{16.697667003805677, 107.36207362073623},
-		{16.079883113589503, 108.26928269282695},
-		{15.275917777006796, 108.8776887768878},
-		{13.425951223564908, 109.33489334893352},
-		{11.667382834702948, 109.20169201692016},
-		{11.00897753800679, 108.36648366483666},
-		{10.364112710137292, 107.2216722167222},
-		{9.531373877297952, 106.40446404464046},
-		{8.600466645465346, 105.15885158851592},
-		{9.240253797524844, 104.79524795247954},
-		{9.918969797460974, 105.0760507605076},
-		{10.485976929577205, 104.33444334443345},
-		{10.888805877170228, 105.19845198451986},
-		{10.961585897113494, 106.24966249662498},
-		{11.567521877106358, 105.81045810458107},
-		{12.337636041622417, 107.49167491674916},
-		{13.535967532781484, 107.61407614076143},
-		{14.202835622494291, 107.38367383673835},
-		{15.201445198460178, 107.56367563675639},
-		{15.90893469465297, 107.31167311673119},
-		{16.604576280622425, 106.55566555665558},
-		{17.48470675435506, 105.92565925659255},
-		{18.66780521808414, 105.09405094050942},
-		{19.265278405060343, 103.89523895238955},
-		{19.624100828966732, 104.18324183241833},
-		{19.886447412483193, 104.8240482404824},
-		{20.758115093199166, 104.43524435244353},
-		{20.766577886215828, 103.20403204032039},
-		{21.675481856205124, 102.75402754027539},
-		{22.464214165357845, 102.17082170821709},
-		{22.709635162840982, 102.70722707227071},
-		{22.704557487030982, 103.50283502835032},
-		{22.819651472057558, 104.47844478444784},
-		{23.35280743210714, 105.3280532805328},
-		{22.977059422167443, 105.81045810458107},
-		{22.794263093007586, 106.72486724867252},
-		{22.218793167874693, 106.56646566465668},
-		{21.81257910307501, 107.04167041670416},
-		{21.551925078161887, 108.04968049680497},
-	},
-	{
-		{-16.46632626988172, 167.84627846278465},
-		{-16.59834584094162, 167.5150751507515},
-		{-16.159973162678625, 167.18027180271804},
-		{-15.892548903352179, 167.21627216272162},
-		{-16.46632626988172, 167.84627846278465},
-	},
-	{
-		{-14.934560733866249, 167.10827108271081},
-		{-15.740218629052293, 167.27027270272703},
-		{-15.614969292405718, 167.00027000270006},
-		{-15.66913116771235, 166.79146791467917},
-		{-15.393244115369228, 166.65106651066515},
-		{-14.626515068059831, 166.62946629466296},
-		{-14.934560733866249, 167.10827108271081},
-	},
-	{
-		{16.65027536291238, 53.109531095310956},
-		{16.38285110358592, 52.3859238592386},
-		{15.937708190909603, 52.19152191521917},
-		{15.597503911639876, 52.169921699217014},
-		{15.176056819410206, 51.172711727117274},
-		{14.708910644890565, 49.574295742957446},
-		{14.003113707301125, 48.67788677886779},
-		{13.947259273391168, 48.23868238682388},
-		{14.006498824507787, 47.939879398794005},
-		{13.591821966691441, 47.353073530735315},
-		{13.398870285911599, 46.715867158671585},
-		{13.348093527811628, 45.87705877058772},
-		{13.290546535298347, 45.62505625056252},
-		{13.026507393178548, 45.40545405454054},
-		{12.953727373235267, 45.14265142651428},
-		{12.699843582735468, 44.98784987849879},
-		{12.721846844578792, 44.49464494644948},
-		{12.58644215631223, 44.17424174241742},
-		{12.637218914412188, 43.483034830348316},
-		{13.221151632561728, 43.22383223832239},
-		{13.767848061437974, 43.25263252632527},
-		{14.062353258417744, 43.08703087030872},
-		{14.802001368073832, 42.89262892628926},
-		{15.213293108683501, 42.60462604626048},
-		{15.262377308180135, 42.80622806228064},
-		{15.719368131079776, 42.701827018270194},
-		{15.912319811859632, 42.82422824228243},
-		{16.34730737291595, 42.78102781027812},
-		{16.667200948945705, 43.21663216632166},
-		{17.088648041175375, 43.11583115831158},
-		{17.579490036141664, 43.382233822338236},
-		{17.320528569831865, 43.792637926379285},
-		{17.410234175808455, 44.06264062640628},
-		{17.433929996255102, 45.21825218252184},
-		{17.334069038658512, 45.39825398253984},
-		{17.2325155224586, 46.36666366663667},
-		{17.283292280558555, 46.748267482674834},
-		{16.949858235702152, 47.000270002700034},
-		{17.117421537432023, 47.468274682746824},
-		{18.166807871497866, 48.18468184681848},
-		{18.617028459984184, 49.11709117091172},
-		{18.99954670433722, 52.00072000720007},
-		{17.349302066088498, 52.781927819278195},
-		{16.65027536291238, 53.109531095310956},
-	},
-	{
-		{-28.576583076722258, 16.34596345963459},
-		{-28.082355964549308, 16.82476824768247},
-		{-28.356550458289092, 17.217172171721728},
-		{-28.783075226328762, 17.38637386373864},
-		{-28.855855246272036, 17.836378363783638},
-		{-29.045421809845223, 18.46638466384664},
-		{-28.97264178990195, 19.00279002790029},
-		{-28.461489091695682, 19.895598955989556},
-		{-24.768326219225237, 19.895598955989556},
-		{-24.917271376318453, 20.165601656016577},
-		{-25.868489311391038, 20.759607596075966},
-		{-26.477810408590564, 20.666006660066614},
-		{-26.82817003948029, 20.88920889208893},
-		{-26.72661652328037, 21.605616056160557},
-		{-26.279781052000722, 22.106021060210622},
-		{-25.98019817921095, 22.58122581225814},
-		{-25.501204094467994, 22.826028260282612},
-		{-25.26932356581151, 23.312033120331222},
-		{-25.38949522664808, 23.733237332373335},
-		{-25.670459954801196, 24.212042120421216},
-		{-25.71954415429782, 25.02565025650256},
-		{-25.485971067038008, 25.666456664566653},
-		{-25.174540284024914, 25.767257672576733},
-		{-24.695546199281956, 25.94005940059401},
-		{-24.615995944925352, 26.487264872648723},
-		{-24.240247934985646, 26.786067860678628},
-		{-23.575072403876163, 27.12087120871209},
-		{-22.82696150120342, 28.017280172801748},
-		{-22.090698508753995, 29.432094320943207},
-		{-22.10254641897732, 29.838898388983893},
-		{-22.27180227931052, 30.321303213032138},
-		{-22.151630618473945, 30.65970659706599},
-		{-22.251491576070535, 31.19251192511925},
-		{-23.659700334042768, 31.67131671316713},
-		{-24.368882388838877, 31.93051930519306},
-		{-25.484278508434677, 31.754117541175418},
-		{-25.84310093234106, 31.836918369183707},
-		{-25.660304603181203, 31.332913329133305},
-		{-25.731392064521145, 31.044910449104492},
-		{-26.022512144294254, 30.95130951309514},
-		{-26.39826015423396, 30.677706777067783},
-		{-26.743542109313687, 30.68490684906851},
-		{-27.285160862379932, 31.282512825128265},
-		{-27.17852967037002, 31.869318693186926},
-		{-26.733386757693694, 32.07092070920709},
-		{-26.741849550710356, 32.83052830528305},
-		{-27.46964975014312, 32.57852578525785},
-		{-28.300696024379135, 32.463324633246344},
-		{-28.752609171468784, 32.204122041220415},
-		{-29.256991635261727, 31.52011520115201},
-		{-29.402551675148274, 31.325713257132577},
-		{-29.91031925614788, 30.9009090090901},
-		{-30.423164512957477, 30.62370623706238},
-		{-31.14080936077025, 30.05490054900551},
-		{-32.171577550199444, 28.92448924489247},
-		{-32.77243585438231, 28.218882188821908},
-		{-33.22773411867862, 27.462874628746306},
-		{-33.61533003884165, 26.41886418864189},
-		{-33.66779935554494, 25.911259112591125},
-		{-33.94537896649139, 25.78165781657816},
-		{-33.796433809398174, 25.173251732517343},
-		{-33.98769293157469, 24.67644676446764},
-		{-33.79474125079484, 23.59283592835928},
-		{-33.91660547023475, 22.988029880298797},
-		{-33.864136153531454, 22.57402574025741},
-		{-34.258502308107815, 21.544415444154453},
-		{-34.417602816821024, 20.68760687606877},
-		{-34.79504338536406, 20.072000720007196},
-		{-34.81873920581071, 19.6147961479615},
-		{-34.46330189911099, 19.193591935919358},
-		{-34.44468375447433, 18.855188551885533},
-		{-33.997848283194685, 18.423184231842328},
-		{-34.13663808866791, 18.37638376383765},
-		{-33.867521270738116, 18.243182431824323},
-		{-33.281895993985245, 18.25038250382505},
-		{-32.61164278706577, 17.926379263792654},
-		{-32.42884645790591, 18.246782467824687},
-		{-31.662117410596508, 18.221582215822167},
-		{-30.72613250295391, 17.566375663756645},
-		{-29.87816064268457, 17.065970659706608},
-		{-29.87646808408124, 17.062370623706244},
-		{-28.576583076722258, 16.34596345963459},
-	},
-	{
-		{-28.955716203868626, 28.978489784897846},
-		{-28.6476705380622, 28.542885428854305},
-		{-28.850777570462043, 28.074880748807487},
-		{-29.243451166435065, 27.531275312753138},
-		{-29.87646808408124, 26.998469984699852},
-		{-30.644889689993974, 27.75087750877509},
-		{-30.545028732397384, 28.107281072810736},
-		{-30.226827714970966, 28.290882908829104},
-		{-30.069419764861088, 28.84888848888491},
-		{-29.744448513021347, 29.018090180901822},
-		{-29.256991635261727, 29.324093240932427},
-		{-28.955716203868626, 28.978489784897846},
-	},
-	{
-		{-9.230638240637376, 32.75852758527586},
-		{-9.677473711917031, 33.23013230132301},
-		{-10.52544557218637, 33.485734857348575},
-		{-10.796254948719493, 33.31653316533166},
-		{-11.606990519715524, 33.1149311493115},
-		{-12.436344235348216, 33.30573305733057},
-		{-12.783318749031267, 32.992529925299266},
-		{-13.712533422260549, 32.68652686526866},
-		{-13.971494888570348, 33.21573215732158},
-		{-14.795770928393026, 30.18090180901811},
-		{-15.508338100395804, 30.274502745027462},
-		{-15.645435347265703, 29.51849518495186},
-		{-16.043186619048726, 28.946089460894626},
-		{-16.39016113273179, 28.827288272882726},
-		{-16.468018828485057, 28.467284672846745},
-		{-17.29060230970441, 27.59967599675997},
-		{-17.93885225478057, 27.04527045270453},
-		{-17.960855516623894, 26.706867068670704},
-		{-17.84576153159732, 26.382863828638307},
-		{-17.73574522238073, 25.26325263252633},
-		{-17.661272643834124, 25.083250832508327},
-		{-17.578337272270858, 25.0760507605076},
-		{-17.353226978027692, 24.68364683646837},
-		{-17.29567998551441, 24.035640356403576},
-		{-17.5224828383609, 23.214832148321477},
-		{-16.89792871373139, 22.56322563225632},
-		{-16.08042290832202, 21.88641886418864},
-		{-12.898412734057842, 21.933219332193318},
-		{-12.910260644281166, 24.017640176401784},
-		{-12.566671247804777, 23.93123931239313},
-		{-12.190923237865064, 24.078840788407888},
-		{-11.7220845047421, 23.902439024390247},
-		{-11.238012744189149, 24.017640176401784},
-		{-10.926581961176055, 23.91323913239134},
-		{-10.95197034022604, 24.258842588425892},
-		{-11.26340112323912, 24.312843128431297},
-		{-11.238012744189149, 24.78444784447845},
-		{-11.331103467372401, 25.418054180541816},
-		{-11.78470917306538, 25.752857528575305},
-		{-11.925191537141941, 26.55206552065522},
-		{-11.608683078318862, 27.1640716407164},
-		{-12.133376245351783, 27.387273872738746},
-		{-12.272166050825007, 28.15408154081541},
-		{-12.698690818864677, 28.524885248852485},
-		{-13.24877236494757, 28.935289352893534},
-		{-13.257235157964232, 29.698496984969864},
-		{-12.17907532764174, 29.615696156961576},
-		{-12.360179098198273, 29.34209342093422},
-		{-11.970890619431913, 28.643686436864385},
-		{-11.793171966082042, 28.373683736837364},
-		{-10.78948471430617, 28.49608496084963},
-		{-9.606386250577089, 28.67248672486727},
-		{-9.164628455107433, 28.449284492844924},
-		{-8.52653386165126, 28.733687336873373},
-		{-8.406362200814698, 29.003690036900366},
-		{-8.238798899084827, 30.346503465034658},
-		{-8.340352415284741, 30.738907389073887},
-		{-8.59423620578454, 31.156511565115665},
-		{-8.76179950751441, 31.55611556115562},
-		{-8.93105536784762, 32.19332193321935},
-		{-9.230638240637376, 32.75852758527586},
-	},
-	{
-		{-22.251491576070535, 31.19251192511925},
-		{-22.151630618473945, 30.65970659706599},
-		{-22.27180227931052, 30.321303213032138},
-		{-22.10254641897732, 29.838898388983893},
-		{-22.090698508753995, 29.432094320943207},
-		{-21.638785361664347, 28.794887948879506},
-		{-21.48645508736446, 28.020880208802083},
-		{-20.851745611114964, 27.72567725677257},
-		{-20.4996934216219, 27.72567725677257},
-		{-20.39136967100866, 27.29727297272973},
-		{-19.292899137446184, 26.163261632616326},
-		{-18.71404409510663, 25.85005850058502},
-		{-18.536325441756773, 25.64845648456486},
-		{-17.73574522238073, 25.26325263252633},
-		{-17.84576153159732, 26.382863828638307},
-		{-17.960855516623894, 26.706867068670704},
-		{-17.93885225478057, 27.04527045270453},
-		{-17.29060230970441, 27.59967599675997},
-		{-16.468018828485057, 28.467284672846745},
-		{-16.39016113273179, 28.827288272882726},
-		{-16.043186619048726, 28.946089460894626},
-		{-15.645435347265703, 29.51849518495186},
-		{-15.508338100395804, 30.274502745027462},
-		{-15.880700993128855, 30.33930339303393},
-		{-15.86039028988887, 31.174511745117456},
-		{-16.071960115305373, 31.635316353163546},
-		{-16.319073671391834, 31.851318513185134},
-		{-16.391853691335115, 32.32652326523265},
-		{-16.713439825968194, 32.848528485284874},
-		{-17.979473661260542, 32.848528485284874},
-		{-18.671730130023334, 32.65412654126541},
-		{-19.419841032696084, 32.6109261092611},
-		{-19.716038788279178, 32.77292772927731},
-		{-20.30504918223872, 32.66132661326614},
-		{-20.394754788215323, 32.51012510125102},
-		{-21.115784753234763, 32.24372243722439},
-		{-22.251491576070535, 31.19251192511925},
-	},
-}
@@ -0,0 +1,136 @@
+package globe
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/mmcloughlin/globe/internal/geodata"
+)
+
+// Country identifies a country in the countries geodata.
+type Country struct {
+	// Name is the common English name of the country.
+	Name string
+
+	// Alpha2, Alpha3 and Numeric are the ISO 3166-1 country codes.
+	Alpha2  string
+	Alpha3  string
+	Numeric string
+}
+
+// countryAliases maps alternative names of countries, in lower case, to their
+// alpha-3 codes.
+var countryAliases = map[string]string{
+	"britain":                  "GBR",
+	"burma":                    "MMR",
+	"czech republic":           "CZE",
+	"cote d'ivoire":            "CIV",
+	"côte d'ivoire":            "CIV",
+	"dr congo":                 "COD",
+	"east timor":               "TLS",
+	"great britain":            "GBR",
+	"holland":                  "NLD",
+	"macedonia":                "MKD",
+	"republic of korea":        "KOR",
+	"russian federation":       "RUS",
+	"swaziland":                "SWZ",
+	"turkiye":                  "TUR",
+	"türkiye":                  "TUR",
+	"uk":                       "GBR",
+	"united states of america": "USA",
+}
+
+// Countries returns the countries that may be drawn with DrawCountry, in order
+// of alpha-3 code. Disputed territories without ISO codes are not included.
+func Countries() []Country {
+	var cs []Country
+	for _, f := range countriesAt(scale110m).features() {
+		if c, ok := country(f); ok {
+			cs = append(cs, c)
+		}
+	}
+	sort.Slice(cs, func(i, j int) bool {
+		return cs[i].Alpha3 < cs[j].Alpha3
+	})
+	return cs
+}
+
+// LookupCountry returns the country with the given ISO 3166 alpha-2, alpha-3
+// or numeric code, or name. Lookups are case insensitive.
+func LookupCountry(code string) (Country, error) {
+	cs := countriesAt(scale110m).features()
+	i := findCountry(cs, code)
+	if i < 0 {
+		return Country{}, fmt.Errorf("globe: unknown country %q", code)
+	}
+	c, _ := country(cs[i])
+	return c, nil
+}
+
+// DrawCountry draws the country with the given code or name, as accepted by
+// LookupCountry. The country is filled and outlined.
+// Uses the default FillColor and LineColor unless overridden by style Options,
+// and 110m geodata unless a Resolution option is given. Pass a nil FillColor
+// or Color to only outline or fill the country.
+func (g *Globe) DrawCountry(code string, style ...Option) error {
+	cs := countriesAt(g.geodataScale(style)).features()
+	i := findCountry(cs, code)
+	if i < 0 {
+		return fmt.Errorf("globe: unknown country %q", code)
+	}
+
+	defer g.styled(outlinedFill, style...)()
+	g.cur.simplify = true
+	for _, ring := range cs[i].Rings {
+		points := make([]latlng, len(ring))
+		for j, p := range ring {
+			points[j] = latlng{float64(p.Lat), float64(p.Lng)}
+		}
+		g.drawPath(points)
+		g.fillRing(points)
+	}
+	return nil
+}
+
+// country returns the Country for feature f of the countries geodata, if it
+// has ISO codes.
+func country(f geodata.Feature) (Country, bool) {
+	// Disputed territories have no ISO codes.
+	if f.Alpha3 == "" {
+		return Country{}, false
+	}
+	return Country{
+		Name:    f.Name,
+		Alpha2:  f.Alpha2,
+		Alpha3:  f.Alpha3,
+		Numeric: f.ID,
+	}, true
+}
+
+// findCountry returns the index in cs of the country with the given code or
+// name, as accepted by LookupCountry, or -1 if there is none.
+func findCountry(cs []geodata.Feature, code string) int {
+	code = strings.TrimSpace(code)
+	if alpha3, ok := countryAliases[strings.ToLower(code)]; ok {
+		code = alpha3
+	}
+	// Numeric codes are zero padded to three digits.
+	if n, err := strconv.Atoi(code); err == nil && strings.Trim(code, "0123456789") == "" && n < 1000 {
+		code = fmt.Sprintf("%03d", n)
+	}
+	for i := range cs {
+		c, ok := country(cs[i])
+		if !ok {
+			continue
+		}
+		if strings.EqualFold(code, c.Alpha2) ||
+			strings.EqualFold(code, c.Alpha3) ||
+			code == c.Numeric ||
+			strings.EqualFold(code, c.Name) {
+			return i
+		}
+	}
+	return -1
+}
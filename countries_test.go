@@ -0,0 +1,118 @@
+package globe
+
+import (
+	"image/color"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFindCountry(t *testing.T) {
+	countries := countriesAt(scale110m).features()
+	cases := []struct {
+		Code   string
+		Alpha3 string
+	}{
+		{"FR", "FRA"},
+		{"fra", "FRA"},
+		{"250", "FRA"},
+		{"4", "AFG"},
+		{"04", "AFG"},
+		{"GB", "GBR"},
+		{"ATA", "ATA"},
+		{"zaf", "ZAF"},
+		{"Germany", "DEU"},
+		{"united kingdom", "GBR"},
+		{"UK", "GBR"},
+		{" Côte d'Ivoire ", "CIV"},
+	}
+	for _, c := range cases {
+		i := findCountry(countries, c.Code)
+		require.True(t, i >= 0, c.Code)
+		assert.Equal(t, c.Alpha3, countries[i].Alpha3)
+	}
+	assert.Equal(t, -1, findCountry(countries, "XX"))
+	assert.Equal(t, -1, findCountry(countries, "-99"))
+	assert.Equal(t, -1, findCountry(countries, ""))
+}
+
+func TestCountriesData(t *testing.T) {
+	seen := map[string]bool{}
+	for _, c := range countriesAt(scale110m).features() {
+		assert.NotEmpty(t, c.ID)
+		assert.NotEmpty(t, c.Rings)
+		if c.Alpha3 == "" {
+			continue
+		}
+		assert.Len(t, c.Alpha2, 2)
+		assert.Len(t, c.Alpha3, 3)
+		assert.NotEmpty(t, c.Name)
+		assert.False(t, seen[c.Alpha3], c.Alpha3)
+		seen[c.Alpha3] = true
+	}
+}
+
+func TestCountries(t *testing.T) {
+	cs := Countries()
+	assert.Len(t, cs, len(countriesAt(scale110m).features())-3)
+	for i := 1; i < len(cs); i++ {
+		assert.True(t, cs[i-1].Alpha3 < cs[i].Alpha3)
+	}
+	for _, c := range cs {
+		for _, code := range []string{c.Name, c.Alpha2, c.Alpha3, c.Numeric} {
+			l, err := LookupCountry(code)
+			require.NoError(t, err)
+			assert.Equal(t, c, l)
+		}
+	}
+}
+
+func TestLookupCountry(t *testing.T) {
+	c, err := LookupCountry("nz")
+	require.NoError(t, err)
+	assert.Equal(t, Country{
+		Name:    "New Zealand",
+		Alpha2:  "NZ",
+		Alpha3:  "NZL",
+		Numeric: "554",
+	}, c)
+
+	_, err = LookupCountry("Atlantis")
+	assert.EqualError(t, err, `globe: unknown country "Atlantis"`)
+}
+
+func TestDrawCountry(t *testing.T) {
+	g := New()
+	require.NoError(t, g.DrawCountry("FR"))
+	require.NoError(t, g.DrawCountry("Italy", FillColor(nil)))
+	require.NoError(t, g.DrawCountry("DEU", Color(nil), FillColor(color.NRGBA{0, 0, 255, 255})))
+	require.Len(t, g.layers, 3)
+	assert.Equal(t, DefaultStyle.LineColor, g.layers[0].color)
+	assert.Equal(t, DefaultStyle.FillColor, g.layers[0].fill)
+	assert.Len(t, g.layers[0].rings, 3)
+	assert.Len(t, g.layers[0].paths, 3)
+	assert.Nil(t, g.layers[1].fill)
+	assert.Nil(t, g.layers[2].color)
+
+	err := g.DrawCountry("XX")
+	assert.EqualError(t, err, `globe: unknown country "XX"`)
+	assert.Len(t, g.layers, 3)
+}
+
+func TestSVGDrawCountry(t *testing.T) {
+	g := New()
+	g.DrawGraticule(10)
+	require.NoError(t, g.DrawCountry("FR"))
+	require.NoError(t, g.DrawCountry("Italy", FillColor(nil)))
+	require.NoError(t, g.DrawCountry("DEU", Color(nil), FillColor(color.NRGBA{0, 0, 255, 255})))
+	g.CenterOn(45, 5)
+	g.SetStyle(Style{
+		GraticuleColor: DefaultStyle.GraticuleColor,
+		Background:     color.White,
+		LineWidth:      0.1,
+		Scale:          0.7,
+		BackFace:       BackFaceDim,
+	})
+	AssertSVGMD5(t, g, "cf5eaffddb471b584bf0fd7d9d14aa42")
+}
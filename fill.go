@@ -0,0 +1,316 @@
+package globe
+
+import (
+	"image/color"
+	"math"
+
+	"github.com/mmcloughlin/globe/internal/geodata"
+)
+
+// horizonArcStep is the max angle (in radians) between points when tracing an
+// arc of the horizon.
+const horizonArcStep = math.Pi / 180
+
+// clipEpsilon is the angle along the boundary of a view within which clipped
+// rings are taken to leave and enter the view at the same point.
+const clipEpsilon = 1e-6
+
+// FillColor uses the given color for filled shapes. Shapes are not filled if c
+// is nil.
+func FillColor(c color.Color) Option {
+	return func(g *Globe) {
+		g.cur.fill = c
+	}
+}
+
+// outlinedFill is the base style for shapes that are both filled and
+// outlined, using the default FillColor and LineColor.
+func outlinedFill(g *Globe) {
+	Color(g.style.LineColor)(g)
+	FillColor(g.style.FillColor)(g)
+}
+
+// DrawLand draws filled land on the globe.
+// Uses the default FillColor unless overridden by style Options, and 110m
+// geodata unless a Resolution option is given.
+func (g *Globe) DrawLand(style ...Option) {
+	g.drawPreparedPolygons(landAt(g.geodataScale(style)).rings(), style...)
+}
+
+// DrawCountries draws filled countries on the globe.
+// Uses the default FillColor unless overridden by style Options, and 110m
+// geodata unless a Resolution option is given.
+func (g *Globe) DrawCountries(style ...Option) {
+	g.drawPreparedPolygons(countriesAt(g.geodataScale(style)).rings(), style...)
+}
+
+func (g *Globe) drawPreparedPolygons(rings [][]geodata.Point, style ...Option) {
+	defer g.styled(FillColor(g.style.FillColor), style...)()
+	g.cur.simplify = true
+	for _, ring := range rings {
+		points := make([]latlng, len(ring))
+		for i, p := range ring {
+			points[i] = latlng{float64(p.Lat), float64(p.Lng)}
+		}
+		g.fillRing(points)
+	}
+}
+
+// fillRing records a ring bounding a filled region in the current layer. The
+// region is the smaller of the two parts of the globe bounded by the ring.
+// Rings in a layer are filled together with the even-odd rule, so holes may
+// be given as additional rings.
+func (g *Globe) fillRing(ring []latlng) {
+	g.cur.rings = append(g.cur.rings, ring)
+}
+
+// clipRing clips ring, in camera space, to the visible side of the globe. The
+// returned rings bound the visible part of the region enclosed by ring, joining
+// the visible pieces of the ring with arcs of the horizon.
+func (v view) clipRing(ring []point) [][]point {
+	n := len(ring)
+	if n > 1 && ring[0] == ring[n-1] {
+		ring = ring[:n-1]
+		n--
+	}
+	if n < 3 {
+		return nil
+	}
+
+	// Start from a hidden vertex, so that no visible piece wraps around.
+	start := -1
+	for i, p := range ring {
+		if !v.visible(p) {
+			start = i
+			break
+		}
+	}
+	if start < 0 {
+		// Visible rings may still loop around the hidden part of the view
+		// on azimuthal maps, which show more than a hemisphere.
+		if ringContains(ring, point{0, 0, 1}) {
+			return [][]point{v.horizonArc(0, 2*math.Pi, 1), ring}
+		}
+		return [][]point{ring}
+	}
+
+	var pieces []clipPiece
+	var piece clipPiece
+	for k := 1; k <= n; k++ {
+		a, b := ring[(start+k-1)%n], ring[(start+k)%n]
+		va, vb := v.visible(a), v.visible(b)
+		switch {
+		case !va && vb:
+			c := v.crossing(a, b)
+			piece = clipPiece{points: []point{c, b}, entry: horizonAngle(c)}
+		case va && vb:
+			piece.points = append(piece.points, b)
+		case va && !vb:
+			c := v.crossing(a, b)
+			piece.points = append(piece.points, c)
+			piece.exit = horizonAngle(c)
+			pieces = append(pieces, piece)
+		}
+	}
+
+	// Entirely hidden rings are either unseen or contain the whole view.
+	if len(pieces) == 0 {
+		if ringContains(ring, point{0, 0, -1}) {
+			return [][]point{v.horizonArc(0, 2*math.Pi, 1)}
+		}
+		return nil
+	}
+	return joinPieces(ring, pieces, boundary{v.horizonPoint, v.horizonArc})
+}
+
+// clipPiece is a piece of a ring clipped to the visible part of a view. It
+// enters and leaves the visible part at the given angles along the boundary.
+type clipPiece struct {
+	points      []point
+	entry, exit float64
+}
+
+// boundary is the edge of the visible part of a view, such as the horizon,
+// with positions along it given by angles.
+type boundary struct {
+	// point returns the point in camera space at angle a along the boundary.
+	point func(a float64) point
+
+	// arc returns points along the boundary, as in horizonArc.
+	arc func(a, length, d float64) []point
+}
+
+// joinPieces joins the pieces of ring, clipped to the visible part of a view
+// with boundary b, into closed rings. The exit of each piece is joined to the
+// next entry along the boundary.
+func joinPieces(ring []point, pieces []clipPiece, b boundary) [][]point {
+	d := arcDirection(ring, pieces, b)
+	used := make([]bool, len(pieces))
+	var clipped [][]point
+	for i := range pieces {
+		if used[i] {
+			continue
+		}
+		var out []point
+		for j := i; !used[j]; {
+			used[j] = true
+			out = append(out, pieces[j].points...)
+			exit := pieces[j].exit
+			j = nextEntry(pieces, exit, d)
+			length := clipDistance(exit, pieces[j].entry, d)
+			if len(pieces) == 1 && length == 0 && ringContains(ring, b.point(exit+math.Pi)) {
+				// The ring loops around the hidden part of the view, as
+				// rings around the point opposite the center of an
+				// azimuthal map do, so the whole boundary is inside it.
+				length = 2 * math.Pi
+			}
+			out = append(out, b.arc(exit, length, d)...)
+		}
+		clipped = append(clipped, out)
+	}
+	return clipped
+}
+
+// arcDirection determines which way along the boundary b (+1 anticlockwise, -1
+// clockwise) the clipped pieces of ring should be joined. The correct arcs are
+// inside the region bounded by the ring.
+func arcDirection(ring []point, pieces []clipPiece, b boundary) float64 {
+	var angles []float64
+	for _, piece := range pieces {
+		angles = append(angles, piece.entry, piece.exit)
+	}
+
+	exit := pieces[0].exit
+	for _, d := range []float64{1, -1} {
+		nearest := 2 * math.Pi
+		for _, a := range angles {
+			if dist := angularDistance(exit, a, d); dist > 0 && dist < nearest {
+				nearest = dist
+			}
+		}
+		if ringContains(ring, b.point(exit+d*nearest/2)) {
+			return d
+		}
+	}
+	return 1
+}
+
+// nextEntry returns the index of the piece whose entry is closest to the
+// angle exit, travelling in direction d.
+func nextEntry(pieces []clipPiece, exit, d float64) int {
+	best, nearest := 0, math.Inf(1)
+	for i, piece := range pieces {
+		dist := clipDistance(exit, piece.entry, d)
+		if dist < nearest {
+			best, nearest = i, dist
+		}
+	}
+	return best
+}
+
+// angularDistance returns the angle travelled from a to b in direction d, in
+// the range [0, 2π).
+func angularDistance(a, b, d float64) float64 {
+	dist := math.Mod(d*(b-a), 2*math.Pi)
+	if dist < 0 {
+		dist += 2 * math.Pi
+	}
+	return dist
+}
+
+// clipDistance returns the angular distance from a to b in direction d, as in
+// angularDistance, except that points within clipEpsilon of each other are
+// taken to coincide.
+func clipDistance(a, b, d float64) float64 {
+	dist := angularDistance(a, b, d)
+	if dist < clipEpsilon || dist > 2*math.Pi-clipEpsilon {
+		return 0
+	}
+	return dist
+}
+
+// horizonAngle returns the angle of p about the z axis in camera space.
+func horizonAngle(p point) float64 {
+	return math.Atan2(p.y, p.x)
+}
+
+// horizonPoint returns the point on the horizon at angle a.
+func (v view) horizonPoint(a float64) point {
+	h := v.horizon()
+	r := math.Sqrt(1 - h*h)
+	return point{r * math.Cos(a), r * math.Sin(a), h}
+}
+
+// horizonArc returns points along the horizon starting at angle a and
+// travelling angle length in direction d. The end points are excluded unless
+// the arc is a full circle.
+func (v view) horizonArc(a, length, d float64) []point {
+	steps := int(math.Ceil(length / horizonArcStep))
+	var arc []point
+	for k := 1; k < steps; k++ {
+		arc = append(arc, v.horizonPoint(a+d*length*float64(k)/float64(steps)))
+	}
+	if length >= 2*math.Pi {
+		arc = append(arc, v.horizonPoint(a))
+	}
+	return arc
+}
+
+// ringContains reports whether q is inside ring. On a sphere a ring divides
+// the surface in two; the inside is taken to be the part not containing the
+// point opposite the center of the ring's vertices.
+func ringContains(ring []point, q point) bool {
+	var c point
+	for _, p := range ring {
+		c.x += p.x
+		c.y += p.y
+		c.z += p.z
+	}
+	r := normalize(point{-c.x, -c.y, -c.z})
+
+	// Stereographic projection from r maps the outside of the ring to the
+	// unbounded region of the plane.
+	e1 := normalize(cross(r, perpendicular(r)))
+	e2 := cross(r, e1)
+	project := func(p point) (float64, float64) {
+		s := 1 - dot3(p, r)
+		if s == 0 {
+			return math.Inf(1), math.Inf(1)
+		}
+		return dot3(p, e1) / s, dot3(p, e2) / s
+	}
+
+	x, y := project(q)
+	inside := false
+	px, py := project(ring[len(ring)-1])
+	for _, p := range ring {
+		ax, ay := project(p)
+		if (ay > y) != (py > y) && x < (px-ax)*(y-ay)/(py-ay)+ax {
+			inside = !inside
+		}
+		px, py = ax, ay
+	}
+	return inside
+}
+
+// perpendicular returns a vector not parallel to p.
+func perpendicular(p point) point {
+	if math.Abs(p.x) < 0.5 {
+		return point{1, 0, 0}
+	}
+	return point{0, 1, 0}
+}
+
+// cross returns the cross product of a and b.
+func cross(a, b point) point {
+	return point{
+		a.y*b.z - a.z*b.y,
+		a.z*b.x - a.x*b.z,
+		a.x*b.y - a.y*b.x,
+	}
+}
+
+// dot3 returns the dot product of a and b.
+func dot3(a, b point) float64 {
+	return a.x*b.x + a.y*b.y + a.z*b.z
+}
@@ -0,0 +1,87 @@
+package globe
+
+import (
+	"image/color"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSVGFillLand(t *testing.T) {
+	g := New()
+	g.DrawLand(FillColor(color.NRGBA{0x40, 0x90, 0x40, 0xff}))
+	g.DrawLandBoundaries()
+	g.CenterOn(51.453349, -2.588323)
+	AssertSVGMD5(t, g, "0e97a9aec77396ee8357ca9673c9f5f1")
+}
+
+func TestSVGFillCountriesSouthPole(t *testing.T) {
+	g := New()
+	g.DrawCountries()
+	g.DrawCountryBoundaries()
+	g.CenterOn(-90, 0)
+	AssertSVGMD5(t, g, "2e2d802f156d9e1fa71d6c6a0f73a506")
+}
+
+func TestDrawLandImage(t *testing.T) {
+	g := New()
+	g.DrawLand()
+	g.CenterOn(-85, 20)
+	m := g.Image(200)
+	assert.Equal(t, color.RGBAModel.Convert(DefaultStyle.FillColor), m.At(100, 100))
+}
+
+func TestRingContains(t *testing.T) {
+	ring := []point{
+		cartestianPoint(10, -10),
+		cartestianPoint(10, 10),
+		cartestianPoint(-10, 10),
+		cartestianPoint(-10, -10),
+	}
+	assert.True(t, ringContains(ring, cartestianPoint(0, 0)))
+	assert.True(t, ringContains(ring, cartestianPoint(5, -5)))
+	assert.False(t, ringContains(ring, cartestianPoint(20, 0)))
+	assert.False(t, ringContains(ring, cartestianPoint(0, 180)))
+}
+
+func TestClipRing(t *testing.T) {
+	v := New().view(Camera{Lat: 0, Lng: 0})
+
+	// Entirely visible rings are unchanged.
+	var small []point
+	for _, ll := range []latlng{{5, -5}, {5, 5}, {-5, 5}, {-5, -5}} {
+		small = append(small, v.transform(ll))
+	}
+	assert.Equal(t, [][]point{small}, v.clipRing(small))
+
+	// Hidden rings are dropped.
+	var far []point
+	for _, ll := range []latlng{{5, 175}, {5, -175}, {-5, -175}, {-5, 175}} {
+		far = append(far, v.transform(ll))
+	}
+	assert.Empty(t, v.clipRing(far))
+
+	// A hidden ring enclosing the view fills the whole disk.
+	pole := New().view(DefaultCamera)
+	var around []point
+	for lng := -180.0; lng < 180; lng += 10 {
+		around = append(around, pole.transform(latlng{35, lng}))
+	}
+	disk := pole.clipRing(around)
+	require.Len(t, disk, 1)
+	for _, p := range disk[0] {
+		assert.InDelta(t, pole.horizon(), p.z, 1e-9)
+	}
+
+	// Rings crossing the horizon are closed along it.
+	var cross []point
+	for _, ll := range []latlng{{10, -10}, {10, 120}, {-10, 120}, {-10, -10}} {
+		cross = append(cross, v.transform(ll))
+	}
+	clipped := v.clipRing(cross)
+	require.Len(t, clipped, 1)
+	for _, p := range clipped[0] {
+		assert.True(t, p.z <= v.horizon()+1e-6)
+	}
+}
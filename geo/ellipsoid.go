@@ -0,0 +1,46 @@
+package geo
+
+// Ellipsoid is an ellipsoid of revolution modelling the shape of the earth.
+// Geodesics on it are computed with the algorithms of C. F. F. Karney,
+// "Algorithms for geodesics", J. Geodesy 87, 43–55 (2013), which are accurate
+// to a few nanometers and converge for all pairs of points, including nearly
+// antipodal ones.
+type Ellipsoid struct {
+	// A is the equatorial radius in kilometers.
+	A float64
+
+	// F is the flattening, (A-B)/A for polar radius B. It is zero for a
+	// sphere and negative for prolate ellipsoids.
+	F float64
+}
+
+// WGS84 is the ellipsoid of the World Geodetic System 1984, used by GPS.
+var WGS84 = Ellipsoid{A: 6378.137, F: 1 / 298.257223563}
+
+// Sphere returns the sphere of radius EarthRadius as an Ellipsoid, so that its
+// geodesics agree with the great circles of Distance and Destination.
+func Sphere() Ellipsoid {
+	return Ellipsoid{A: EarthRadius}
+}
+
+// Distance returns the length of the shortest geodesic between a and b on e.
+func (e Ellipsoid) Distance(a, b LatLng) float64 {
+	d, _, _ := e.Inverse(a, b)
+	return d
+}
+
+// Inverse solves the inverse geodesic problem on e: it returns the length of
+// the shortest geodesic between a and b, and its bearings at a and at b, in the
+// range [0, 360).
+func (e Ellipsoid) Inverse(a, b LatLng) (distance, initial, final float64) {
+	s12, azi1, azi2 := geodesicOf(e).inverse(a.Lat, a.Lng, b.Lat, b.Lng)
+	return s12, normalizeBearing(azi1), normalizeBearing(azi2)
+}
+
+// Direct solves the direct geodesic problem on e: it returns the location
+// reached by travelling distance from p along the geodesic with initial
+// bearing, and the bearing of the geodesic there, in the range [0, 360).
+func (e Ellipsoid) Direct(p LatLng, distance, bearing float64) (LatLng, float64) {
+	lat, lng, azi := geodesicOf(e).direct(p.Lat, p.Lng, bearing, distance)
+	return LatLng{lat, normalizeLng(lng)}, normalizeBearing(azi)
+}
@@ -0,0 +1,231 @@
+package geo
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// Test vectors are from the documentation of GeographicLib, whose results are
+// accurate to about 15 nanometers.
+
+func TestEllipsoidInverse(t *testing.T) {
+	cases := []struct {
+		A, B           LatLng
+		Distance       float64
+		Initial, Final float64
+	}{
+		// Wellington to Salamanca, nearly antipodal.
+		{LatLng{-41.32, 174.81}, LatLng{40.96, -5.50}, 19959.67926735382, 161.06766998615882, 18.825195123248392},
+		// JFK to LHR.
+		{LatLng{40.6, -73.8}, LatLng{51.6, -0.5}, 5551.759400318679, 51.198882845579824, 107.82177673551425},
+	}
+	for _, c := range cases {
+		d, initial, final := WGS84.Inverse(c.A, c.B)
+		assert.InDelta(t, c.Distance, d, 1e-9)
+		assert.InDelta(t, c.Initial, initial, 1e-9)
+		assert.InDelta(t, c.Final, final, 1e-9)
+		assert.Equal(t, d, WGS84.Distance(c.A, c.B))
+	}
+
+	// JFK to Singapore, given to the nearest meter and tenth of an arc second.
+	d, initial, final := WGS84.Inverse(
+		LatLng{40 + 38/60.0 + 23/3600.0, -(73 + 46/60.0 + 44/3600.0)},
+		LatLng{1 + 21/60.0 + 33/3600.0, 103 + 59/60.0 + 22/3600.0},
+	)
+	assert.InDelta(t, 15347.628, d, 1e-3)
+	assert.InDelta(t, 3+18/60.0+29.9/3600, initial, 0.05/3600)
+	assert.InDelta(t, 177+29/60.0+9.2/3600, final, 0.05/3600)
+}
+
+func TestEllipsoidDirect(t *testing.T) {
+	// Wellington to Salamanca, as in TestEllipsoidInverse.
+	p, final := WGS84.Direct(LatLng{-41.32, 174.81}, 19959.67926735382, 161.06766998615882)
+	assert.InDelta(t, 40.96, p.Lat, 1e-9)
+	assert.InDelta(t, -5.50, p.Lng, 1e-9)
+	assert.InDelta(t, 18.825195123248392, final, 1e-9)
+
+	// The direct and inverse problems agree.
+	for _, brng := range []float64{0, 30, 90, 135, 180, 250} {
+		for _, d := range []float64{1, 1000, 10000, 19990} {
+			q, final := WGS84.Direct(landsEnd, d, brng)
+			dist, initial, fin := WGS84.Inverse(landsEnd, q)
+			assert.InDelta(t, d, dist, 1e-9)
+			assert.InDelta(t, 0, math.Remainder(initial-brng, 360), 1e-9)
+			assert.InDelta(t, 0, math.Remainder(fin-final, 360), 1e-9)
+		}
+	}
+}
+
+func TestEllipsoidSpecialCases(t *testing.T) {
+	const quarterMeridian = 10001.9657293127
+	assert.InDelta(t, quarterMeridian, WGS84.Distance(nullIsland, northPole), 1e-6)
+	assert.InDelta(t, 2*quarterMeridian, WGS84.Distance(LatLng{-90, 0}, northPole), 1e-6)
+	assert.InDelta(t, math.Pi*WGS84.A/2, WGS84.Distance(nullIsland, LatLng{0, 90}), 1e-6)
+	assert.Equal(t, 0.0, WGS84.Distance(greenwich, greenwich))
+
+	// The shortest geodesic between antipodes on the equator is a meridian.
+	d, initial, _ := WGS84.Inverse(nullIsland, antimeridian)
+	assert.InDelta(t, 2*quarterMeridian, d, 1e-6)
+	assert.True(t, initial == 0 || initial == 180)
+
+	// Across the antimeridian.
+	assert.InDelta(t, WGS84.Distance(LatLng{10, 178}, LatLng{12, -179}), WGS84.Distance(LatLng{10, -2}, LatLng{12, 1}), 1e-9)
+}
+
+func TestEllipsoidSphere(t *testing.T) {
+	s := Sphere()
+	for _, b := range []LatLng{johnOGroats, greenwich, northPole, antimeridian, {-33.9, 151.2}} {
+		d, initial, final := s.Inverse(landsEnd, b)
+		assert.InDelta(t, Distance(landsEnd, b), d, 1e-6)
+		assert.InDelta(t, InitialBearing(landsEnd, b), initial, 1e-9)
+		assert.InDelta(t, FinalBearing(landsEnd, b), final, 1e-9)
+	}
+
+	// Spherical distances are off by up to half a percent.
+	a, b := nullIsland, LatLng{0, 90}
+	assert.InDelta(t, 1, Distance(a, b)/WGS84.Distance(a, b), 0.005)
+}
+
+// geodTest holds rows of GeodTest.dat, the GeographicLib test set of geodesics
+// on WGS84, as sampled by the GeographicLib test suite. Each row is lat1, lon1,
+// azi1, lat2, lon2, azi2 and s12 in meters.
+var geodTest = [][7]float64{
+	{35.60777, -139.44815, 111.098748429560326, -11.17491, -69.95921, 129.289270889708762, 8935244.5604818305},
+	{55.52454, 106.05087, 22.020059880982801, 77.03196, 197.18234, 109.112041110671519, 4105086.1713924406},
+	{-21.97856, 142.59065, -32.44456876433189, 41.84138, 98.56635, -41.84359951440466, 8394328.894657671},
+	{-66.99028, 112.2363, 173.73491240878403, -12.70631, 285.90344, 2.512956620913668, 11150344.2312080241},
+	{-17.42761, 173.34268, -159.033557661192928, -15.84784, 5.93557, -20.787484651536988, 16076603.1631180673},
+	{32.84994, 48.28919, 150.492927788121982, -56.28556, 202.29132, 48.113449399816759, 16727068.9438164461},
+	{6.96833, 52.74123, 92.581585386317712, -7.39675, 206.17291, 90.721692165923907, 17102477.2496958388},
+	{-50.56724, -16.30485, -105.439679907590164, -33.56571, -94.97412, -47.348547835650331, 6455670.5118668696},
+	{-58.93002, -8.90775, 140.965397902500679, -8.91104, 133.13503, 19.255429433416599, 11756066.0219864627},
+	{-68.82867, -74.28391, 93.774347763114881, -50.63005, -8.36685, 34.65564085411343, 3956936.926063544},
+	{-10.62672, -32.0898, -86.426713286747751, 5.883, -134.31681, -80.473780971034875, 11470869.3864563009},
+	{-21.76221, 166.90563, 29.319421206936428, 48.72884, 213.97627, 43.508671946410168, 9098627.3986554915},
+	{-19.79938, -174.47484, 71.167275780171533, -11.99349, -154.35109, 65.589099775199228, 2319004.8601169389},
+	{-11.95887, -116.94513, 92.712619830452549, 4.57352, 7.16501, 78.64960934409585, 13834722.5801401374},
+	{-87.85331, 85.66836, -65.120313040242748, 66.48646, 16.09921, -4.888658719272296, 17286615.3147144645},
+	{1.74708, 128.32011, -101.584843631173858, -11.16617, 11.87109, -86.325793296437476, 12942901.1241347408},
+	{-25.72959, -144.90758, -153.647468693117198, -57.70581, -269.17879, -48.343983158876487, 9413446.7452453107},
+	{-41.22777, 122.32875, 14.285113402275739, -7.57291, 130.37946, 10.805303085187369, 3812686.035106021},
+	{11.01307, 138.25278, 79.43682622782374, 6.62726, 247.05981, 103.708090215522657, 11911190.819018408},
+	{-29.47124, 95.14681, -163.779130441688382, -27.46601, -69.15955, -15.909335945554969, 13487015.8381145492},
+}
+
+func TestEllipsoidGeodTest(t *testing.T) {
+	for _, r := range geodTest {
+		a, b := LatLng{r[0], r[1]}, LatLng{r[3], r[4]}
+		d, initial, final := WGS84.Inverse(a, b)
+		assert.InDelta(t, r[6]/1000, d, 1e-11, r)
+		assert.InDelta(t, 0, math.Remainder(initial-r[2], 360), 1e-12, r)
+		assert.InDelta(t, 0, math.Remainder(final-r[5], 360), 1e-12, r)
+
+		p, final := WGS84.Direct(a, r[6]/1000, r[2])
+		assert.InDelta(t, r[3], p.Lat, 1e-12, r)
+		assert.InDelta(t, 0, math.Remainder(p.Lng-r[4], 360), 1e-12, r)
+		assert.InDelta(t, 0, math.Remainder(final-r[5], 360), 1e-12, r)
+	}
+}
+
+func TestEllipsoidHardCases(t *testing.T) {
+	// Regression tests of GeographicLib, to the precision they are given.
+	// Bearings are not checked where they are not given.
+	cases := []struct {
+		Name           string
+		A, B           LatLng
+		Distance       float64
+		Initial, Final float64
+		Tolerance      float64
+	}{
+		{"nearly antipodal", LatLng{88.202499451857, 0}, LatLng{-88.202499451857, 179.981022032992859592}, 20003898.214, -1, -1, 0.5e-3},
+		{"nearly antipodal", LatLng{89.262080389218, 0}, LatLng{-89.262080389218, 179.992207982775375662}, 20003925.854, -1, -1, 0.5e-3},
+		{"nearly antipodal", LatLng{89.333123580033, 0}, LatLng{-89.333123580032997687, 179.99295812360148422}, 20003926.881, -1, -1, 0.5e-3},
+		{"nearly antipodal", LatLng{56.320923501171, 0}, LatLng{-56.320923501171, 179.664747671772880215}, 19993558.287, -1, -1, 0.5e-3},
+		{"nearly antipodal", LatLng{52.784459512564, 0}, LatLng{-52.784459512563990912, 179.634407464943777557}, 19991596.095, -1, -1, 0.5e-3},
+		{"nearly antipodal", LatLng{48.522876735459, 0}, LatLng{-48.52287673545898293, 179.599720456223079643}, 19989144.774, -1, -1, 0.5e-3},
+		{"nearly antipodal", LatLng{-(41 + 19/60.0), 174 + 49/60.0}, LatLng{40 + 58/60.0, -(5 + 30/60.0)}, 19960543.857179, 160.39137649664, 19.50042925176, 0.5e-6},
+		{"nearly antipodal", LatLng{27.2, 0}, LatLng{-27.1, 179.5}, 19974354.765767, 45.82468716758, 134.22776532670, 0.5e-6},
+		{"equatorial", LatLng{0, 0}, LatLng{0, 179}, 19926189, 90, 90, 0.5},
+		{"equatorial", LatLng{0, 0}, LatLng{0, 179.5}, 19980862, 55.96650, 124.03350, 0.5},
+		{"equatorial", LatLng{0, 0}, LatLng{0, 180}, 20003931, 0, 180, 0.5},
+		{"equatorial", LatLng{0, 0}, LatLng{1, 180}, 19893357, 0, 180, 0.5},
+		{"short", LatLng{54.1589, 15.3872}, LatLng{54.1591, 15.3877}, 39.527686385, 55.723110355, 55.723515675, 0.5e-9},
+		{"short", LatLng{36.493349428792, 0}, LatLng{36.49334942879201, .0000008}, 0.072, -1, -1, 0.5e-3},
+	}
+	for _, c := range cases {
+		d, initial, final := WGS84.Inverse(c.A, c.B)
+		assert.InDelta(t, c.Distance/1000, d, c.Tolerance/1000, c.Name)
+		if c.Initial < 0 {
+			continue
+		}
+		// Bearings are given to about the same relative precision as
+		// distances.
+		tol := c.Tolerance * 1e-5
+		if c.Distance < 1000 {
+			tol = 5e-9
+		}
+		assert.InDelta(t, 0, math.Remainder(initial-c.Initial, 360), tol, c.Name)
+		assert.InDelta(t, 0, math.Remainder(final-c.Final, 360), tol, c.Name)
+
+		// Travelling the given distance at the given bearing reaches B, to
+		// within the tolerance and the rounding of positions in degrees.
+		p, _ := WGS84.Direct(c.A, c.Distance/1000, c.Initial)
+		assert.InDelta(t, 0, WGS84.Distance(c.B, p), 2*c.Tolerance/1000+1e-11, c.Name)
+	}
+}
+
+func TestEllipsoidMeridional(t *testing.T) {
+	cases := []struct {
+		A, B           LatLng
+		Distance       float64
+		Initial, Final float64
+	}{
+		{LatLng{-30, 0}, LatLng{60, 0}, meridianArc(60) - meridianArc(-30), 0, 0},
+		{LatLng{10, 45}, LatLng{10.5, 45}, meridianArc(10.5) - meridianArc(10), 0, 0},
+		{LatLng{50, -120}, LatLng{-40, -120}, meridianArc(50) - meridianArc(-40), 180, 180},
+		// Over the poles.
+		{LatLng{60, 0}, LatLng{70, 180}, 2*meridianArc(90) - meridianArc(60) - meridianArc(70), 0, 180},
+		{LatLng{-80, 20}, LatLng{-85, -160}, 2*meridianArc(90) + meridianArc(-80) + meridianArc(-85), 180, 0},
+	}
+	for _, c := range cases {
+		d, initial, final := WGS84.Inverse(c.A, c.B)
+		assert.InDelta(t, c.Distance, d, 1e-10)
+		assert.Equal(t, c.Initial, initial)
+		assert.Equal(t, c.Final, final)
+
+		p, final := WGS84.Direct(c.A, c.Distance, c.Initial)
+		assert.InDelta(t, 0, WGS84.Distance(c.B, p), 1e-10)
+		assert.InDelta(t, 0, math.Remainder(final-c.Final, 360), 1e-9)
+	}
+}
+
+// meridianArc returns the distance along a meridian of WGS84 from the equator
+// to latitude lat, by numerical integration of the radius of curvature.
+func meridianArc(lat float64) float64 {
+	const n = 10000
+	e2 := WGS84.F * (2 - WGS84.F)
+	phi := degToRad(lat)
+	h := phi / n
+	f := func(x float64) float64 {
+		s := math.Sin(x)
+		return math.Pow(1-e2*s*s, -1.5)
+	}
+	// Simpson's rule.
+	sum := f(0) + f(phi)
+	for i := 1; i < n; i++ {
+		w := 2.0
+		if i%2 == 1 {
+			w = 4
+		}
+		sum += w * f(float64(i)*h)
+	}
+	return WGS84.A * (1 - e2) * sum * h / 3
+}
+
+func TestGeodesicOf(t *testing.T) {
+	assert.Same(t, wgs84Geodesic, geodesicOf(WGS84))
+	assert.Equal(t, newGeodesic(WGS84), geodesicOf(WGS84))
+	assert.Equal(t, newGeodesic(Sphere()), geodesicOf(Sphere()))
+}
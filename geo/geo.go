@@ -0,0 +1,143 @@
+// Package geo implements spherical geometry on the earth.
+//
+// The earth is modelled as a sphere of radius EarthRadius, except by the
+// methods of Ellipsoid. Locations are given in degrees, distances in
+// kilometers and bearings in degrees clockwise from north.
+package geo
+
+import "math"
+
+// EarthRadius is the mean radius of the earth in kilometers.
+const EarthRadius = 6371.0
+
+// LatLng is a location on the earth in degrees.
+type LatLng struct {
+	Lat, Lng float64
+}
+
+// Distance returns the great circle distance between a and b, computed with
+// the haversine formula.
+func Distance(a, b LatLng) float64 {
+	dlat := b.Lat - a.Lat
+	dlng := b.Lng - a.Lng
+	h := sin(dlat/2)*sin(dlat/2) + cos(a.Lat)*cos(b.Lat)*sin(dlng/2)*sin(dlng/2)
+	c := 2 * math.Atan2(math.Sqrt(h), math.Sqrt(1-h))
+	return EarthRadius * c
+}
+
+// InitialBearing returns the bearing at a of the great circle path from a to
+// b, in the range [0, 360).
+func InitialBearing(a, b LatLng) float64 {
+	dlng := b.Lng - a.Lng
+	y := sin(dlng) * cos(b.Lat)
+	x := cos(a.Lat)*sin(b.Lat) - sin(a.Lat)*cos(b.Lat)*cos(dlng)
+	return normalizeBearing(radToDeg(math.Atan2(y, x)))
+}
+
+// FinalBearing returns the bearing at b of the great circle path from a to b,
+// in the range [0, 360).
+func FinalBearing(a, b LatLng) float64 {
+	return normalizeBearing(InitialBearing(b, a) + 180)
+}
+
+// Destination returns the location reached by travelling distance from p
+// along the great circle with initial bearing. At the poles, bearings are
+// taken relative to the meridian of p, as in the limit approaching the pole
+// along it.
+func Destination(p LatLng, distance, bearing float64) LatLng {
+	dr := distance / EarthRadius
+	phi := math.Asin(sin(p.Lat)*math.Cos(dr) + cos(p.Lat)*math.Sin(dr)*cos(bearing))
+	var lambda float64
+	switch {
+	case p.Lat >= 90:
+		lambda = degToRad(p.Lng + 180 - bearing)
+	case p.Lat <= -90:
+		lambda = degToRad(p.Lng + bearing)
+	default:
+		lambda = degToRad(p.Lng) + math.Atan2(sin(bearing)*math.Sin(dr)*cos(p.Lat), math.Cos(dr)-sin(p.Lat)*math.Sin(phi))
+	}
+	return LatLng{radToDeg(phi), normalizeLng(radToDeg(lambda))}
+}
+
+// Intermediate returns the location that is fraction f of the way along the
+// great circle path from a to b. The path is undefined if a and b are
+// antipodal.
+func Intermediate(a, b LatLng, f float64) LatLng {
+	dr := Distance(a, b) / EarthRadius
+	if dr == 0 {
+		return a
+	}
+	p := math.Sin((1-f)*dr) / math.Sin(dr)
+	q := math.Sin(f*dr) / math.Sin(dr)
+	x := p*cos(a.Lat)*cos(a.Lng) + q*cos(b.Lat)*cos(b.Lng)
+	y := p*cos(a.Lat)*sin(a.Lng) + q*cos(b.Lat)*sin(b.Lng)
+	z := p*sin(a.Lat) + q*sin(b.Lat)
+	phi := math.Atan2(z, math.Sqrt(x*x+y*y))
+	lambda := math.Atan2(y, x)
+	return LatLng{radToDeg(phi), radToDeg(lambda)}
+}
+
+// Midpoint returns the location half way along the great circle path from a
+// to b.
+func Midpoint(a, b LatLng) LatLng {
+	dlng := b.Lng - a.Lng
+	bx := cos(b.Lat) * cos(dlng)
+	by := cos(b.Lat) * sin(dlng)
+	phi := math.Atan2(sin(a.Lat)+sin(b.Lat), math.Hypot(cos(a.Lat)+bx, by))
+	lambda := degToRad(a.Lng) + math.Atan2(by, cos(a.Lat)+bx)
+	return LatLng{radToDeg(phi), normalizeLng(radToDeg(lambda))}
+}
+
+// CrossTrackDistance returns the distance of p from the great circle through
+// a and b. The distance is positive if p is to the right of the path from a to
+// b, and negative if it is to the left.
+func CrossTrackDistance(p, a, b LatLng) float64 {
+	d := Distance(a, p) / EarthRadius
+	dbrng := degToRad(InitialBearing(a, p) - InitialBearing(a, b))
+	return math.Asin(math.Sin(d)*math.Sin(dbrng)) * EarthRadius
+}
+
+// AlongTrackDistance returns the distance from a, along the great circle
+// through a and b, to the point on it closest to p. The distance is negative
+// if that point is behind a.
+func AlongTrackDistance(p, a, b LatLng) float64 {
+	d := Distance(a, p) / EarthRadius
+	dbrng := degToRad(InitialBearing(a, p) - InitialBearing(a, b))
+	xt := math.Asin(math.Sin(d) * math.Sin(dbrng))
+	c := math.Max(-1, math.Min(1, math.Cos(d)/math.Cos(xt)))
+	return math.Copysign(math.Acos(c)*EarthRadius, math.Cos(dbrng))
+}
+
+// normalizeBearing maps bearing b to the range [0, 360).
+func normalizeBearing(b float64) float64 {
+	b = math.Mod(b, 360)
+	if b < 0 {
+		b += 360
+	}
+	return b
+}
+
+// normalizeLng maps longitude lng to the range [-180, 180).
+func normalizeLng(lng float64) float64 {
+	l := math.Mod(lng+180, 360)
+	if l < 0 {
+		l += 360
+	}
+	return l - 180
+}
+
+// sin is math.Sin for degrees.
+func sin(d float64) float64 { return math.Sin(degToRad(d)) }
+
+// cos is math.Cos for degrees.
+func cos(d float64) float64 { return math.Cos(degToRad(d)) }
+
+// degToRad converts d degrees to radians.
+func degToRad(d float64) float64 {
+	return math.Pi * d / 180.0
+}
+
+// radToDeg converts r radians to degrees.
+func radToDeg(r float64) float64 {
+	return 180.0 * r / math.Pi
+}
@@ -0,0 +1,147 @@
+package geo
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	landsEnd     = LatLng{50.0664, -5.7147}
+	johnOGroats  = LatLng{58.6439, -3.0700}
+	greenwich    = LatLng{51.4779, 0}
+	northPole    = LatLng{90, 0}
+	nullIsland   = LatLng{0, 0}
+	antimeridian = LatLng{0, 180}
+)
+
+func TestDistance(t *testing.T) {
+	assert.InDelta(t, 968.9, Distance(landsEnd, johnOGroats), 0.1)
+	assert.InDelta(t, 968.9, Distance(johnOGroats, landsEnd), 0.1)
+	assert.InDelta(t, math.Pi*EarthRadius/2, Distance(nullIsland, northPole), 1e-9)
+	assert.InDelta(t, math.Pi*EarthRadius, Distance(nullIsland, antimeridian), 1e-9)
+	assert.Equal(t, 0.0, Distance(greenwich, greenwich))
+	assert.InDelta(t, 222.39, Distance(LatLng{0, 179}, LatLng{0, -179}), 0.01)
+}
+
+func TestBearing(t *testing.T) {
+	assert.InDelta(t, 9.1198, InitialBearing(landsEnd, johnOGroats), 1e-4)
+	assert.InDelta(t, 11.2752, FinalBearing(landsEnd, johnOGroats), 1e-4)
+
+	cases := []struct {
+		A, B    LatLng
+		Bearing float64
+	}{
+		{nullIsland, northPole, 0},
+		{nullIsland, LatLng{0, 10}, 90},
+		{LatLng{10, 0}, nullIsland, 180},
+		{nullIsland, LatLng{0, -10}, 270},
+		{LatLng{0, 179}, LatLng{0, -179}, 90},
+	}
+	for _, c := range cases {
+		assert.InDelta(t, c.Bearing, InitialBearing(c.A, c.B), 1e-9)
+		assert.InDelta(t, c.Bearing, FinalBearing(c.A, c.B), 1e-9)
+	}
+}
+
+func TestDestination(t *testing.T) {
+	p := Destination(LatLng{53.3206, -1.7297}, 124.8, 96.0217)
+	assert.InDelta(t, 53.1883, p.Lat, 1e-4)
+	assert.InDelta(t, 0.1333, p.Lng, 1e-4)
+
+	p = Destination(LatLng{0, 179}, Distance(LatLng{0, 179}, LatLng{0, -179}), 90)
+	assert.InDelta(t, 0, p.Lat, 1e-9)
+	assert.InDelta(t, -179, p.Lng, 1e-9)
+
+	// Bearings at the poles are relative to the meridian, as in the limit
+	// approaching them.
+	for _, pole := range []LatLng{{90, 30}, {-90, 30}} {
+		near := LatLng{pole.Lat - math.Copysign(1e-6, pole.Lat), pole.Lng}
+		for _, brng := range []float64{0, 45, 90, 180, 300} {
+			p := Destination(pole, 1000, brng)
+			q := Destination(near, 1000, brng)
+			assert.InDelta(t, 1000, Distance(pole, p), 1e-6)
+			assert.InDelta(t, q.Lat, p.Lat, 1e-4)
+			assert.InDelta(t, q.Lng, p.Lng, 1e-3)
+		}
+	}
+
+	for _, brng := range []float64{0, 45, 90, 180, 300} {
+		d := 1234.5
+		q := Destination(landsEnd, d, brng)
+		assert.InDelta(t, d, Distance(landsEnd, q), 1e-6)
+		assert.InDelta(t, brng, InitialBearing(landsEnd, q), 1e-9)
+	}
+}
+
+func TestIntermediate(t *testing.T) {
+	for f, want := range map[float64]LatLng{0: landsEnd, 1: johnOGroats} {
+		p := Intermediate(landsEnd, johnOGroats, f)
+		assert.InDelta(t, want.Lat, p.Lat, 1e-9)
+		assert.InDelta(t, want.Lng, p.Lng, 1e-9)
+	}
+	assert.Equal(t, greenwich, Intermediate(greenwich, greenwich, 0.5))
+
+	d := Distance(landsEnd, johnOGroats)
+	for _, f := range []float64{0.1, 0.25, 0.5, 0.9} {
+		p := Intermediate(landsEnd, johnOGroats, f)
+		assert.InDelta(t, f*d, Distance(landsEnd, p), 1e-6)
+		assert.InDelta(t, (1-f)*d, Distance(p, johnOGroats), 1e-6)
+	}
+}
+
+func TestMidpoint(t *testing.T) {
+	m := Midpoint(landsEnd, johnOGroats)
+	assert.InDelta(t, 54.3622, m.Lat, 1e-4)
+	assert.InDelta(t, -4.5306, m.Lng, 1e-4)
+
+	i := Intermediate(landsEnd, johnOGroats, 0.5)
+	assert.InDelta(t, i.Lat, m.Lat, 1e-9)
+	assert.InDelta(t, i.Lng, m.Lng, 1e-9)
+
+	m = Midpoint(LatLng{0, 170}, LatLng{0, -170})
+	assert.InDelta(t, 0, m.Lat, 1e-9)
+	assert.InDelta(t, -180, m.Lng, 1e-9)
+}
+
+func TestTrackDistance(t *testing.T) {
+	p := LatLng{53.2611, -0.7972}
+	a, b := LatLng{53.3206, -1.7297}, LatLng{53.1887, 0.1334}
+	assert.InDelta(t, -0.3075, CrossTrackDistance(p, a, b), 1e-4)
+	assert.InDelta(t, 62.331, AlongTrackDistance(p, a, b), 1e-3)
+
+	// Points on the right of the path, and behind its start.
+	a, b = nullIsland, LatLng{0, 10}
+	q := LatLng{-1, -2}
+	assert.InDelta(t, Distance(q, LatLng{0, -2}), CrossTrackDistance(q, a, b), 1e-6)
+	assert.InDelta(t, -Distance(a, LatLng{0, -2}), AlongTrackDistance(q, a, b), 1e-6)
+
+	// Points on the path.
+	m := Midpoint(a, b)
+	assert.InDelta(t, 0, CrossTrackDistance(m, a, b), 1e-9)
+	assert.InDelta(t, Distance(a, m), AlongTrackDistance(m, a, b), 1e-6)
+}
+
+func TestNormalize(t *testing.T) {
+	cases := []struct {
+		Angle, Lng, Bearing float64
+	}{
+		{0, 0, 0},
+		{180, -180, 180},
+		{-180, -180, 180},
+		{190, -170, 190},
+		{-190, 170, 170},
+		{540, -180, 180},
+		{-540, -180, 180},
+		{-541, 179, 179},
+		{900, -180, 180},
+		{-900, -180, 180},
+		{-1000, 80, 80},
+		{1000, -80, 280},
+	}
+	for _, c := range cases {
+		assert.InDelta(t, c.Lng, normalizeLng(c.Angle), 1e-9, "%v", c.Angle)
+		assert.InDelta(t, c.Bearing, normalizeBearing(c.Angle), 1e-9, "%v", c.Angle)
+	}
+}
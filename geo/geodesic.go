@@ -0,0 +1,740 @@
+package geo
+
+import "math"
+
+// This file is a port of the geodesic routines of GeographicLib, by C. F. F.
+// Karney, restricted to solving the direct and inverse problems. Series are
+// expanded to sixth order in the third flattening, which gives full double
+// precision for flattenings like the earth's.
+//
+// GeographicLib is distributed under the MIT License, reproduced here as it
+// requires:
+//
+// Copyright (c) 2008-2022, Charles Karney
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+// IN THE SOFTWARE.
+
+const (
+	// maxit1 is the number of Newton iterations tried when solving the inverse
+	// problem, and maxit2 the limit on those followed by bisection.
+	maxit1 = 20
+	maxit2 = maxit1 + 53 + 10
+)
+
+var (
+	tiny    = math.Sqrt(0x1p-1022)
+	tol0    = 0x1p-52
+	tol1    = 200 * tol0
+	tol2    = math.Sqrt(tol0)
+	tolb    = tol0 * tol2
+	xthresh = 1000 * tol2
+)
+
+// geodesic holds the derived parameters of an ellipsoid.
+type geodesic struct {
+	a, f, f1, ep2, n, b float64
+	etol2               float64
+
+	// a3x are the coefficients of A3 in powers of epsilon, and c3x[l] those
+	// of C3l.
+	a3x [6]float64
+	c3x [6][6]float64
+}
+
+// wgs84Geodesic is the geodesic of WGS84, derived once since it is the
+// ellipsoid most often used.
+var wgs84Geodesic = newGeodesic(WGS84)
+
+// geodesicOf returns the geodesic of e, reusing wgs84Geodesic if e is WGS84.
+func geodesicOf(e Ellipsoid) *geodesic {
+	if e.A == wgs84Geodesic.a && e.F == wgs84Geodesic.f {
+		return wgs84Geodesic
+	}
+	return newGeodesic(e)
+}
+
+func newGeodesic(e Ellipsoid) *geodesic {
+	g := &geodesic{a: e.A, f: e.F}
+	g.f1 = 1 - g.f
+	e2 := g.f * (2 - g.f)
+	g.ep2 = e2 / sq(g.f1)
+	g.n = g.f / (2 - g.f)
+	g.b = g.a * g.f1
+	g.etol2 = 0.1 * tol2 / math.Sqrt(math.Max(0.001, math.Abs(g.f))*math.Min(1, 1-g.f/2)/2)
+
+	n := g.n
+	g.a3x = [6]float64{
+		1,
+		-(1 - n) / 2,
+		-(2 + n - 3*n*n) / 8,
+		-(1 + 3*n + n*n) / 16,
+		-(3 + 2*n) / 64,
+		-3.0 / 128,
+	}
+	g.c3x = [6][6]float64{
+		1: {0, (1 - n) / 4, (1 - n*n) / 8, (3 + 3*n - n*n) / 64, (5 + 2*n) / 128, 3.0 / 128},
+		2: {0, 0, (2 - 3*n + n*n) / 32, (3 - 2*n - 3*n*n) / 64, (3 + n) / 128, 5.0 / 256},
+		3: {0, 0, 0, (5 - 9*n + 5*n*n) / 192, (9 - 10*n) / 384, 7.0 / 512},
+		4: {0, 0, 0, 0, (7 - 14*n) / 512, 7.0 / 512},
+		5: {0, 0, 0, 0, 0, 21.0 / 2560},
+	}
+	return g
+}
+
+// direct returns the end of the geodesic of length s12 from (lat1, lon1) with
+// azimuth azi1, and the azimuth there.
+func (g *geodesic) direct(lat1, lon1, azi1, s12 float64) (lat2, lon2, azi2 float64) {
+	lat1 = latFix(lat1)
+	salp1, calp1 := sincosd(angRound(angNormalize(azi1)))
+	sbet1, cbet1 := sincosd(angRound(lat1))
+	sbet1, cbet1 = norm2(g.f1*sbet1, cbet1)
+	cbet1 = math.Max(tiny, cbet1)
+
+	// The equator crossing and the start of the geodesic on the auxiliary
+	// sphere.
+	salp0 := salp1 * cbet1
+	calp0 := math.Hypot(calp1, salp1*sbet1)
+	ssig1, somg1 := sbet1, salp0*sbet1
+	csig1 := 1.0
+	if sbet1 != 0 || calp1 != 0 {
+		csig1 = calp1 * cbet1
+	}
+	comg1 := csig1
+	ssig1, csig1 = norm2(ssig1, csig1)
+
+	k2 := sq(calp0) * g.ep2
+	eps := k2 / (2*(1+math.Sqrt(1+k2)) + k2)
+	a1m1 := a1m1f(eps)
+	c1a := c1f(eps)
+	b11 := sinSeries(ssig1, csig1, c1a)
+	s, c := math.Sincos(b11)
+	stau1 := ssig1*c + csig1*s
+	ctau1 := csig1*c - ssig1*s
+	c1pa := c1pf(eps)
+	a3c := -g.f * salp0 * g.a3f(eps)
+	c3a := g.c3f(eps)
+	b31 := sinSeries(ssig1, csig1, c3a)
+
+	// Convert the distance to an arc length on the auxiliary sphere.
+	tau12 := s12 / (g.b * (1 + a1m1))
+	s, c = math.Sincos(tau12)
+	b12 := -sinSeries(stau1*c+ctau1*s, ctau1*c-stau1*s, c1pa)
+	sig12 := tau12 - (b12 - b11)
+	ssig12, csig12 := math.Sincos(sig12)
+	if math.Abs(g.f) > 0.01 {
+		// The reverted series is less accurate for large flattening, so
+		// take a Newton step.
+		ssig2 := ssig1*csig12 + csig1*ssig12
+		csig2 := csig1*csig12 - ssig1*ssig12
+		b12 = sinSeries(ssig2, csig2, c1a)
+		serr := (1+a1m1)*(sig12+(b12-b11)) - s12/g.b
+		sig12 -= serr / math.Sqrt(1+k2*sq(ssig2))
+		ssig12, csig12 = math.Sincos(sig12)
+	}
+
+	ssig2 := ssig1*csig12 + csig1*ssig12
+	csig2 := csig1*csig12 - ssig1*ssig12
+	sbet2 := calp0 * ssig2
+	cbet2 := math.Hypot(salp0, calp0*csig2)
+	if cbet2 == 0 {
+		cbet2, csig2 = tiny, tiny
+	}
+	somg2, comg2 := salp0*ssig2, csig2
+	omg12 := math.Atan2(somg2*comg1-comg2*somg1, comg2*comg1+somg2*somg1)
+	lam12 := omg12 + a3c*(sig12+(sinSeries(ssig2, csig2, c3a)-b31))
+
+	lat2 = atan2d(sbet2, g.f1*cbet2)
+	lon2 = angNormalize(angNormalize(lon1) + angNormalize(radToDeg(lam12)))
+	azi2 = atan2d(salp0, calp0*csig2)
+	return lat2, lon2, azi2
+}
+
+// inverse returns the length of the shortest geodesic between (lat1, lon1)
+// and (lat2, lon2), and its azimuths at each end.
+func (g *geodesic) inverse(lat1, lon1, lat2, lon2 float64) (s12, azi1, azi2 float64) {
+	// Reduce to the case lon12 in [0, 180], lat1 <= 0 and |lat1| >= |lat2|,
+	// remembering how to undo it.
+	lon12, lon12s := angDiff(lon1, lon2)
+	lonsign := 1.0
+	if lon12 < 0 {
+		lonsign = -1
+	}
+	lon12 = lonsign * angRound(lon12)
+	lon12s = angRound((180 - lon12) - lonsign*lon12s)
+	lam12 := degToRad(lon12)
+	var slam12, clam12 float64
+	if lon12 > 90 {
+		slam12, clam12 = sincosd(lon12s)
+		clam12 = -clam12
+	} else {
+		slam12, clam12 = sincosd(lon12)
+	}
+
+	lat1 = angRound(latFix(lat1))
+	lat2 = angRound(latFix(lat2))
+	swapp := 1.0
+	if math.Abs(lat1) < math.Abs(lat2) {
+		swapp = -1
+		lonsign = -lonsign
+		lat1, lat2 = lat2, lat1
+	}
+	latsign := -1.0
+	if lat1 < 0 {
+		latsign = 1
+	}
+	lat1 *= latsign
+	lat2 *= latsign
+
+	sbet1, cbet1 := sincosd(lat1)
+	sbet1, cbet1 = norm2(g.f1*sbet1, cbet1)
+	cbet1 = math.Max(tiny, cbet1)
+	sbet2, cbet2 := sincosd(lat2)
+	sbet2, cbet2 = norm2(g.f1*sbet2, cbet2)
+	cbet2 = math.Max(tiny, cbet2)
+
+	// Make the latitudes exactly equal or opposite if they are so to
+	// rounding error.
+	if cbet1 < -sbet1 {
+		if cbet2 == cbet1 {
+			sbet2 = math.Copysign(sbet1, sbet2)
+		}
+	} else if math.Abs(sbet2) == -sbet1 {
+		cbet2 = cbet1
+	}
+
+	dn1 := math.Sqrt(1 + g.ep2*sq(sbet1))
+	dn2 := math.Sqrt(1 + g.ep2*sq(sbet2))
+
+	var sig12, salp1, calp1, salp2, calp2, s12x float64
+
+	meridian := lat1 == -90 || slam12 == 0
+	if meridian {
+		// Along a meridian, unless it is not the shortest geodesic.
+		salp1, calp1 = slam12, clam12
+		salp2, calp2 = 0, 1
+		ssig1, csig1 := sbet1, calp1*cbet1
+		ssig2, csig2 := sbet2, calp2*cbet2
+		sig12 = math.Atan2(math.Max(0, csig1*ssig2-ssig1*csig2), csig1*csig2+ssig1*ssig2)
+		s12b, m12b, _ := g.lengths(g.n, sig12, ssig1, csig1, dn1, ssig2, csig2, dn2)
+		if sig12 < 1 || m12b >= 0 {
+			if sig12 < 3*tiny || (sig12 < tol0 && (s12b < 0 || m12b < 0)) {
+				s12b = 0
+			}
+			s12x = s12b * g.b
+		} else {
+			meridian = false
+		}
+	}
+
+	switch {
+	case meridian:
+	case sbet1 == 0 && (g.f <= 0 || lon12s >= g.f*180):
+		// Along the equator.
+		salp1, calp1 = 1, 0
+		salp2, calp2 = 1, 0
+		s12x = g.a * lam12
+	default:
+		var dnm float64
+		sig12, salp1, calp1, salp2, calp2, dnm = g.inverseStart(sbet1, cbet1, dn1, sbet2, cbet2, dn2, lam12, slam12, clam12)
+		if sig12 >= 0 {
+			// Short lines are solved directly.
+			s12x = sig12 * g.b * dnm
+			break
+		}
+
+		// Find the azimuth at the first point by Newton's method on the
+		// longitude difference, falling back to bisection.
+		var ssig1, csig1, ssig2, csig2, eps float64
+		tripn, tripb := false, false
+		salp1a, calp1a := tiny, 1.0
+		salp1b, calp1b := tiny, -1.0
+		for numit := 0; numit < maxit2; {
+			var v, dv float64
+			v, salp2, calp2, sig12, ssig1, csig1, ssig2, csig2, eps, dv = g.lambda12(
+				sbet1, cbet1, dn1, sbet2, cbet2, dn2, salp1, calp1, slam12, clam12, numit < maxit1)
+			limit := tol0
+			if tripn {
+				limit = 8 * tol0
+			}
+			if tripb || !(math.Abs(v) >= limit) {
+				break
+			}
+			// Update the bracketing interval.
+			if v > 0 && (numit > maxit1 || calp1/salp1 > calp1b/salp1b) {
+				salp1b, calp1b = salp1, calp1
+			} else if v < 0 && (numit > maxit1 || calp1/salp1 < calp1a/salp1a) {
+				salp1a, calp1a = salp1, calp1
+			}
+			numit++
+			if numit < maxit1 && dv > 0 {
+				dalp1 := -v / dv
+				sdalp1, cdalp1 := math.Sincos(dalp1)
+				nsalp1 := salp1*cdalp1 + calp1*sdalp1
+				if nsalp1 > 0 && math.Abs(dalp1) < math.Pi {
+					calp1 = calp1*cdalp1 - salp1*sdalp1
+					salp1 = nsalp1
+					salp1, calp1 = norm2(salp1, calp1)
+					tripn = math.Abs(v) <= 16*tol0
+					continue
+				}
+			}
+			salp1, calp1 = norm2((salp1a+salp1b)/2, (calp1a+calp1b)/2)
+			tripn = false
+			tripb = math.Abs(salp1a-salp1)+(calp1a-calp1) < tolb ||
+				math.Abs(salp1-salp1b)+(calp1-calp1b) < tolb
+		}
+		s12b, _, _ := g.lengths(eps, sig12, ssig1, csig1, dn1, ssig2, csig2, dn2)
+		s12x = s12b * g.b
+	}
+
+	if swapp < 0 {
+		salp1, salp2 = salp2, salp1
+		calp1, calp2 = calp2, calp1
+	}
+	salp1 *= swapp * lonsign
+	calp1 *= swapp * latsign
+	salp2 *= swapp * lonsign
+	calp2 *= swapp * latsign
+	return s12x, atan2d(salp1, calp1), atan2d(salp2, calp2)
+}
+
+// lengths returns the distance s12b and reduced length m12b, both divided by
+// the polar radius, of the geodesic with arc length sig12 on the auxiliary
+// sphere between the given points, and the coefficient m0 of sig12 in m12b.
+func (g *geodesic) lengths(eps, sig12, ssig1, csig1, dn1, ssig2, csig2, dn2 float64) (s12b, m12b, m0 float64) {
+	c1a := c1f(eps)
+	c2a := c2f(eps)
+	a1 := a1m1f(eps)
+	a2 := a2m1f(eps)
+	m0 = a1 - a2
+	a1++
+	a2++
+	b1 := sinSeries(ssig2, csig2, c1a) - sinSeries(ssig1, csig1, c1a)
+	b2 := sinSeries(ssig2, csig2, c2a) - sinSeries(ssig1, csig1, c2a)
+	s12b = a1 * (sig12 + b1)
+	j12 := m0*sig12 + (a1*b1 - a2*b2)
+	m12b = dn2*(csig1*ssig2) - dn1*(ssig1*csig2) - csig1*csig2*j12
+	return s12b, m12b, m0
+}
+
+// inverseStart returns a starting azimuth for the Newton iteration of the
+// inverse problem. If the geodesic is short enough to be solved directly,
+// sig12 is non-negative and the solution is returned.
+func (g *geodesic) inverseStart(sbet1, cbet1, dn1, sbet2, cbet2, dn2, lam12, slam12, clam12 float64) (sig12, salp1, calp1, salp2, calp2, dnm float64) {
+	sig12 = -1
+	sbet12 := sbet2*cbet1 - cbet2*sbet1
+	cbet12 := cbet2*cbet1 + sbet2*sbet1
+	sbet12a := sbet2*cbet1 + cbet2*sbet1
+
+	shortline := cbet12 >= 0 && sbet12 < 0.5 && cbet2*lam12 < 0.5
+	somg12, comg12 := slam12, clam12
+	if shortline {
+		sbetm2 := sq(sbet1 + sbet2)
+		sbetm2 /= sbetm2 + sq(cbet1+cbet2)
+		dnm = math.Sqrt(1 + g.ep2*sbetm2)
+		somg12, comg12 = math.Sincos(lam12 / (g.f1 * dnm))
+	}
+
+	salp1 = cbet2 * somg12
+	if comg12 >= 0 {
+		calp1 = sbet12 + cbet2*sbet1*sq(somg12)/(1+comg12)
+	} else {
+		calp1 = sbet12a - cbet2*sbet1*sq(somg12)/(1-comg12)
+	}
+	ssig12 := math.Hypot(salp1, calp1)
+	csig12 := sbet1*sbet2 + cbet1*cbet2*comg12
+
+	switch {
+	case shortline && ssig12 < g.etol2:
+		salp2 = cbet1 * somg12
+		t := 1 - comg12
+		if comg12 >= 0 {
+			t = sq(somg12) / (1 + comg12)
+		}
+		calp2 = sbet12 - cbet1*sbet2*t
+		salp2, calp2 = norm2(salp2, calp2)
+		sig12 = math.Atan2(ssig12, csig12)
+	case math.Abs(g.n) >= 0.1 || csig12 >= 0 || ssig12 >= 6*math.Abs(g.n)*math.Pi*sq(cbet1):
+		// The spherical approximation is good enough.
+	default:
+		// Nearly antipodal points: scale the problem to the astroid.
+		lam12x := math.Atan2(-slam12, -clam12)
+		var x, y, lamscale float64
+		if g.f >= 0 {
+			k2 := sq(sbet1) * g.ep2
+			eps := k2 / (2*(1+math.Sqrt(1+k2)) + k2)
+			lamscale = g.f * cbet1 * g.a3f(eps) * math.Pi
+			betscale := lamscale * cbet1
+			x = lam12x / lamscale
+			y = sbet12a / betscale
+		} else {
+			cbet12a := cbet2*cbet1 - sbet2*sbet1
+			bet12a := math.Atan2(sbet12a, cbet12a)
+			_, m12b, m0 := g.lengths(g.n, math.Pi+bet12a, sbet1, -cbet1, dn1, sbet2, cbet2, dn2)
+			x = -1 + m12b/(cbet1*cbet2*m0*math.Pi)
+			betscale := -g.f * sq(cbet1) * math.Pi
+			if x < -0.01 {
+				betscale = sbet12a / x
+			}
+			lamscale = betscale / cbet1
+			y = lam12x / lamscale
+		}
+
+		if y > -tol1 && x > -1-xthresh {
+			if g.f >= 0 {
+				salp1 = math.Min(1, -x)
+				calp1 = -math.Sqrt(1 - sq(salp1))
+			} else {
+				lower := -1.0
+				if x > -tol1 {
+					lower = 0
+				}
+				calp1 = math.Max(lower, x)
+				salp1 = math.Sqrt(1 - sq(calp1))
+			}
+		} else {
+			k := astroid(x, y)
+			var omg12a float64
+			if g.f >= 0 {
+				omg12a = lamscale * (-x * k / (1 + k))
+			} else {
+				omg12a = lamscale * (-y * (1 + k) / k)
+			}
+			somg12, comg12 = math.Sin(omg12a), -math.Cos(omg12a)
+			salp1 = cbet2 * somg12
+			calp1 = sbet12a - cbet2*sbet1*sq(somg12)/(1-comg12)
+		}
+	}
+
+	if !(salp1 <= 0) {
+		salp1, calp1 = norm2(salp1, calp1)
+	} else {
+		salp1, calp1 = 1, 0
+	}
+	return sig12, salp1, calp1, salp2, calp2, dnm
+}
+
+// lambda12 returns the difference v between the longitude reached by the
+// geodesic leaving the first point with azimuth alp1 at the latitude of the
+// second point, and the longitude of the second point. If diffp is set,
+// dv is its derivative with respect to alp1.
+func (g *geodesic) lambda12(sbet1, cbet1, dn1, sbet2, cbet2, dn2, salp1, calp1, slam120, clam120 float64, diffp bool) (v, salp2, calp2, sig12, ssig1, csig1, ssig2, csig2, eps, dv float64) {
+	if sbet1 == 0 && calp1 == 0 {
+		// Break the degeneracy of equatorial lines.
+		calp1 = -tiny
+	}
+	salp0 := salp1 * cbet1
+	calp0 := math.Hypot(calp1, salp1*sbet1)
+
+	ssig1, somg1 := sbet1, salp0*sbet1
+	csig1 = calp1 * cbet1
+	comg1 := csig1
+	ssig1, csig1 = norm2(ssig1, csig1)
+
+	salp2 = salp1
+	if cbet2 != cbet1 {
+		salp2 = salp0 / cbet2
+	}
+	calp2 = math.Abs(calp1)
+	if cbet2 != cbet1 || math.Abs(sbet2) != -sbet1 {
+		t := (sbet1 - sbet2) * (sbet1 + sbet2)
+		if cbet1 < -sbet1 {
+			t = (cbet2 - cbet1) * (cbet1 + cbet2)
+		}
+		calp2 = math.Sqrt(sq(calp1*cbet1)+t) / cbet2
+	}
+
+	ssig2, somg2 := sbet2, salp0*sbet2
+	csig2 = calp2 * cbet2
+	comg2 := csig2
+	ssig2, csig2 = norm2(ssig2, csig2)
+
+	sig12 = math.Atan2(math.Max(0, csig1*ssig2-ssig1*csig2), csig1*csig2+ssig1*ssig2)
+	somg12 := math.Max(0, comg1*somg2-somg1*comg2)
+	comg12 := comg1*comg2 + somg1*somg2
+	eta := math.Atan2(somg12*clam120-comg12*slam120, comg12*clam120+somg12*slam120)
+
+	k2 := sq(calp0) * g.ep2
+	eps = k2 / (2*(1+math.Sqrt(1+k2)) + k2)
+	c3a := g.c3f(eps)
+	b312 := sinSeries(ssig2, csig2, c3a) - sinSeries(ssig1, csig1, c3a)
+	v = eta - g.f*g.a3f(eps)*salp0*(sig12+b312)
+
+	dv = math.NaN()
+	if diffp {
+		if calp2 == 0 {
+			dv = -2 * g.f1 * dn1 / sbet1
+		} else {
+			_, m12b, _ := g.lengths(eps, sig12, ssig1, csig1, dn1, ssig2, csig2, dn2)
+			dv = m12b * g.f1 / (calp2 * cbet2)
+		}
+	}
+	return v, salp2, calp2, sig12, ssig1, csig1, ssig2, csig2, eps, dv
+}
+
+// astroid solves k^4 + 2k^3 - (x^2 + y^2 - 1)k^2 - 2y^2k - y^2 = 0 for its
+// positive root k.
+func astroid(x, y float64) float64 {
+	p, q := sq(x), sq(y)
+	r := (p + q - 1) / 6
+	if q == 0 && r <= 0 {
+		return 0
+	}
+	s := p * q / 4
+	r2 := sq(r)
+	r3 := r * r2
+	disc := s * (s + 2*r3)
+	u := r
+	if disc >= 0 {
+		t3 := s + r3
+		if t3 < 0 {
+			t3 -= math.Sqrt(disc)
+		} else {
+			t3 += math.Sqrt(disc)
+		}
+		t := math.Cbrt(t3)
+		u += t
+		if t != 0 {
+			u += r2 / t
+		}
+	} else {
+		ang := math.Atan2(math.Sqrt(-disc), -(s + r3))
+		u += 2 * r * math.Cos(ang/3)
+	}
+	v := math.Sqrt(sq(u) + q)
+	uv := u + v
+	if u < 0 {
+		uv = q / (v - u)
+	}
+	w := (uv - q) / (2 * v)
+	return uv / (math.Sqrt(uv+sq(w)) + w)
+}
+
+// a3f evaluates A3, the coefficient of the longitude integral.
+func (g *geodesic) a3f(eps float64) float64 {
+	return poly(g.a3x[:], eps)
+}
+
+// c3f evaluates the coefficients C3l of the longitude integral.
+func (g *geodesic) c3f(eps float64) []float64 {
+	c := make([]float64, 6)
+	for l := 1; l < 6; l++ {
+		c[l] = poly(g.c3x[l][:], eps)
+	}
+	return c
+}
+
+// a1m1f evaluates A1 - 1, where A1 is the coefficient of the distance
+// integral.
+func a1m1f(eps float64) float64 {
+	eps2 := sq(eps)
+	t := eps2 * (eps2*(eps2+4) + 64) / 256
+	return (t + eps) / (1 - eps)
+}
+
+// c1f evaluates the coefficients C1l of the distance integral.
+func c1f(eps float64) []float64 {
+	eps2 := sq(eps)
+	return []float64{
+		0,
+		eps * ((6-eps2)*eps2 - 16) / 32,
+		sq(eps) * ((64-9*eps2)*eps2 - 128) / 2048,
+		math.Pow(eps, 3) * (9*eps2 - 16) / 768,
+		math.Pow(eps, 4) * (3*eps2 - 5) / 512,
+		-7 * math.Pow(eps, 5) / 1280,
+		-7 * math.Pow(eps, 6) / 2048,
+	}
+}
+
+// c1pf evaluates the coefficients C1'l of the reverted distance integral.
+func c1pf(eps float64) []float64 {
+	eps2 := sq(eps)
+	return []float64{
+		0,
+		eps * (eps2*(205*eps2-432) + 768) / 1536,
+		sq(eps) * (eps2*(4005*eps2-4736) + 3840) / 12288,
+		math.Pow(eps, 3) * (116 - 225*eps2) / 384,
+		math.Pow(eps, 4) * (2695 - 7173*eps2) / 7680,
+		3467 * math.Pow(eps, 5) / 7680,
+		38081 * math.Pow(eps, 6) / 61440,
+	}
+}
+
+// a2m1f evaluates A2 - 1, where A2 is the coefficient of the reduced length
+// integral.
+func a2m1f(eps float64) float64 {
+	eps2 := sq(eps)
+	t := eps2 * (eps2*(25*eps2+36) + 64) / 256
+	return t*(1-eps) - eps
+}
+
+// c2f evaluates the coefficients C2l of the reduced length integral.
+func c2f(eps float64) []float64 {
+	eps2 := sq(eps)
+	return []float64{
+		0,
+		eps * (eps2*(eps2+2) + 16) / 32,
+		sq(eps) * (eps2*(35*eps2+64) + 384) / 2048,
+		math.Pow(eps, 3) * (15*eps2 + 80) / 768,
+		math.Pow(eps, 4) * (7*eps2 + 35) / 512,
+		63 * math.Pow(eps, 5) / 1280,
+		77 * math.Pow(eps, 6) / 2048,
+	}
+}
+
+// sinSeries evaluates the sum of c[l] sin(2l x) for l >= 1 by Clenshaw
+// summation, given sin x and cos x.
+func sinSeries(sinx, cosx float64, c []float64) float64 {
+	k := len(c)
+	n := k - 1
+	ar := 2 * (cosx - sinx) * (cosx + sinx)
+	var y0, y1 float64
+	if n&1 != 0 {
+		k--
+		y0 = c[k]
+	}
+	for n /= 2; n > 0; n-- {
+		k--
+		y1 = ar*y0 - y1 + c[k]
+		k--
+		y0 = ar*y1 - y0 + c[k]
+	}
+	return 2 * sinx * cosx * y0
+}
+
+// poly evaluates the polynomial with coefficients c in increasing powers of x.
+func poly(c []float64, x float64) float64 {
+	var y float64
+	for i := len(c) - 1; i >= 0; i-- {
+		y = y*x + c[i]
+	}
+	return y
+}
+
+// sincosd returns the sine and cosine of x degrees, exactly for multiples of
+// 90 degrees.
+func sincosd(x float64) (float64, float64) {
+	r := math.Mod(x, 360)
+	q := 0
+	if !math.IsNaN(r) {
+		q = int(math.RoundToEven(r / 90))
+	}
+	r = degToRad(r - 90*float64(q))
+	s, c := math.Sincos(r)
+	switch (q%4 + 4) % 4 {
+	case 1:
+		s, c = c, -s
+	case 2:
+		s, c = -s, -c
+	case 3:
+		s, c = -c, s
+	}
+	if x != 0 {
+		s, c = s+0, c+0
+	}
+	return s, c
+}
+
+// atan2d returns the angle in degrees of (x, y), in the range (-180, 180],
+// exactly for multiples of 90 degrees.
+func atan2d(y, x float64) float64 {
+	q := 0
+	if math.Abs(y) > math.Abs(x) {
+		q = 2
+		x, y = y, x
+	}
+	if x < 0 {
+		q++
+		x = -x
+	}
+	ang := radToDeg(math.Atan2(y, x))
+	switch q {
+	case 1:
+		if y >= 0 {
+			ang = 180 - ang
+		} else {
+			ang = -180 - ang
+		}
+	case 2:
+		ang = 90 - ang
+	case 3:
+		ang = -90 + ang
+	}
+	return ang
+}
+
+// angNormalize maps x degrees to the range (-180, 180].
+func angNormalize(x float64) float64 {
+	y := math.Remainder(x, 360)
+	if y == -180 {
+		return 180
+	}
+	return y
+}
+
+// angDiff returns y - x in degrees, reduced to the range (-180, 180], as an
+// error-free sum d + t.
+func angDiff(x, y float64) (d, t float64) {
+	d, t = twoSum(angNormalize(-x), angNormalize(y))
+	d = angNormalize(d)
+	if d == 180 && t > 0 {
+		d = -180
+	}
+	return twoSum(d, t)
+}
+
+// angRound rounds tiny angles x so that small differences from zero are
+// represented exactly.
+func angRound(x float64) float64 {
+	const z = 1.0 / 16
+	y := math.Abs(x)
+	if y < z {
+		y = z - (z - y)
+	}
+	return math.Copysign(y, x)
+}
+
+// latFix returns NaN for latitudes outside [-90, 90].
+func latFix(x float64) float64 {
+	if math.Abs(x) > 90 {
+		return math.NaN()
+	}
+	return x
+}
+
+// twoSum returns s = u + v and the rounding error t, so that s + t is exact.
+func twoSum(u, v float64) (s, t float64) {
+	s = u + v
+	up := s - v
+	vpp := s - up
+	up -= u
+	vpp -= v
+	return s, -(up + vpp)
+}
+
+// norm2 scales (x, y) to unit length.
+func norm2(x, y float64) (float64, float64) {
+	r := math.Hypot(x, y)
+	return x / r, y / r
+}
+
+// sq returns x squared.
+func sq(x float64) float64 {
+	return x * x
+}
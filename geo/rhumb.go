@@ -0,0 +1,69 @@
+package geo
+
+import "math"
+
+// A rhumb line, or loxodrome, crosses every meridian at the same angle. It is
+// a straight line on the Mercator projection, and is generally longer than
+// the great circle between the same points.
+
+// RhumbDistance returns the distance along the shortest rhumb line between a
+// and b, which crosses the antimeridian if that is shorter.
+func RhumbDistance(a, b LatLng) float64 {
+	dphi := degToRad(b.Lat - a.Lat)
+	dlambda := degToRad(normalizeLng(b.Lng - a.Lng))
+	return math.Hypot(dphi, rhumbStretch(a.Lat, b.Lat)*dlambda) * EarthRadius
+}
+
+// RhumbBearing returns the constant bearing of the shortest rhumb line from a
+// to b, in the range [0, 360).
+func RhumbBearing(a, b LatLng) float64 {
+	dlambda := degToRad(normalizeLng(b.Lng - a.Lng))
+	return normalizeBearing(radToDeg(math.Atan2(dlambda, mercatorDelta(a.Lat, b.Lat))))
+}
+
+// RhumbDestination returns the location reached by travelling distance from p
+// along the rhumb line with the given bearing. Rhumb lines spiral into the
+// poles without reaching them in finite longitude, so a destination beyond a
+// pole is clamped to it, with the longitude of p.
+func RhumbDestination(p LatLng, distance, bearing float64) LatLng {
+	dr := distance / EarthRadius
+	lat := p.Lat + radToDeg(dr*cos(bearing))
+	if math.Abs(lat) >= 90 {
+		return LatLng{math.Copysign(90, lat), p.Lng}
+	}
+	// Leaving a pole, every rhumb line is a meridian.
+	if math.Abs(p.Lat) >= 90 {
+		return LatLng{lat, p.Lng}
+	}
+	dlambda := dr * sin(bearing) / rhumbStretch(p.Lat, lat)
+	return LatLng{lat, normalizeLng(p.Lng + radToDeg(dlambda))}
+}
+
+// mercatorDelta returns the difference in Mercator projected latitude from
+// lat1 to lat2, in radians.
+func mercatorDelta(lat1, lat2 float64) float64 {
+	if lat1 == lat2 {
+		return 0
+	}
+	return mercator(lat2) - mercator(lat1)
+}
+
+// mercator returns the Mercator projected latitude of lat, in radians. It is
+// infinite at the poles.
+func mercator(lat float64) float64 {
+	if math.Abs(lat) >= 90 {
+		return math.Copysign(math.Inf(1), lat)
+	}
+	return math.Log(math.Tan(math.Pi/4 + degToRad(lat)/2))
+}
+
+// rhumbStretch returns the ratio of the change in latitude to the change in
+// Mercator projected latitude between lat1 and lat2. It is the factor by which
+// longitude differences are shortened along a rhumb line between them.
+func rhumbStretch(lat1, lat2 float64) float64 {
+	dpsi := mercatorDelta(lat1, lat2)
+	if math.Abs(dpsi) < 1e-12 {
+		return cos(lat1)
+	}
+	return degToRad(lat2-lat1) / dpsi
+}
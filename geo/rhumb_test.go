@@ -0,0 +1,86 @@
+package geo
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRhumb(t *testing.T) {
+	plymouth := LatLng{50.3664, -4.1339}
+	boston := LatLng{42.3511, -71.0408}
+	assert.InDelta(t, 5198, RhumbDistance(plymouth, boston), 1)
+	assert.InDelta(t, 260.1272, RhumbBearing(plymouth, boston), 1e-4)
+
+	p := RhumbDestination(LatLng{51.1256, 1.3381}, 40.23, 116.6361)
+	assert.InDelta(t, 50.9633, p.Lat, 1e-4)
+	assert.InDelta(t, 1.8525, p.Lng, 1e-4)
+}
+
+func TestRhumbCases(t *testing.T) {
+	cases := []struct {
+		A, B     LatLng
+		Distance float64
+		Bearing  float64
+	}{
+		// Meridians and the equator are both rhumb lines and great circles.
+		{nullIsland, northPole, math.Pi * EarthRadius / 2, 0},
+		{nullIsland, LatLng{0, -90}, math.Pi * EarthRadius / 2, 270},
+		// Parallels.
+		{LatLng{60, 10}, LatLng{60, 20}, math.Pi * EarthRadius / 18 * 0.5, 90},
+		// Across the antimeridian.
+		{LatLng{0, 179}, LatLng{0, -179}, math.Pi * EarthRadius / 90, 90},
+		{LatLng{0, -179}, LatLng{0, 179}, math.Pi * EarthRadius / 90, 270},
+	}
+	for _, c := range cases {
+		assert.InDelta(t, c.Distance, RhumbDistance(c.A, c.B), 1e-6, "%v", c)
+		assert.InDelta(t, c.Bearing, RhumbBearing(c.A, c.B), 1e-9, "%v", c)
+		q := RhumbDestination(c.A, c.Distance, c.Bearing)
+		assert.InDelta(t, c.B.Lat, q.Lat, 1e-9, "%v", c)
+		assert.InDelta(t, 0, normalizeLng(q.Lng-c.B.Lng), 1e-9, "%v", c)
+	}
+}
+
+func TestRhumbDestination(t *testing.T) {
+	// Travelling along a rhumb line keeps its bearing.
+	for _, brng := range []float64{10, 80, 135, 200, 300} {
+		for _, d := range []float64{100, 1234.5, 3000} {
+			q := RhumbDestination(landsEnd, d, brng)
+			assert.InDelta(t, d, RhumbDistance(landsEnd, q), 1e-6)
+			assert.InDelta(t, brng, RhumbBearing(landsEnd, q), 1e-9)
+		}
+	}
+
+	// Long rhumb lines, and those near the poles, wrap around the globe many
+	// times before reaching their destination.
+	cases := []struct {
+		P                 LatLng
+		Distance, Bearing float64
+		Want              LatLng
+	}{
+		{LatLng{80, 0}, 10000, 271, LatLng{81.5695, 157.1562}},
+		{LatLng{-85, 170}, 20000, 89, LatLng{-81.8609, -27.2140}},
+		{nullIsland, 100000, 270, LatLng{0, -179.3216}},
+	}
+	for _, c := range cases {
+		q := RhumbDestination(c.P, c.Distance, c.Bearing)
+		assert.InDelta(t, c.Want.Lat, q.Lat, 1e-4, "%v", c)
+		assert.InDelta(t, c.Want.Lng, q.Lng, 1e-4, "%v", c)
+		assert.True(t, -180 <= q.Lng && q.Lng < 180, "%v", c)
+	}
+
+	// Destinations beyond the poles are clamped to them.
+	assert.Equal(t, LatLng{90, 30}, RhumbDestination(LatLng{80, 30}, 5000, 45))
+	assert.Equal(t, LatLng{-90, 30}, RhumbDestination(LatLng{-80, 30}, 5000, 180))
+
+	// Rhumb lines to and from the poles are meridians.
+	assert.Equal(t, 0.0, RhumbBearing(LatLng{60, 45}, LatLng{90, 0}))
+	assert.Equal(t, 180.0, RhumbBearing(LatLng{90, 0}, LatLng{60, 45}))
+	assert.InDelta(t, math.Pi*EarthRadius/6, RhumbDistance(LatLng{60, 45}, LatLng{90, 0}), 1e-9)
+
+	// Leaving a pole, rhumb lines follow its meridian.
+	q := RhumbDestination(LatLng{90, 30}, 1000, 180)
+	assert.InDelta(t, 90-radToDeg(1000/EarthRadius), q.Lat, 1e-9)
+	assert.Equal(t, 30.0, q.Lng)
+}
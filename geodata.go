@@ -0,0 +1,130 @@
+package globe
+
+import (
+	_ "embed"
+	"sync"
+
+	"github.com/mmcloughlin/globe/internal/geodata"
+)
+
+// geodataScale is the scale of Natural Earth geodata, as the denominator of
+// the map scale in millions.
+type geodataScale int
+
+// Geodata scales.
+const (
+	scale10m  geodataScale = 10
+	scale50m  geodataScale = 50
+	scale110m geodataScale = 110
+)
+
+// geodataScales lists the geodata scales, finest first.
+var geodataScales = []geodataScale{scale10m, scale50m, scale110m}
+
+// Geodata generated by buildgeodata.go, in the encoding of the geodata
+// package.
+var (
+	//go:embed land.geodata
+	land110m []byte
+
+	//go:embed countries.geodata
+	countries110m []byte
+)
+
+// landData and countryData hold the geodata compiled in at each scale. The
+// 110m geodata is always available; finer scales are registered by files
+// built with the globe50m and globe10m tags.
+var (
+	landData    = map[geodataScale]*dataset{scale110m: {encoded: land110m}}
+	countryData = map[geodataScale]*dataset{scale110m: {encoded: countries110m}}
+)
+
+// dataset is encoded geodata, which is decoded on first use.
+type dataset struct {
+	encoded []byte
+
+	once    sync.Once
+	decoded []geodata.Feature
+	flat    [][]geodata.Point
+}
+
+// features returns the features of the dataset.
+func (d *dataset) features() []geodata.Feature {
+	d.decode()
+	return d.decoded
+}
+
+// rings returns the rings of all features of the dataset.
+func (d *dataset) rings() [][]geodata.Point {
+	d.decode()
+	return d.flat
+}
+
+func (d *dataset) decode() {
+	d.once.Do(func() {
+		fs, err := geodata.Decode(d.encoded)
+		if err != nil {
+			// Embedded geodata is generated, so cannot be invalid.
+			panic(err)
+		}
+		d.decoded = fs
+		for _, f := range fs {
+			d.flat = append(d.flat, f.Rings...)
+		}
+	})
+}
+
+// Resolution options select the scale of the geodata drawn by DrawLand,
+// DrawLandBoundaries, DrawCountries, DrawCountryBoundaries, DrawCountry and
+// DrawChoropleth. The default is Resolution110m, which is always available.
+// The 50m and 10m geodata are large, so they are only compiled in with the
+// globe50m and globe10m build tags. If the requested resolution is not
+// compiled in, the finest coarser resolution available is used instead.
+var (
+	Resolution110m = resolution(scale110m)
+	Resolution50m  = resolution(scale50m)
+	Resolution10m  = resolution(scale10m)
+)
+
+func resolution(s geodataScale) Option {
+	return func(g *Globe) {
+		g.cur.scale = s
+	}
+}
+
+// geodataScale returns the geodata scale selected by style, without modifying
+// the current layer.
+func (g *Globe) geodataScale(style []Option) geodataScale {
+	return g.selected(style).scale
+}
+
+// fallback returns s followed by the coarser scales.
+func (s geodataScale) fallback() []geodataScale {
+	for i, t := range geodataScales {
+		if t == s {
+			return geodataScales[i:]
+		}
+	}
+	return []geodataScale{scale110m}
+}
+
+// landAt returns the land geodata at scale s, or the nearest coarser scale
+// compiled in.
+func landAt(s geodataScale) *dataset {
+	return datasetAt(landData, s)
+}
+
+// countriesAt returns the country geodata at scale s, or the nearest coarser
+// scale compiled in.
+func countriesAt(s geodataScale) *dataset {
+	return datasetAt(countryData, s)
+}
+
+func datasetAt(data map[geodataScale]*dataset, s geodataScale) *dataset {
+	for _, t := range s.fallback() {
+		if d, ok := data[t]; ok {
+			return d
+		}
+	}
+	return data[scale110m]
+}
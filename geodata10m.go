@@ -0,0 +1,20 @@
+//go:build globe10m
+// +build globe10m
+
+package globe
+
+import _ "embed"
+
+// The 10m geodata is not committed; generate it with make geodata10m.
+var (
+	//go:embed land10m.geodata
+	land10m []byte
+
+	//go:embed countries10m.geodata
+	countries10m []byte
+)
+
+func init() {
+	landData[scale10m] = &dataset{encoded: land10m}
+	countryData[scale10m] = &dataset{encoded: countries10m}
+}
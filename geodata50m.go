@@ -0,0 +1,20 @@
+//go:build globe50m
+// +build globe50m
+
+package globe
+
+import _ "embed"
+
+// The 50m geodata is not committed; generate it with make geodata50m.
+var (
+	//go:embed land50m.geodata
+	land50m []byte
+
+	//go:embed countries50m.geodata
+	countries50m []byte
+)
+
+func init() {
+	landData[scale50m] = &dataset{encoded: land50m}
+	countryData[scale50m] = &dataset{encoded: countries50m}
+}
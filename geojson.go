@@ -0,0 +1,146 @@
+package globe
+
+import (
+	"io"
+
+	"github.com/mmcloughlin/globe/geojson"
+)
+
+// defaultDotRadius is the radius of dots drawn for point geometries, unless
+// overridden by the Radius option.
+const defaultDotRadius = 0.05
+
+// Radius uses the given radius for dots, overriding the radius given when
+// drawing.
+func Radius(r float64) Option {
+	return func(g *Globe) {
+		for i := range g.cur.dots {
+			g.cur.dots[i].radius = r
+		}
+	}
+}
+
+// DrawGeoJSON reads a GeoJSON feature collection, feature or geometry from r
+// and draws it on the globe, as in DrawFeatureCollection.
+func (g *Globe) DrawGeoJSON(r io.Reader, style ...Option) error {
+	fc, err := geojson.Decode(r)
+	if err != nil {
+		return err
+	}
+	g.DrawFeatureCollection(fc, style...)
+	return nil
+}
+
+// DrawFeatureCollection draws the geometries of the features in fc. Points are
+// drawn as dots, lines along great circles between their positions, and
+// polygons filled and outlined. Polygons are drawn first, then lines, then
+// dots, and each polygon is drawn as a separate layer.
+// Uses the default DotColor, LineColor and FillColor unless overridden by style
+// Options.
+func (g *Globe) DrawFeatureCollection(fc *geojson.FeatureCollection, style ...Option) {
+	var s geojsonShapes
+	for _, f := range fc.Features {
+		if f.Geometry != nil {
+			s.add(f.Geometry)
+		}
+	}
+
+	for _, polygon := range s.polygons {
+		g.drawPolygon(polygon, style...)
+	}
+	g.drawLines(s.lines, style...)
+	g.drawPoints(s.points, style...)
+}
+
+// drawLines draws lines along great circles between their positions, in one
+// layer. Nothing is drawn if there are no lines.
+func (g *Globe) drawLines(lines [][]latlng, style ...Option) {
+	if len(lines) == 0 {
+		return
+	}
+	defer g.styled(Color(g.style.LineColor), style...)()
+	g.cur.simplify = true
+	for _, line := range lines {
+		g.drawPath(greatCirclePath(line))
+	}
+}
+
+// drawPoints draws points as dots, in one layer. Nothing is drawn if there
+// are no points.
+func (g *Globe) drawPoints(points []latlng, style ...Option) {
+	if len(points) == 0 {
+		return
+	}
+	defer g.styled(Color(g.style.DotColor), style...)()
+	for _, p := range points {
+		g.cur.dots = append(g.cur.dots, dot{latlng: p, radius: defaultDotRadius})
+	}
+}
+
+// drawPolygon draws a filled polygon with the given rings, the first of which
+// is the exterior and the rest holes. Edges are drawn along great circles.
+func (g *Globe) drawPolygon(rings [][]latlng, style ...Option) {
+	defer g.styled(outlinedFill, style...)()
+	g.cur.simplify = true
+	for _, ring := range rings {
+		path := greatCirclePath(ring)
+		g.drawPath(path)
+		g.fillRing(path)
+	}
+}
+
+// geojsonShapes collects the shapes in GeoJSON geometries.
+type geojsonShapes struct {
+	points   []latlng
+	lines    [][]latlng
+	polygons [][][]latlng
+}
+
+// add adds the shapes in geometry geom.
+func (s *geojsonShapes) add(geom *geojson.Geometry) {
+	switch geom.Type {
+	case geojson.Point:
+		s.points = append(s.points, geojsonPosition(geom.Point))
+	case geojson.MultiPoint:
+		s.points = append(s.points, geojsonPositions(geom.MultiPoint)...)
+	case geojson.LineString:
+		s.lines = append(s.lines, geojsonPositions(geom.LineString))
+	case geojson.MultiLineString:
+		for _, line := range geom.MultiLineString {
+			s.lines = append(s.lines, geojsonPositions(line))
+		}
+	case geojson.Polygon:
+		s.polygons = append(s.polygons, geojsonPolygon(geom.Polygon))
+	case geojson.MultiPolygon:
+		for _, polygon := range geom.MultiPolygon {
+			s.polygons = append(s.polygons, geojsonPolygon(polygon))
+		}
+	case geojson.GeometryCollection:
+		for _, child := range geom.Geometries {
+			s.add(child)
+		}
+	}
+}
+
+// geojsonPosition converts a GeoJSON [longitude, latitude] position.
+func geojsonPosition(p []float64) latlng {
+	return latlng{p[1], p[0]}
+}
+
+// geojsonPositions converts a list of GeoJSON positions.
+func geojsonPositions(ps [][]float64) []latlng {
+	points := make([]latlng, len(ps))
+	for i, p := range ps {
+		points[i] = geojsonPosition(p)
+	}
+	return points
+}
+
+// geojsonPolygon converts the rings of a GeoJSON polygon.
+func geojsonPolygon(rings [][][]float64) [][]latlng {
+	polygon := make([][]latlng, len(rings))
+	for i, ring := range rings {
+		polygon[i] = geojsonPositions(ring)
+	}
+	return polygon
+}
@@ -0,0 +1,245 @@
+// Package geojson decodes GeoJSON documents, as specified by RFC 7946.
+package geojson
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// Object types.
+const (
+	TypeFeatureCollection = "FeatureCollection"
+	TypeFeature           = "Feature"
+)
+
+// GeometryType is the type of a geometry object.
+type GeometryType string
+
+// Supported geometry types.
+const (
+	Point              GeometryType = "Point"
+	MultiPoint         GeometryType = "MultiPoint"
+	LineString         GeometryType = "LineString"
+	MultiLineString    GeometryType = "MultiLineString"
+	Polygon            GeometryType = "Polygon"
+	MultiPolygon       GeometryType = "MultiPolygon"
+	GeometryCollection GeometryType = "GeometryCollection"
+)
+
+// FeatureCollection is a collection of features.
+type FeatureCollection struct {
+	Features []*Feature
+}
+
+// Feature is a geometry with properties. The geometry may be nil.
+type Feature struct {
+	ID         interface{}
+	Geometry   *Geometry
+	Properties map[string]interface{}
+}
+
+// Geometry is a geometry object. Positions are [longitude, latitude] pairs,
+// optionally followed by an altitude. Only the field corresponding to the
+// geometry type is set.
+type Geometry struct {
+	Type            GeometryType
+	Point           []float64
+	MultiPoint      [][]float64
+	LineString      [][]float64
+	MultiLineString [][][]float64
+	Polygon         [][][]float64
+	MultiPolygon    [][][][]float64
+	Geometries      []*Geometry
+}
+
+// object is the JSON representation of any GeoJSON object.
+type object struct {
+	Type        string                 `json:"type"`
+	ID          interface{}            `json:"id"`
+	Features    []json.RawMessage      `json:"features"`
+	Geometry    json.RawMessage        `json:"geometry"`
+	Properties  map[string]interface{} `json:"properties"`
+	Coordinates json.RawMessage        `json:"coordinates"`
+	Geometries  []json.RawMessage      `json:"geometries"`
+}
+
+// Decode reads a GeoJSON document from r. The document may be a feature
+// collection, a feature or a geometry; features and geometries are returned
+// as a collection of one feature.
+func Decode(r io.Reader) (*FeatureCollection, error) {
+	var raw json.RawMessage
+	if err := json.NewDecoder(r).Decode(&raw); err != nil {
+		return nil, err
+	}
+	return Unmarshal(raw)
+}
+
+// Unmarshal parses the GeoJSON document b, as in Decode.
+func Unmarshal(b []byte) (*FeatureCollection, error) {
+	var obj object
+	if err := json.Unmarshal(b, &obj); err != nil {
+		return nil, err
+	}
+
+	switch obj.Type {
+	case TypeFeatureCollection:
+		fc := &FeatureCollection{}
+		for _, raw := range obj.Features {
+			f, err := UnmarshalFeature(raw)
+			if err != nil {
+				return nil, err
+			}
+			fc.Features = append(fc.Features, f)
+		}
+		return fc, nil
+	case TypeFeature:
+		f, err := featureFromObject(obj)
+		if err != nil {
+			return nil, err
+		}
+		return &FeatureCollection{Features: []*Feature{f}}, nil
+	default:
+		g, err := geometryFromObject(obj)
+		if err != nil {
+			return nil, err
+		}
+		return &FeatureCollection{Features: []*Feature{{Geometry: g}}}, nil
+	}
+}
+
+// UnmarshalFeature parses the GeoJSON feature b.
+func UnmarshalFeature(b []byte) (*Feature, error) {
+	var obj object
+	if err := json.Unmarshal(b, &obj); err != nil {
+		return nil, err
+	}
+	return featureFromObject(obj)
+}
+
+// UnmarshalGeometry parses the GeoJSON geometry b.
+func UnmarshalGeometry(b []byte) (*Geometry, error) {
+	var obj object
+	if err := json.Unmarshal(b, &obj); err != nil {
+		return nil, err
+	}
+	return geometryFromObject(obj)
+}
+
+func featureFromObject(obj object) (*Feature, error) {
+	if obj.Type != TypeFeature {
+		return nil, fmt.Errorf("geojson: expected feature, got type %q", obj.Type)
+	}
+	f := &Feature{
+		ID:         obj.ID,
+		Properties: obj.Properties,
+	}
+	if len(obj.Geometry) > 0 && string(obj.Geometry) != "null" {
+		g, err := UnmarshalGeometry(obj.Geometry)
+		if err != nil {
+			return nil, err
+		}
+		f.Geometry = g
+	}
+	return f, nil
+}
+
+func geometryFromObject(obj object) (*Geometry, error) {
+	g := &Geometry{Type: GeometryType(obj.Type)}
+
+	var coords interface{}
+	switch g.Type {
+	case Point:
+		coords = &g.Point
+	case MultiPoint:
+		coords = &g.MultiPoint
+	case LineString:
+		coords = &g.LineString
+	case MultiLineString:
+		coords = &g.MultiLineString
+	case Polygon:
+		coords = &g.Polygon
+	case MultiPolygon:
+		coords = &g.MultiPolygon
+	case GeometryCollection:
+		for _, raw := range obj.Geometries {
+			child, err := UnmarshalGeometry(raw)
+			if err != nil {
+				return nil, err
+			}
+			g.Geometries = append(g.Geometries, child)
+		}
+		return g, nil
+	default:
+		return nil, fmt.Errorf("geojson: unknown geometry type %q", obj.Type)
+	}
+
+	if len(obj.Coordinates) == 0 {
+		return nil, fmt.Errorf("geojson: %s geometry has no coordinates", g.Type)
+	}
+	if err := json.Unmarshal(obj.Coordinates, coords); err != nil {
+		return nil, fmt.Errorf("geojson: %s coordinates: %s", g.Type, err)
+	}
+	if err := g.validate(); err != nil {
+		return nil, err
+	}
+	return g, nil
+}
+
+// errPosition is returned for positions with fewer than two coordinates.
+var errPosition = errors.New("geojson: position must have at least two coordinates")
+
+// validate checks that all positions in g have at least two coordinates.
+func (g *Geometry) validate() error {
+	check := func(positions ...[]float64) error {
+		for _, p := range positions {
+			if len(p) < 2 {
+				return errPosition
+			}
+		}
+		return nil
+	}
+	switch g.Type {
+	case Point:
+		return check(g.Point)
+	case MultiPoint:
+		return check(g.MultiPoint...)
+	case LineString:
+		return check(g.LineString...)
+	case MultiLineString:
+		for _, line := range g.MultiLineString {
+			if err := check(line...); err != nil {
+				return err
+			}
+		}
+	case Polygon:
+		for _, ring := range g.Polygon {
+			if err := check(ring...); err != nil {
+				return err
+			}
+		}
+	case MultiPolygon:
+		for _, polygon := range g.MultiPolygon {
+			for _, ring := range polygon {
+				if err := check(ring...); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// PropertyString returns the property of f with the given key formatted as a
+// string, and whether it was set.
+func (f *Feature) PropertyString(key string) (string, bool) {
+	v, ok := f.Properties[key]
+	if !ok || v == nil {
+		return "", false
+	}
+	if s, ok := v.(string); ok {
+		return s, true
+	}
+	return fmt.Sprint(v), true
+}
@@ -0,0 +1,120 @@
+package geojson
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecodeFeatureCollection(t *testing.T) {
+	src := `{
+		"type": "FeatureCollection",
+		"features": [
+			{
+				"type": "Feature",
+				"id": "a",
+				"properties": {"name": "Point", "rank": 3},
+				"geometry": {"type": "Point", "coordinates": [102.0, 0.5]}
+			},
+			{
+				"type": "Feature",
+				"id": 2,
+				"properties": null,
+				"geometry": {"type": "LineString", "coordinates": [[102.0, 0.0], [103.0, 1.0, 50.0]]}
+			},
+			{
+				"type": "Feature",
+				"properties": {},
+				"geometry": null
+			}
+		]
+	}`
+	fc, err := Decode(strings.NewReader(src))
+	require.NoError(t, err)
+	require.Len(t, fc.Features, 3)
+
+	f := fc.Features[0]
+	assert.Equal(t, "a", f.ID)
+	assert.Equal(t, Point, f.Geometry.Type)
+	assert.Equal(t, []float64{102, 0.5}, f.Geometry.Point)
+	name, ok := f.PropertyString("name")
+	assert.True(t, ok)
+	assert.Equal(t, "Point", name)
+	rank, ok := f.PropertyString("rank")
+	assert.True(t, ok)
+	assert.Equal(t, "3", rank)
+	_, ok = f.PropertyString("missing")
+	assert.False(t, ok)
+
+	f = fc.Features[1]
+	assert.Equal(t, 2.0, f.ID)
+	assert.Equal(t, LineString, f.Geometry.Type)
+	assert.Equal(t, [][]float64{{102, 0}, {103, 1, 50}}, f.Geometry.LineString)
+
+	assert.Nil(t, fc.Features[2].Geometry)
+}
+
+func TestDecodeGeometryTypes(t *testing.T) {
+	cases := []struct {
+		Source string
+		Expect *Geometry
+	}{
+		{
+			`{"type": "MultiPoint", "coordinates": [[1, 2], [3, 4]]}`,
+			&Geometry{Type: MultiPoint, MultiPoint: [][]float64{{1, 2}, {3, 4}}},
+		},
+		{
+			`{"type": "MultiLineString", "coordinates": [[[1, 2], [3, 4]], [[5, 6], [7, 8]]]}`,
+			&Geometry{Type: MultiLineString, MultiLineString: [][][]float64{{{1, 2}, {3, 4}}, {{5, 6}, {7, 8}}}},
+		},
+		{
+			`{"type": "Polygon", "coordinates": [[[0, 0], [1, 0], [1, 1], [0, 0]]]}`,
+			&Geometry{Type: Polygon, Polygon: [][][]float64{{{0, 0}, {1, 0}, {1, 1}, {0, 0}}}},
+		},
+		{
+			`{"type": "MultiPolygon", "coordinates": [[[[0, 0], [1, 0], [1, 1], [0, 0]]]]}`,
+			&Geometry{Type: MultiPolygon, MultiPolygon: [][][][]float64{{{{0, 0}, {1, 0}, {1, 1}, {0, 0}}}}},
+		},
+		{
+			`{"type": "GeometryCollection", "geometries": [{"type": "Point", "coordinates": [1, 2]}]}`,
+			&Geometry{Type: GeometryCollection, Geometries: []*Geometry{{Type: Point, Point: []float64{1, 2}}}},
+		},
+	}
+	for _, c := range cases {
+		fc, err := Unmarshal([]byte(c.Source))
+		require.NoError(t, err)
+		require.Len(t, fc.Features, 1)
+		assert.Equal(t, c.Expect, fc.Features[0].Geometry)
+	}
+}
+
+func TestDecodeFeature(t *testing.T) {
+	fc, err := Unmarshal([]byte(`{"type": "Feature", "id": "x", "geometry": {"type": "Point", "coordinates": [1, 2]}}`))
+	require.NoError(t, err)
+	require.Len(t, fc.Features, 1)
+	assert.Equal(t, "x", fc.Features[0].ID)
+}
+
+func TestDecodeErrors(t *testing.T) {
+	cases := []struct {
+		Source string
+		Error  string
+	}{
+		{`{"type": "Circle", "coordinates": [1, 2]}`, `geojson: unknown geometry type "Circle"`},
+		{`{"type": "Point"}`, "geojson: Point geometry has no coordinates"},
+		{`{"type": "Point", "coordinates": [1]}`, "geojson: position must have at least two coordinates"},
+		{`{"type": "LineString", "coordinates": [1, 2]}`, "geojson: LineString coordinates: "},
+		{`{"type": "FeatureCollection", "features": [{"type": "Point", "coordinates": [1, 2]}]}`, `geojson: expected feature, got type "Point"`},
+		{`{"type": "Feature", "geometry": {"type": "Polygon", "coordinates": [[[1, 2], [3]]]}}`, "geojson: position must have at least two coordinates"},
+	}
+	for _, c := range cases {
+		_, err := Unmarshal([]byte(c.Source))
+		require.Error(t, err, c.Source)
+		assert.True(t, strings.HasPrefix(err.Error(), c.Error), err.Error())
+	}
+
+	_, err := Decode(strings.NewReader("{"))
+	assert.Error(t, err)
+}
@@ -0,0 +1,108 @@
+package globe
+
+import (
+	"image/color"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testGeoJSON = `{
+	"type": "FeatureCollection",
+	"features": [
+		{
+			"type": "Feature",
+			"properties": {"name": "Bermuda Triangle"},
+			"geometry": {
+				"type": "Polygon",
+				"coordinates": [[[-80.19, 25.77], [-64.78, 32.30], [-66.11, 18.47], [-80.19, 25.77]]]
+			}
+		},
+		{
+			"type": "Feature",
+			"properties": {"name": "Route"},
+			"geometry": {
+				"type": "LineString",
+				"coordinates": [[-2.588323, 51.453349], [-73.903879, 40.645423], [-122.4194, 37.7749]]
+			}
+		},
+		{
+			"type": "Feature",
+			"properties": {},
+			"geometry": {
+				"type": "GeometryCollection",
+				"geometries": [
+					{"type": "Point", "coordinates": [-2.588323, 51.453349]},
+					{"type": "MultiPoint", "coordinates": [[-73.903879, 40.645423], [-122.4194, 37.7749]]}
+				]
+			}
+		}
+	]
+}`
+
+func TestDrawGeoJSON(t *testing.T) {
+	g := New()
+	err := g.DrawGeoJSON(strings.NewReader(testGeoJSON))
+	require.NoError(t, err)
+	require.Len(t, g.layers, 3)
+
+	polygon := g.layers[0]
+	assert.Equal(t, DefaultStyle.FillColor, polygon.fill)
+	assert.Equal(t, DefaultStyle.LineColor, polygon.color)
+	require.Len(t, polygon.rings, 1)
+	assert.True(t, len(polygon.rings[0]) > 4)
+
+	lines := g.layers[1]
+	assert.Equal(t, DefaultStyle.LineColor, lines.color)
+	require.Len(t, lines.paths, 1)
+	path := lines.paths[0]
+	assert.Equal(t, latlng{51.453349, -2.588323}, path[0])
+	assert.Equal(t, latlng{37.7749, -122.4194}, path[len(path)-1])
+
+	dots := g.layers[2]
+	assert.Equal(t, DefaultStyle.DotColor, dots.color)
+	require.Len(t, dots.dots, 3)
+	for _, d := range dots.dots {
+		assert.Equal(t, defaultDotRadius, d.radius)
+	}
+}
+
+func TestDrawGeoJSONStyle(t *testing.T) {
+	g := New()
+	blue := color.NRGBA{0, 0, 255, 255}
+	err := g.DrawGeoJSON(strings.NewReader(testGeoJSON), Color(blue), Radius(0.1))
+	require.NoError(t, err)
+	for _, l := range g.layers {
+		assert.Equal(t, blue, l.color)
+	}
+	assert.Equal(t, 0.1, g.layers[2].dots[0].radius)
+}
+
+func TestDrawGeoJSONError(t *testing.T) {
+	g := New()
+	err := g.DrawGeoJSON(strings.NewReader(`{"type": "Circle"}`))
+	assert.Error(t, err)
+	assert.Empty(t, g.layers)
+}
+
+func TestSVGDrawGeoJSON(t *testing.T) {
+	g := New()
+	g.DrawGraticule(10)
+	require.NoError(t, g.DrawGeoJSON(strings.NewReader(testGeoJSON)))
+	g.CenterOn(35, -60)
+	AssertSVGMD5(t, g, "335f855fa0f60ef9b3c349bb8d4ba656")
+}
+
+func TestGreatCirclePath(t *testing.T) {
+	points := []latlng{{0, 0}, {0, 10}, {10, 10}}
+	path := greatCirclePath(points)
+	assert.Equal(t, points[0], path[0])
+	assert.Equal(t, points[2], path[len(path)-1])
+	assert.Contains(t, path, points[1])
+	for i := 1; i < len(path); i++ {
+		d := haversine(path[i-1].lat, path[i-1].lng, path[i].lat, path[i].lng)
+		assert.True(t, d <= linePointInterval+1e-6)
+	}
+}
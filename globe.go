@@ -4,8 +4,14 @@ package globe
 import (
 	"image"
 	"image/color"
+	"image/draw"
+	"image/png"
 	"math"
+	"os"
 
+	"github.com/fogleman/gg"
+	"github.com/mmcloughlin/globe/geo"
+	"github.com/mmcloughlin/globe/internal/geodata"
 	"github.com/tidwall/pinhole"
 )
 
@@ -18,6 +24,10 @@ const (
 	// linePointInterval is the max distance (in km) between line segments when
 	// drawing along a great circle.
 	linePointInterval = 500.0
+
+	// circleBearingStep is the gap between points of a circle in degrees of
+	// bearing from its center.
+	circleBearingStep = 1.0
 )
 
 // Style encapsulates globe display options.
@@ -25,18 +35,28 @@ type Style struct {
 	GraticuleColor color.Color
 	LineColor      color.Color
 	DotColor       color.Color
+	FillColor      color.Color
 	Background     color.Color
 	LineWidth      float64
 	Scale          float64
+	BackFace       BackFace
+
+	// Projection is the map projection images are rendered in. Flat maps are
+	// drawn at about the size of the globe, and BackFace does not apply to
+	// them.
+	Projection Projection
+
+	// Tolerance is the distance in pixels that land, country and GeoJSON
+	// geometry may be moved by simplification when rendering, which saves
+	// drawing detail too small to see. Zero uses a quarter of a pixel, and a
+	// negative tolerance draws every point.
+	Tolerance float64
 }
 
-// imageOptions builds the pinhole ImageOptions object for this Style.
-func (s Style) imageOptions() *pinhole.ImageOptions {
-	return &pinhole.ImageOptions{
-		BGColor:   s.Background,
-		LineWidth: s.LineWidth,
-		Scale:     s.Scale,
-	}
+// dotScale returns the line width multiplier pinhole applies to dots of the
+// given radius.
+func dotScale(radius float64) float64 {
+	return 10 / 0.1 * radius
 }
 
 // DefaultStyle specifies out-of-the box style options.
@@ -44,6 +64,7 @@ var DefaultStyle = Style{
 	GraticuleColor: color.Gray{192},
 	LineColor:      color.Gray{32},
 	DotColor:       color.NRGBA{255, 0, 0, 255},
+	FillColor:      color.Gray{208},
 	Background:     color.White,
 	LineWidth:      0.1,
 	Scale:          0.7,
@@ -51,38 +72,152 @@ var DefaultStyle = Style{
 
 // Globe is a globe visualization.
 type Globe struct {
-	p     *pinhole.Pinhole
-	style Style
+	layers []*layer
+	cur    *layer
+	style  Style
+	camera Camera
+	legend ColorScale
 }
 
 // New constructs an empty globe with the default style.
 func New() *Globe {
 	return &Globe{
-		p:     pinhole.New(),
-		style: DefaultStyle,
+		style:  DefaultStyle,
+		camera: DefaultCamera,
+	}
+}
+
+// SetStyle sets the style of the globe. Default colors in the style apply to
+// geometry drawn subsequently; other options apply when rendering.
+func (g *Globe) SetStyle(s Style) {
+	g.style = s
+}
+
+// Clone returns a copy of the globe. Drawing on the copy, or changing its style
+// or camera, does not affect the original.
+func (g *Globe) Clone() *Globe {
+	c := *g
+	c.layers = append([]*layer(nil), g.layers...)
+	c.cur = nil
+	return &c
+}
+
+// latlng is a location on the globe in degrees.
+type latlng struct {
+	lat, lng float64
+}
+
+// dot is a location drawn with a radius.
+type dot struct {
+	latlng
+	radius float64
+}
+
+// layer is a group of geometry drawn with common style. Every drawing method
+// records its geometry in a new layer, which is not modified afterwards.
+type layer struct {
+	color color.Color
+	fill  color.Color
+	paths [][]latlng
+	dots  []dot
+	rings [][]latlng
+
+	// complements are rings bounding filled regions that are the larger of
+	// the two parts of the globe bounded by the ring.
+	complements [][]latlng
+
+	// simplify is set if paths and rings may be simplified when rendering.
+	simplify bool
+
+	// scale is the geodata scale selected by Resolution options.
+	scale geodataScale
+
+	// ellipsoid is the shape of the earth selected by the Ellipsoidal option,
+	// or nil for a sphere.
+	ellipsoid *geo.Ellipsoid
+
+	// colors, if set, holds a color for each path, overriding color unless
+	// nil.
+	colors []color.Color
+
+	// vertexColors and closed are selected by the VertexColors and ClosePath
+	// options.
+	vertexColors []color.Color
+	closed       bool
+
+	// valueColors and valueRadius are selected by the ValueColors and
+	// ValueRadius options.
+	valueColors ColorScale
+	valueRadius func(v float64) float64
+}
+
+// pathColor returns the color of the i'th path.
+func (l *layer) pathColor(i int) color.Color {
+	if l.colors != nil && l.colors[i] != nil {
+		return l.colors[i]
 	}
+	return l.color
+}
+
+// line returns the path from a to b drawn by DrawLine with the settings of l.
+func (l *layer) line(a, b latlng) []latlng {
+	if l.ellipsoid != nil {
+		return geodesic(*l.ellipsoid, a, b)
+	}
+	return greatCircle(a, b)
 }
 
 // Option is a function that stylizes a globe.
 type Option func(*Globe)
 
-// Color uses the given color.
+// Color uses the given color. Lines and dots are not drawn if c is nil.
 func Color(c color.Color) Option {
 	return func(g *Globe) {
-		g.p.Colorize(c)
+		g.cur.color = c
 	}
 }
 
-// styled is an internal convenience for applying style Options within a pinhole
-// Begin/End context.
+// styled is an internal convenience for applying style Options to a new layer.
+// The layer is available as g.cur until the returned function is called.
 func (g *Globe) styled(base Option, options ...Option) func() {
-	g.p.Begin()
+	g.cur = &layer{}
 	return func() {
 		base(g)
 		for _, option := range options {
 			option(g)
 		}
-		g.p.End()
+		g.layers = append(g.layers, g.cur)
+		g.cur = nil
+	}
+}
+
+// selected returns a layer with the settings selected by style, without
+// modifying the current layer. It is used where settings affect how geometry
+// is recorded, since styled only applies options once drawing is done.
+func (g *Globe) selected(style []Option) *layer {
+	cur := g.cur
+	defer func() { g.cur = cur }()
+	g.cur = &layer{scale: scale110m}
+	for _, option := range style {
+		option(g)
+	}
+	return g.cur
+}
+
+// drawPath records a path through the given points in the current layer.
+func (g *Globe) drawPath(path []latlng) {
+	g.drawColoredPath(path, nil)
+}
+
+// drawColoredPath records a path through the given points in the current
+// layer, drawn in color c rather than the layer color if c is not nil.
+func (g *Globe) drawColoredPath(path []latlng, c color.Color) {
+	if c != nil && g.cur.colors == nil {
+		g.cur.colors = make([]color.Color, len(g.cur.paths))
+	}
+	g.cur.paths = append(g.cur.paths, path)
+	if g.cur.colors != nil {
+		g.cur.colors = append(g.cur.colors, c)
 	}
 }
 
@@ -90,11 +225,12 @@ func (g *Globe) styled(base Option, options ...Option) func() {
 // Uses the default GraticuleColor unless overridden by style Options.
 func (g *Globe) DrawParallel(lat float64, style ...Option) {
 	defer g.styled(Color(g.style.GraticuleColor), style...)()
+	path := []latlng{}
 	for lng := -180.0; lng < 180.0; lng += graticuleLineStep {
-		x1, y1, z1 := cartestian(lat, lng)
-		x2, y2, z2 := cartestian(lat, lng+graticuleLineStep)
-		g.p.DrawLine(x1, y1, z1, x2, y2, z2)
+		path = append(path, latlng{lat, lng})
 	}
+	path = append(path, latlng{lat, 180.0})
+	g.drawPath(path)
 }
 
 // DrawParallels draws parallels at the given interval.
@@ -111,11 +247,12 @@ func (g *Globe) DrawParallels(interval float64, style ...Option) {
 // Uses the default GraticuleColor unless overridden by style Options.
 func (g *Globe) DrawMeridian(lng float64, style ...Option) {
 	defer g.styled(Color(g.style.GraticuleColor), style...)()
+	path := []latlng{}
 	for lat := -90.0; lat < 90.0; lat += graticuleLineStep {
-		x1, y1, z1 := cartestian(lat, lng)
-		x2, y2, z2 := cartestian(lat+graticuleLineStep, lng)
-		g.p.DrawLine(x1, y1, z1, x2, y2, z2)
+		path = append(path, latlng{lat, lng})
 	}
+	path = append(path, latlng{90.0, lng})
+	g.drawPath(path)
 }
 
 // DrawMeridians draws meridians at the given interval.
@@ -137,32 +274,118 @@ func (g *Globe) DrawGraticule(interval float64, style ...Option) {
 // Uses the default DotColor unless overridden by style Options.
 func (g *Globe) DrawDot(lat, lng float64, radius float64, style ...Option) {
 	defer g.styled(Color(g.style.DotColor), style...)()
-	x, y, z := cartestian(lat, lng)
-	g.p.DrawDot(x, y, z, radius)
+	g.cur.dots = append(g.cur.dots, dot{
+		latlng: latlng{lat, lng},
+		radius: radius,
+	})
 }
 
 // DrawLine draws a line between (lat1, lng1) and (lat2, lng2) along the great
-// circle.
+// circle, or along the geodesic if an Ellipsoidal option is given.
 // Uses the default LineColor unless overridden by style Options.
 func (g *Globe) DrawLine(lat1, lng1, lat2, lng2 float64, style ...Option) {
+	sel := g.selected(style)
 	defer g.styled(Color(g.style.LineColor), style...)()
+	g.drawPath(sel.line(latlng{lat1, lng1}, latlng{lat2, lng2}))
+}
+
+// Ellipsoidal draws lines along the shortest geodesics on the ellipsoid e,
+// such as geo.WGS84, rather than along great circles of a spherical earth.
+func Ellipsoidal(e geo.Ellipsoid) Option {
+	return func(g *Globe) {
+		g.cur.ellipsoid = &e
+	}
+}
 
-	d := haversine(lat1, lng1, lat2, lng2)
+// geodesic returns a path from a to b along the shortest geodesic on e, with
+// points at most linePointInterval apart.
+func geodesic(e geo.Ellipsoid, a, b latlng) []latlng {
+	p := geo.LatLng{Lat: a.lat, Lng: a.lng}
+	d, brng, _ := e.Inverse(p, geo.LatLng{Lat: b.lat, Lng: b.lng})
 	step := d / math.Ceil(d/linePointInterval)
-	fx, fy, fz := cartestian(lat1, lng1)
+	path := []latlng{a}
+	for s := step; s < d-step/2; s += step {
+		q, _ := e.Direct(p, s, brng)
+		path = append(path, latlng{q.Lat, q.Lng})
+	}
+	return append(path, b)
+}
+
+// greatCircle returns a path from a to b along the great circle, with points
+// at most linePointInterval apart.
+func greatCircle(a, b latlng) []latlng {
+	d := haversine(a.lat, a.lng, b.lat, b.lng)
+	step := d / math.Ceil(d/linePointInterval)
+	path := []latlng{a}
 	for p := step; p < d-step/2; p += step {
-		tlat, tlng := intermediate(lat1, lng1, lat2, lng2, p/d)
-		tx, ty, tz := cartestian(tlat, tlng)
-		g.p.DrawLine(fx, fy, fz, tx, ty, tz)
-		fx, fy, fz = tx, ty, tz
+		tlat, tlng := intermediate(a.lat, a.lng, b.lat, b.lng, p/d)
+		path = append(path, latlng{tlat, tlng})
+	}
+	return append(path, b)
+}
+
+// DrawRhumbLine draws a line between (lat1, lng1) and (lat2, lng2) along the
+// shortest rhumb line, which keeps a constant bearing.
+// Uses the default LineColor unless overridden by style Options.
+func (g *Globe) DrawRhumbLine(lat1, lng1, lat2, lng2 float64, style ...Option) {
+	defer g.styled(Color(g.style.LineColor), style...)()
+	g.drawPath(rhumbLine(latlng{lat1, lng1}, latlng{lat2, lng2}))
+}
+
+// rhumbLine returns a path from a to b along the shortest rhumb line, with
+// points at most linePointInterval apart. Near the poles rhumb lines turn
+// tightly, so points are also at most graticuleLineStep apart in longitude.
+func rhumbLine(a, b latlng) []latlng {
+	// Any longitude names a pole, so meet it along the meridian of the other
+	// end.
+	if math.Abs(a.lat) >= 90 {
+		a.lng = b.lng
+	}
+	if math.Abs(b.lat) >= 90 {
+		b.lng = a.lng
+	}
+	p, q := geo.LatLng{Lat: a.lat, Lng: a.lng}, geo.LatLng{Lat: b.lat, Lng: b.lng}
+	d := geo.RhumbDistance(p, q)
+	brng := geo.RhumbBearing(p, q)
+	at := func(s float64) latlng {
+		t := geo.RhumbDestination(p, s, brng)
+		return latlng{t.Lat, t.Lng}
 	}
 
-	tx, ty, tz := cartestian(lat2, lng2)
-	g.p.DrawLine(fx, fy, fz, tx, ty, tz)
+	// Longitude changes faster towards the poles, so split steps in half
+	// until they are short enough in both distance and longitude.
+	path := []latlng{a}
+	var extend func(s0, s1 float64, end latlng)
+	extend = func(s0, s1 float64, end latlng) {
+		start := path[len(path)-1]
+		dlng := math.Abs(math.Mod(end.lng-start.lng+540, 360) - 180)
+		if s1-s0 > linePointInterval || dlng > graticuleLineStep {
+			mid := at((s0 + s1) / 2)
+			extend(s0, (s0+s1)/2, mid)
+			extend((s0+s1)/2, s1, end)
+			return
+		}
+		path = append(path, end)
+	}
+	extend(0, d, b)
+	return path
 }
 
-// DrawRect draws the rectangle with the given corners. Sides are drawn along
-// great circles, as in DrawLine.
+// greatCirclePath returns a path through points, joining consecutive points
+// along great circles as in greatCircle.
+func greatCirclePath(points []latlng) []latlng {
+	if len(points) < 2 {
+		return points
+	}
+	path := []latlng{points[0]}
+	for i := 1; i < len(points); i++ {
+		path = append(path, greatCircle(points[i-1], points[i])[1:]...)
+	}
+	return path
+}
+
+// DrawRect draws the rectangle with the given corners. Sides are drawn as in
+// DrawLine.
 // Uses the default LineColor unless overridden by style Options.
 func (g *Globe) DrawRect(minlat, minlng, maxlat, maxlng float64, style ...Option) {
 	g.DrawLine(minlat, minlng, maxlat, minlng, style...)
@@ -172,48 +395,155 @@ func (g *Globe) DrawRect(minlat, minlng, maxlat, maxlng float64, style ...Option
 }
 
 // DrawLandBoundaries draws land boundaries on the globe.
-// Uses the default LineColor unless overridden by style Options.
+// Uses the default LineColor unless overridden by style Options, and 110m
+// geodata unless a Resolution option is given.
 func (g *Globe) DrawLandBoundaries(style ...Option) {
-	g.drawPreparedPaths(land, style...)
+	g.drawPreparedPaths(landAt(g.geodataScale(style)).rings(), style...)
 }
 
 // DrawCountryBoundaries draws country boundaries on the globe.
-// Uses the default LineColor unless overridden by style Options.
+// Uses the default LineColor unless overridden by style Options, and 110m
+// geodata unless a Resolution option is given.
 func (g *Globe) DrawCountryBoundaries(style ...Option) {
-	g.drawPreparedPaths(countries, style...)
+	g.drawPreparedPaths(countriesAt(g.geodataScale(style)).rings(), style...)
 }
 
-func (g *Globe) drawPreparedPaths(paths [][]struct{ lat, lng float32 }, style ...Option) {
+func (g *Globe) drawPreparedPaths(paths [][]geodata.Point, style ...Option) {
 	defer g.styled(Color(g.style.LineColor), style...)()
+	g.cur.simplify = true
 	for _, path := range paths {
-		n := len(path)
-		for i := 0; i+1 < n; i++ {
-			p1, p2 := path[i], path[i+1]
-			x1, y1, z1 := cartestian(float64(p1.lat), float64(p1.lng))
-			x2, y2, z2 := cartestian(float64(p2.lat), float64(p2.lng))
-			g.p.DrawLine(x1, y1, z1, x2, y2, z2)
+		points := make([]latlng, len(path))
+		for i, p := range path {
+			points[i] = latlng{float64(p.Lat), float64(p.Lng)}
 		}
+		g.drawPath(points)
 	}
 }
 
-// CenterOn rotates the globe to center on (lat, lng).
+// CenterOn points the camera at (lat, lng). The camera position is absolute, so
+// each call replaces the center set by previous calls.
 func (g *Globe) CenterOn(lat, lng float64) {
-	g.p.Rotate(0, 0, -degToRad(lng)-math.Pi/2)
-	g.p.Rotate(math.Pi/2-degToRad(lat), 0, 0)
+	g.camera.Lat = lat
+	g.camera.Lng = lng
+}
+
+// pinhole builds a pinhole object for the given meshes.
+func (v view) pinhole(meshes []*mesh) *pinhole.Pinhole {
+	p := pinhole.New()
+	for _, l := range meshes {
+		p.Begin()
+		if l.colors == nil {
+			for _, path := range l.paths {
+				v.pinholePath(p, path)
+			}
+		}
+		for _, d := range l.dots {
+			p.DrawDot(d.x*v.zoom, d.y*v.zoom, d.z, d.radius)
+		}
+		p.Colorize(l.color)
+		// Paths with their own colors are drawn in nested groups, after the
+		// rest of the mesh is colored.
+		for i, path := range l.paths {
+			if l.colors != nil {
+				p.Begin()
+				v.pinholePath(p, path)
+				p.Colorize(l.colors[i])
+				p.End()
+			}
+		}
+		p.End()
+	}
+	return p
+}
+
+// pinholePath draws path, in camera space, to p.
+func (v view) pinholePath(p *pinhole.Pinhole, path []point) {
+	for i := 0; i+1 < len(path); i++ {
+		a, b := path[i], path[i+1]
+		p.DrawLine(a.x*v.zoom, a.y*v.zoom, a.z, b.x*v.zoom, b.y*v.zoom, b.z)
+	}
 }
 
 // Image renders an image object for the visualization with dimensions
 // (side, side).
 func (g *Globe) Image(side int) *image.RGBA {
-	opts := g.style.imageOptions()
-	return g.p.Image(side, side, opts)
+	return g.image(g.camera, side)
+}
+
+// RenderAt renders an image object for the visualization with dimensions
+// (side, side), centered on (lat, lng). Otherwise the globe's camera is used.
+// The globe is not modified, so many images may be rendered from one globe.
+func (g *Globe) RenderAt(lat, lng float64, side int) *image.RGBA {
+	c := g.camera
+	c.Lat, c.Lng = lat, lng
+	return g.image(c, side)
+}
+
+// image renders an image object from camera c.
+func (g *Globe) image(c Camera, side int) *image.RGBA {
+	v, meshes := g.render(c, float64(side))
+	p := v.pinhole(meshes)
+	opts := v.imageOptions()
+	if !hasFills(meshes) && g.legend == nil {
+		return p.Image(side, side, opts)
+	}
+
+	// Pinhole only draws lines, so fills are drawn beneath its output and the
+	// legend above it.
+	m := image.NewRGBA(image.Rect(0, 0, side, side))
+	ctx := gg.NewContextForRGBA(m)
+	if v.Background != nil {
+		ctx.SetColor(v.Background)
+		ctx.Clear()
+	}
+	ctx.SetFillRuleEvenOdd()
+	for _, f := range meshes {
+		if len(f.fills) == 0 {
+			continue
+		}
+		for _, ring := range f.fills {
+			for i, pt := range ring {
+				x, y := v.project(pt, float64(side))
+				if i == 0 {
+					ctx.MoveTo(x, y)
+				} else {
+					ctx.LineTo(x, y)
+				}
+			}
+			ctx.ClosePath()
+		}
+		ctx.SetColor(f.fill)
+		ctx.Fill()
+	}
+
+	opts.BGColor = nil
+	lines := p.Image(side, side, opts)
+	draw.Draw(m, m.Bounds(), lines, image.Point{}, draw.Over)
+	if g.legend != nil {
+		v.drawLegend(ctx, g.legend, float64(side))
+	}
+	return m
+}
+
+// hasFills reports whether any of the meshes have filled regions.
+func hasFills(meshes []*mesh) bool {
+	for _, m := range meshes {
+		if len(m.fills) > 0 {
+			return true
+		}
+	}
+	return false
 }
 
 // SavePNG writes the visualization to filename in PNG format with dimensions
 // (side, side).
 func (g *Globe) SavePNG(filename string, side int) error {
-	opts := g.style.imageOptions()
-	return g.p.SavePNG(filename, side, side, opts)
+	f, err := os.Create(filename)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	return png.Encode(f, g.Image(side))
 }
 
 // cartestian maps (lat, lng) to pinhole cartestian space.
@@ -224,40 +554,42 @@ func cartestian(lat, lng float64) (x, y, z float64) {
 	return
 }
 
-// earthRadius is the radius of the earth.
-const earthRadius = 6371.0
+// cartestianPoint maps (lat, lng) to a point in pinhole cartestian space.
+func cartestianPoint(lat, lng float64) point {
+	x, y, z := cartestian(lat, lng)
+	return point{x, y, z}
+}
+
+// rotateX rotates (y, z) by angle q about the x axis, matching the pinhole
+// Rotate method.
+func rotateX(y, z, q float64) (float64, float64) {
+	return y*math.Cos(q) - z*math.Sin(q), y*math.Sin(q) + z*math.Cos(q)
+}
+
+// rotateZ rotates (x, y) by angle q about the z axis, matching the pinhole
+// Rotate method.
+func rotateZ(x, y, q float64) (float64, float64) {
+	return x*math.Cos(q) - y*math.Sin(q), x*math.Sin(q) + y*math.Cos(q)
+}
 
 // haversine returns the distance (in km) between the points (lat1, lng1) and
 // (lat2, lng2).
 func haversine(lat1, lng1, lat2, lng2 float64) float64 {
-	dlat := lat2 - lat1
-	dlng := lng2 - lng1
-	a := sin(dlat/2)*sin(dlat/2) + cos(lat1)*cos(lat2)*sin(dlng/2)*sin(dlng/2)
-	c := 2 * math.Atan2(math.Sqrt(a), math.Sqrt(1-a))
-	return earthRadius * c
+	return geo.Distance(geo.LatLng{Lat: lat1, Lng: lng1}, geo.LatLng{Lat: lat2, Lng: lng2})
 }
 
 // intermediate returns the point that is fraction f between (lat1, lng1) and
 // (lat2, lng2).
 func intermediate(lat1, lng1, lat2, lng2, f float64) (float64, float64) {
-	dr := haversine(lat1, lng1, lat2, lng2) / earthRadius
-	a := math.Sin((1-f)*dr) / math.Sin(dr)
-	b := math.Sin(f*dr) / math.Sin(dr)
-	x := a*cos(lat1)*cos(lng1) + b*cos(lat2)*cos(lng2)
-	y := a*cos(lat1)*sin(lng1) + b*cos(lat2)*sin(lng2)
-	z := a*sin(lat1) + b*sin(lat2)
-	phi := math.Atan2(z, math.Sqrt(x*x+y*y))
-	lambda := math.Atan2(y, x)
-	return radToDeg(phi), radToDeg(lambda)
+	p := geo.Intermediate(geo.LatLng{Lat: lat1, Lng: lng1}, geo.LatLng{Lat: lat2, Lng: lng2}, f)
+	return p.Lat, p.Lng
 }
 
 // destination computes the destination point reached when travelling distance d
 // from (lat, lng) at bearing brng.
 func destination(lat, lng, d, brng float64) (float64, float64) {
-	dr := d / earthRadius
-	phi := math.Asin(sin(lat)*math.Cos(dr) + cos(lat)*math.Sin(dr)*cos(brng))
-	lambda := degToRad(lng) + math.Atan2(sin(brng)*math.Sin(dr)*cos(lat), math.Cos(dr)-sin(lat)*math.Sin(phi))
-	return radToDeg(phi), math.Mod(radToDeg(lambda)+540, 360) - 180
+	p := geo.Destination(geo.LatLng{Lat: lat, Lng: lng}, d, brng)
+	return p.Lat, p.Lng
 }
 
 // sin is math.Sin for degrees.
@@ -7,11 +7,13 @@ import (
 	"fmt"
 	"image/png"
 	"io"
+	"math"
 	"os"
 	"runtime"
 	"strings"
 	"testing"
 
+	"github.com/mmcloughlin/globe/geo"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -75,8 +77,17 @@ func TestDrawDots(t *testing.T) {
 	AssertPNGMD5(t, g, "200588de765c11b6b4136b8df36a698c")
 }
 
+// ExactStyle is the default style without simplification, as used for golden
+// images that predate it.
+func ExactStyle() Style {
+	s := DefaultStyle
+	s.Tolerance = -1
+	return s
+}
+
 func TestDrawLand(t *testing.T) {
 	g := New()
+	g.SetStyle(ExactStyle())
 	g.DrawLandBoundaries()
 	g.CenterOn(51.453349, -2.588323)
 	AssertPNGMD5(t, g, "d7667370d3395cb7d987cee444e59b17")
@@ -84,6 +95,7 @@ func TestDrawLand(t *testing.T) {
 
 func TestDrawCountries(t *testing.T) {
 	g := New()
+	g.SetStyle(ExactStyle())
 	g.DrawCountryBoundaries()
 	g.CenterOn(40.645423, -73.903879)
 	AssertPNGMD5(t, g, "139333c753ce3af5077a89b3c4bd8cdb")
@@ -103,6 +115,70 @@ func TestRect(t *testing.T) {
 	AssertPNGMD5(t, g, "a1f7d08345c78e484612e211f8dc5e4b")
 }
 
+func TestRhumbLine(t *testing.T) {
+	cases := []struct {
+		A, B latlng
+	}{
+		{latlng{51.453349, -2.588323}, latlng{40.645423, -73.903879}},
+		{latlng{-33.8688, 151.2093}, latlng{21.3069, -157.8583}},
+		{latlng{85, -170}, latlng{88, 10}},
+		{latlng{90, 0}, latlng{60, 45}},
+		{latlng{60, 45}, latlng{90, 0}},
+	}
+	for _, c := range cases {
+		path := rhumbLine(c.A, c.B)
+		require.True(t, len(path) > 2)
+		end := path[len(path)-1]
+		assert.InDelta(t, 0, haversine(c.B.lat, c.B.lng, end.lat, end.lng), 1e-9)
+		a := geo.LatLng{Lat: path[0].lat, Lng: path[0].lng}
+		brng := geo.RhumbBearing(a, geo.LatLng{Lat: c.B.lat, Lng: c.B.lng})
+		for i := 1; i < len(path); i++ {
+			p, q := geo.LatLng{Lat: path[i-1].lat, Lng: path[i-1].lng}, geo.LatLng{Lat: path[i].lat, Lng: path[i].lng}
+			assert.True(t, geo.RhumbDistance(p, q) <= linePointInterval+1e-6)
+			assert.True(t, math.Abs(math.Mod(q.Lng-p.Lng+540, 360)-180) <= graticuleLineStep+1e-6)
+			assert.InDelta(t, brng, geo.RhumbBearing(a, q), 1e-6)
+		}
+	}
+
+	// Lines crossing the antimeridian go the short way around.
+	for _, p := range rhumbLine(latlng{10, 170}, latlng{20, -170}) {
+		assert.True(t, p.lng >= 170 || p.lng <= -170)
+	}
+}
+
+func TestEllipsoidalLine(t *testing.T) {
+	a, b := latlng{-41.32, 174.81}, latlng{40.96, -5.50}
+	p, q := geo.LatLng{Lat: a.lat, Lng: a.lng}, geo.LatLng{Lat: b.lat, Lng: b.lng}
+	path := geodesic(geo.WGS84, a, b)
+	assert.Equal(t, a, path[0])
+	assert.Equal(t, b, path[len(path)-1])
+	d := geo.WGS84.Distance(p, q)
+	for i := 1; i < len(path); i++ {
+		u := geo.LatLng{Lat: path[i].lat, Lng: path[i].lng}
+		assert.True(t, geo.WGS84.Distance(geo.LatLng{Lat: path[i-1].lat, Lng: path[i-1].lng}, u) <= linePointInterval+1e-6)
+		// Points lie on the geodesic.
+		assert.InDelta(t, d, geo.WGS84.Distance(p, u)+geo.WGS84.Distance(u, q), 1e-6)
+	}
+
+	// The option is passed through DrawRect to its sides.
+	g := New()
+	g.DrawLine(a.lat, a.lng, b.lat, b.lng, Ellipsoidal(geo.WGS84))
+	g.DrawLine(a.lat, a.lng, b.lat, b.lng)
+	g.DrawRect(10, 20, 30, 40, Ellipsoidal(geo.WGS84))
+	require.Len(t, g.layers, 6)
+	assert.Equal(t, path, g.layers[0].paths[0])
+	assert.Equal(t, greatCircle(a, b), g.layers[1].paths[0])
+	assert.Equal(t, geodesic(geo.WGS84, latlng{10, 20}, latlng{30, 20}), g.layers[2].paths[0])
+
+	// Geodesics on a sphere are great circles.
+	sphere := geodesic(geo.Sphere(), a, b)
+	great := greatCircle(a, b)
+	require.Len(t, sphere, len(great))
+	for i := range sphere {
+		assert.InDelta(t, 0, haversine(sphere[i].lat, sphere[i].lng, great[i].lat, great[i].lng), 1e-6)
+	}
+}
+
 func TestCartestian(t *testing.T) {
 	x, y, z := cartestian(42, -163)
 	assert.Equal(t, -0.7106729309733519, x)
@@ -182,3 +258,29 @@ func TestDestination(t *testing.T) {
 		assert.InDelta(t, c.Lng2, lng, 0.00001, "longitude error")
 	}
 }
+
+func TestRenderAt(t *testing.T) {
+	g := New()
+	g.DrawGraticule(10.0)
+	g.DrawLandBoundaries()
+	for _, c := range [][2]float64{{51.453349, -2.588323}, {40.645423, -73.903879}, {-33.9, 151.2}} {
+		m := g.RenderAt(c[0], c[1], 256)
+		h := g.Clone()
+		h.CenterOn(c[0], c[1])
+		assert.Equal(t, h.Image(256).Pix, m.Pix)
+	}
+	assert.Equal(t, DefaultCamera, g.Camera())
+}
+
+func TestClone(t *testing.T) {
+	g := New()
+	g.DrawLandBoundaries()
+	expect := g.Image(256)
+
+	c := g.Clone()
+	c.DrawGraticule(10.0)
+	c.CenterOn(10, 20)
+
+	assert.Equal(t, expect.Pix, g.Image(256).Pix)
+	assert.NotEqual(t, expect.Pix, c.Image(256).Pix)
+}
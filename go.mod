@@ -1,6 +1,6 @@
 module github.com/mmcloughlin/globe
 
-go 1.10
+go 1.16
 
 require (
 	github.com/stretchr/testify v1.7.1
@@ -8,7 +8,7 @@ require (
 )
 
 require (
-	github.com/fogleman/gg v1.3.0 // indirect
+	github.com/fogleman/gg v1.3.0
 	github.com/golang/freetype v0.0.0-20170609003504-e2365dfdc4a0 // indirect
 	github.com/google/btree v1.1.2 // indirect
 	golang.org/x/image v0.6.0 // indirect
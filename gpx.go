@@ -0,0 +1,59 @@
+package globe
+
+import (
+	"io"
+
+	"github.com/mmcloughlin/globe/gpx"
+)
+
+// DrawGPX reads a GPX document from r and draws it on the globe. Track
+// segments and routes are drawn as paths along great circles between their
+// points, and waypoints as dots. Lines are drawn before dots.
+// Uses the default LineColor and DotColor unless overridden by style Options.
+func (g *Globe) DrawGPX(r io.Reader, style ...Option) error {
+	doc, err := gpx.Decode(r)
+	if err != nil {
+		return err
+	}
+
+	var lines [][]latlng
+	for _, trk := range doc.Tracks {
+		for _, seg := range trk.Segments {
+			lines = append(lines, gpxPoints(seg))
+		}
+	}
+	for _, rte := range doc.Routes {
+		lines = append(lines, gpxPoints(rte.Points))
+	}
+
+	if len(lines) > 0 {
+		func() {
+			defer g.styled(Color(g.style.LineColor), style...)()
+			g.cur.simplify = true
+			for _, line := range lines {
+				if len(line) >= 2 {
+					g.drawPath(greatCirclePath(line))
+				}
+			}
+		}()
+	}
+
+	if len(doc.Waypoints) > 0 {
+		func() {
+			defer g.styled(Color(g.style.DotColor), style...)()
+			for _, p := range gpxPoints(doc.Waypoints) {
+				g.cur.dots = append(g.cur.dots, dot{latlng: p, radius: defaultDotRadius})
+			}
+		}()
+	}
+	return nil
+}
+
+// gpxPoints converts GPX points.
+func gpxPoints(points []gpx.Point) []latlng {
+	ps := make([]latlng, len(points))
+	for i, p := range points {
+		ps[i] = latlng{p.Lat, p.Lon}
+	}
+	return ps
+}
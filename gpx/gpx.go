@@ -0,0 +1,162 @@
+// Package gpx decodes GPS Exchange Format documents, as specified by GPX 1.1.
+// GPX 1.0 documents share the same structure for waypoints, routes and tracks,
+// and are also accepted.
+package gpx
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// GPX is a decoded GPX document.
+type GPX struct {
+	Version   string
+	Creator   string
+	Waypoints []Point
+	Routes    []Route
+	Tracks    []Track
+}
+
+// Point is a waypoint, or a point of a route or track. Lat and Lon are in
+// degrees, and Ele is the elevation in meters. Fields other than Lat and Lon
+// are zero if not given.
+type Point struct {
+	Lat  float64
+	Lon  float64
+	Ele  float64
+	Time time.Time
+	Name string
+}
+
+// Route is an ordered list of points leading to a destination.
+type Route struct {
+	Name   string
+	Points []Point
+}
+
+// Track is an ordered list of points describing a path, split into segments
+// where the recording was interrupted.
+type Track struct {
+	Name     string
+	Segments [][]Point
+}
+
+// document is the XML representation of a GPX document.
+type document struct {
+	XMLName   xml.Name `xml:"gpx"`
+	Version   string   `xml:"version,attr"`
+	Creator   string   `xml:"creator,attr"`
+	Waypoints []point  `xml:"wpt"`
+	Routes    []route  `xml:"rte"`
+	Tracks    []track  `xml:"trk"`
+}
+
+type point struct {
+	Lat  *string   `xml:"lat,attr"`
+	Lon  *string   `xml:"lon,attr"`
+	Ele  float64   `xml:"ele"`
+	Time time.Time `xml:"time"`
+	Name string    `xml:"name"`
+}
+
+type route struct {
+	Name   string  `xml:"name"`
+	Points []point `xml:"rtept"`
+}
+
+type track struct {
+	Name     string    `xml:"name"`
+	Segments []segment `xml:"trkseg"`
+}
+
+type segment struct {
+	Points []point `xml:"trkpt"`
+}
+
+// Decode reads a GPX document from r.
+func Decode(r io.Reader) (*GPX, error) {
+	var doc document
+	if err := xml.NewDecoder(r).Decode(&doc); err != nil {
+		if err == io.EOF {
+			return nil, errors.New("gpx: empty document")
+		}
+		return nil, fmt.Errorf("gpx: %s", err)
+	}
+
+	g := &GPX{
+		Version: doc.Version,
+		Creator: doc.Creator,
+	}
+	for i, p := range doc.Waypoints {
+		q, err := p.point()
+		if err != nil {
+			return nil, fmt.Errorf("gpx: waypoint %d: %s", i+1, err)
+		}
+		g.Waypoints = append(g.Waypoints, q)
+	}
+	for i, rte := range doc.Routes {
+		r := Route{Name: rte.Name}
+		for j, p := range rte.Points {
+			q, err := p.point()
+			if err != nil {
+				return nil, fmt.Errorf("gpx: route %d, point %d: %s", i+1, j+1, err)
+			}
+			r.Points = append(r.Points, q)
+		}
+		g.Routes = append(g.Routes, r)
+	}
+	for i, trk := range doc.Tracks {
+		t := Track{Name: trk.Name}
+		for j, seg := range trk.Segments {
+			var s []Point
+			for k, p := range seg.Points {
+				q, err := p.point()
+				if err != nil {
+					return nil, fmt.Errorf("gpx: track %d, segment %d, point %d: %s", i+1, j+1, k+1, err)
+				}
+				s = append(s, q)
+			}
+			t.Segments = append(t.Segments, s)
+		}
+		g.Tracks = append(g.Tracks, t)
+	}
+	return g, nil
+}
+
+// point converts p, checking that its coordinates are given and in range.
+func (p point) point() (Point, error) {
+	lat, err := coordinate("lat", p.Lat, 90)
+	if err != nil {
+		return Point{}, err
+	}
+	lon, err := coordinate("lon", p.Lon, 180)
+	if err != nil {
+		return Point{}, err
+	}
+	return Point{
+		Lat:  lat,
+		Lon:  lon,
+		Ele:  p.Ele,
+		Time: p.Time,
+		Name: p.Name,
+	}, nil
+}
+
+// coordinate parses the coordinate attribute with the given name and value,
+// which must lie within [-limit, limit].
+func coordinate(name string, value *string, limit float64) (float64, error) {
+	if value == nil {
+		return 0, fmt.Errorf("missing %s attribute", name)
+	}
+	x, err := strconv.ParseFloat(strings.TrimSpace(*value), 64)
+	if err != nil || math.IsNaN(x) || x < -limit || x > limit {
+		return 0, fmt.Errorf("invalid %s %q", name, *value)
+	}
+	return x, nil
+}
@@ -0,0 +1,82 @@
+package gpx
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testGPX = `<?xml version="1.0" encoding="UTF-8"?>
+<gpx version="1.1" creator="test" xmlns="http://www.topografix.com/GPX/1/1">
+	<wpt lat="51.453349" lon="-2.588323">
+		<ele>11.5</ele>
+		<name>Bristol</name>
+	</wpt>
+	<wpt lat="40.645423" lon="-73.903879"/>
+	<rte>
+		<name>Crossing</name>
+		<rtept lat="51.453349" lon="-2.588323"/>
+		<rtept lat="40.645423" lon="-73.903879"/>
+	</rte>
+	<trk>
+		<name>Walk</name>
+		<trkseg>
+			<trkpt lat="51.4500" lon="-2.5900"><time>2020-05-01T10:00:00Z</time></trkpt>
+			<trkpt lat="51.4510" lon="-2.5880"><time>2020-05-01T10:05:00Z</time></trkpt>
+		</trkseg>
+		<trkseg>
+			<trkpt lat="51.4600" lon="-2.5800"/>
+		</trkseg>
+	</trk>
+</gpx>`
+
+func TestDecode(t *testing.T) {
+	g, err := Decode(strings.NewReader(testGPX))
+	require.NoError(t, err)
+	assert.Equal(t, "1.1", g.Version)
+	assert.Equal(t, "test", g.Creator)
+
+	require.Len(t, g.Waypoints, 2)
+	assert.Equal(t, Point{Lat: 51.453349, Lon: -2.588323, Ele: 11.5, Name: "Bristol"}, g.Waypoints[0])
+	assert.Equal(t, Point{Lat: 40.645423, Lon: -73.903879}, g.Waypoints[1])
+
+	require.Len(t, g.Routes, 1)
+	assert.Equal(t, "Crossing", g.Routes[0].Name)
+	assert.Len(t, g.Routes[0].Points, 2)
+
+	require.Len(t, g.Tracks, 1)
+	trk := g.Tracks[0]
+	assert.Equal(t, "Walk", trk.Name)
+	require.Len(t, trk.Segments, 2)
+	require.Len(t, trk.Segments[0], 2)
+	assert.Len(t, trk.Segments[1], 1)
+	p := trk.Segments[0][1]
+	assert.Equal(t, 51.451, p.Lat)
+	assert.Equal(t, -2.588, p.Lon)
+	assert.Equal(t, time.Date(2020, 5, 1, 10, 5, 0, 0, time.UTC), p.Time)
+}
+
+func TestDecodeErrors(t *testing.T) {
+	cases := []struct {
+		Name  string
+		Input string
+		Error string
+	}{
+		{"Empty", ``, "gpx: empty document"},
+		{"Malformed", `<gpx><trk></gpx>`, "gpx: XML syntax error on line 1: element <trk> closed by </gpx>"},
+		{"Root", `<kml></kml>`, "gpx: expected element type <gpx> but have <kml>"},
+		{"MissingLat", `<gpx><wpt lon="1"/></gpx>`, "gpx: waypoint 1: missing lat attribute"},
+		{"InvalidLon", `<gpx><rte><rtept lat="1" lon="1"/><rtept lat="1" lon="east"/></rte></gpx>`, `gpx: route 1, point 2: invalid lon "east"`},
+		{"LatRange", `<gpx><trk><trkseg><trkpt lat="91" lon="1"/></trkseg></trk></gpx>`, `gpx: track 1, segment 1, point 1: invalid lat "91"`},
+		{"Time", `<gpx><wpt lat="1" lon="1"><time>yesterday</time></wpt></gpx>`, `gpx: parsing time "yesterday" as "2006-01-02T15:04:05Z07:00": cannot parse "yesterday" as "2006"`},
+	}
+	for _, c := range cases {
+		t.Run(c.Name, func(t *testing.T) {
+			_, err := Decode(strings.NewReader(c.Input))
+			assert.EqualError(t, err, c.Error)
+		})
+	}
+}
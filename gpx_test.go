@@ -0,0 +1,82 @@
+package globe
+
+import (
+	"image/color"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testGPX = `<?xml version="1.0" encoding="UTF-8"?>
+<gpx version="1.1" creator="test" xmlns="http://www.topografix.com/GPX/1/1">
+	<wpt lat="51.453349" lon="-2.588323"><name>Bristol</name></wpt>
+	<wpt lat="40.645423" lon="-73.903879"><name>New York</name></wpt>
+	<rte>
+		<rtept lat="51.453349" lon="-2.588323"/>
+		<rtept lat="40.645423" lon="-73.903879"/>
+	</rte>
+	<trk>
+		<trkseg>
+			<trkpt lat="40.645423" lon="-73.903879"/>
+			<trkpt lat="39.9526" lon="-75.1652"/>
+			<trkpt lat="38.9072" lon="-77.0369"/>
+		</trkseg>
+		<trkseg>
+			<trkpt lat="36.1627" lon="-86.7816"/>
+			<trkpt lat="35.1495" lon="-90.0490"/>
+		</trkseg>
+	</trk>
+</gpx>`
+
+func TestDrawGPX(t *testing.T) {
+	g := New()
+	err := g.DrawGPX(strings.NewReader(testGPX))
+	require.NoError(t, err)
+	require.Len(t, g.layers, 2)
+
+	lines := g.layers[0]
+	assert.Equal(t, DefaultStyle.LineColor, lines.color)
+	require.Len(t, lines.paths, 3)
+	// Track segments come first, then routes.
+	assert.Equal(t, latlng{40.645423, -73.903879}, lines.paths[0][0])
+	assert.Equal(t, latlng{35.1495, -90.0490}, lines.paths[1][len(lines.paths[1])-1])
+	route := lines.paths[2]
+	assert.Equal(t, latlng{51.453349, -2.588323}, route[0])
+	assert.Equal(t, latlng{40.645423, -73.903879}, route[len(route)-1])
+	assert.True(t, len(route) > 2)
+
+	dots := g.layers[1]
+	assert.Equal(t, DefaultStyle.DotColor, dots.color)
+	require.Len(t, dots.dots, 2)
+	assert.Equal(t, defaultDotRadius, dots.dots[0].radius)
+}
+
+func TestDrawGPXStyle(t *testing.T) {
+	g := New()
+	blue := color.NRGBA{0, 0, 255, 255}
+	err := g.DrawGPX(strings.NewReader(testGPX), Color(blue), Radius(0.1))
+	require.NoError(t, err)
+	for _, l := range g.layers {
+		assert.Equal(t, blue, l.color)
+	}
+	assert.Equal(t, 0.1, g.layers[1].dots[0].radius)
+}
+
+func TestDrawGPXError(t *testing.T) {
+	g := New()
+	err := g.DrawGPX(strings.NewReader(`<gpx><wpt lat="1"/></gpx>`))
+	assert.EqualError(t, err, "gpx: waypoint 1: missing lon attribute")
+	err = g.DrawGPX(strings.NewReader(testGeoJSON))
+	assert.Error(t, err)
+	assert.Empty(t, g.layers)
+}
+
+func TestSVGDrawGPX(t *testing.T) {
+	g := New()
+	g.DrawGraticule(10)
+	require.NoError(t, g.DrawGPX(strings.NewReader(testGPX)))
+	g.CenterOn(45, -45)
+	AssertSVGMD5(t, g, "ce8a6a141986ebf8eda95ef36aa4ebc1")
+}
@@ -0,0 +1,181 @@
+// Package geodata implements the compact binary encoding of the geodata
+// embedded in the globe package.
+//
+// An encoding starts with the magic string "GEO" and a format version byte,
+// followed by a uvarint count of features, each of which is four strings (id,
+// alpha-2 code, alpha-3 code and name) and a list of rings. Strings are a
+// uvarint length followed by the bytes, and lists a uvarint length followed by
+// the elements. A ring is a list of points.
+//
+// Points are encoded as the varint difference of their latitude and longitude
+// from the previous point. Coordinates are float32, mapped to integers that
+// preserve their order so that nearby coordinates have small differences and
+// decoding is exact. The result is dense enough that general purpose
+// compression gains little, so it is not applied.
+package geodata
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"io"
+	"math"
+)
+
+// header identifies the encoding and its format version.
+const header = "GEO\x01"
+
+// Point is a location in degrees.
+type Point struct {
+	Lat, Lng float32
+}
+
+// Feature is a set of rings with identifying properties, which may be empty.
+type Feature struct {
+	ID     string
+	Alpha2 string
+	Alpha3 string
+	Name   string
+	Rings  [][]Point
+}
+
+// Encode writes the encoding of fs to w.
+func Encode(w io.Writer, fs []Feature) error {
+	e := &encoder{}
+	e.w.WriteString(header)
+	e.uvarint(uint64(len(fs)))
+	for _, f := range fs {
+		for _, s := range []string{f.ID, f.Alpha2, f.Alpha3, f.Name} {
+			e.uvarint(uint64(len(s)))
+			e.w.WriteString(s)
+		}
+		e.uvarint(uint64(len(f.Rings)))
+		for _, ring := range f.Rings {
+			e.uvarint(uint64(len(ring)))
+			for _, p := range ring {
+				e.point(p)
+			}
+		}
+	}
+	_, err := e.w.WriteTo(w)
+	return err
+}
+
+// encoder buffers an encoding.
+type encoder struct {
+	w    bytes.Buffer
+	buf  [binary.MaxVarintLen64]byte
+	prev [2]int64
+}
+
+func (e *encoder) uvarint(x uint64) {
+	n := binary.PutUvarint(e.buf[:], x)
+	e.w.Write(e.buf[:n])
+}
+
+func (e *encoder) point(p Point) {
+	for i, c := range [2]float32{p.Lat, p.Lng} {
+		x := ordered(c)
+		n := binary.PutVarint(e.buf[:], x-e.prev[i])
+		e.w.Write(e.buf[:n])
+		e.prev[i] = x
+	}
+}
+
+// errCorrupt is returned when decoding invalid data.
+var errCorrupt = errors.New("geodata: corrupt data")
+
+// Decode decodes the encoded features b.
+func Decode(b []byte) ([]Feature, error) {
+	if !bytes.HasPrefix(b, []byte(header)) {
+		return nil, errors.New("geodata: unknown format")
+	}
+
+	d := &decoder{b: b[len(header):]}
+	fs := make([]Feature, d.length())
+	for i := range fs {
+		f := &fs[i]
+		for _, s := range []*string{&f.ID, &f.Alpha2, &f.Alpha3, &f.Name} {
+			*s = string(d.bytes(d.length()))
+		}
+		f.Rings = make([][]Point, d.length())
+		for j := range f.Rings {
+			ring := make([]Point, d.length())
+			for k := range ring {
+				ring[k] = d.point()
+			}
+			f.Rings[j] = ring
+		}
+	}
+	if d.err == nil && len(d.b) > 0 {
+		d.err = errCorrupt
+	}
+	if d.err != nil {
+		return nil, d.err
+	}
+	return fs, nil
+}
+
+// decoder reads an encoding. After an error, all reads return
+// zero values.
+type decoder struct {
+	b    []byte
+	prev [2]int64
+	err  error
+}
+
+func (d *decoder) fail() {
+	d.b = nil
+	d.err = errCorrupt
+}
+
+// length reads a list or string length. Every element takes at least one
+// byte, so lengths greater than the remaining data are invalid.
+func (d *decoder) length() int {
+	x, n := binary.Uvarint(d.b)
+	if n <= 0 || x > uint64(len(d.b)-n) {
+		d.fail()
+		return 0
+	}
+	d.b = d.b[n:]
+	return int(x)
+}
+
+func (d *decoder) bytes(n int) []byte {
+	b := d.b[:n]
+	d.b = d.b[n:]
+	return b
+}
+
+func (d *decoder) point() Point {
+	var c [2]float32
+	for i := range c {
+		x, n := binary.Varint(d.b)
+		if n <= 0 {
+			d.fail()
+			return Point{}
+		}
+		d.b = d.b[n:]
+		d.prev[i] += x
+		c[i] = unordered(d.prev[i])
+	}
+	return Point{Lat: c[0], Lng: c[1]}
+}
+
+// ordered maps f to an integer, such that the order of floats is preserved.
+func ordered(f float32) int64 {
+	b := int32(math.Float32bits(f))
+	if b < 0 {
+		b ^= math.MaxInt32
+	}
+	return int64(b)
+}
+
+// unordered is the inverse of ordered.
+func unordered(x int64) float32 {
+	b := int32(x)
+	if b < 0 {
+		b ^= math.MaxInt32
+	}
+	return math.Float32frombits(uint32(b))
+}
@@ -0,0 +1,78 @@
+package geodata
+
+import (
+	"bytes"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRoundTrip(t *testing.T) {
+	fs := []Feature{
+		{
+			ID:     "250",
+			Alpha2: "FR",
+			Alpha3: "FRA",
+			Name:   "France",
+			Rings: [][]Point{
+				{{49.5, 2.25}, {48.85, 2.35}, {-0.5, 0}, {0.1, -179.99}},
+				{{1e-30, -1e-30}, {90, 180}},
+			},
+		},
+		{Rings: [][]Point{{}}},
+		{Name: "Empty"},
+	}
+	var buf bytes.Buffer
+	require.NoError(t, Encode(&buf, fs))
+
+	got, err := Decode(buf.Bytes())
+	require.NoError(t, err)
+	require.Len(t, got, len(fs))
+	for i := range fs {
+		assert.Equal(t, fs[i].ID, got[i].ID)
+		assert.Equal(t, fs[i].Alpha2, got[i].Alpha2)
+		assert.Equal(t, fs[i].Alpha3, got[i].Alpha3)
+		assert.Equal(t, fs[i].Name, got[i].Name)
+		assert.Equal(t, len(fs[i].Rings), len(got[i].Rings))
+		for j := range fs[i].Rings {
+			assert.Equal(t, len(fs[i].Rings[j]), len(got[i].Rings[j]))
+			for k, p := range fs[i].Rings[j] {
+				assert.Equal(t, p, got[i].Rings[j][k])
+			}
+		}
+	}
+}
+
+func TestOrdered(t *testing.T) {
+	fs := []float32{
+		float32(math.Inf(-1)), -180, -1, -1e-30, 0, 1e-30, 1, 179.99998, 180,
+		float32(math.Inf(1)),
+	}
+	for i, f := range fs {
+		assert.Equal(t, f, unordered(ordered(f)))
+		if i > 0 {
+			assert.True(t, ordered(fs[i-1]) < ordered(f), "%v < %v", fs[i-1], f)
+		}
+	}
+	negzero := float32(math.Copysign(0, -1))
+	assert.Equal(t, math.Float32bits(negzero), math.Float32bits(unordered(ordered(negzero))))
+}
+
+func TestDecodeErrors(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, Encode(&buf, []Feature{{Name: "A", Rings: [][]Point{{{1, 2}, {3, 4}}}}}))
+	b := buf.Bytes()
+
+	_, err := Decode([]byte("GEO\x02"))
+	assert.EqualError(t, err, "geodata: unknown format")
+
+	for n := len(header); n < len(b); n++ {
+		_, err := Decode(b[:n])
+		assert.Equal(t, errCorrupt, err, "truncated to %d bytes", n)
+	}
+
+	_, err = Decode(append(b, 0))
+	assert.Equal(t, errCorrupt, err)
+}
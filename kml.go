@@ -0,0 +1,154 @@
+package globe
+
+import (
+	"image/color"
+	"io"
+
+	"github.com/mmcloughlin/globe/kml"
+)
+
+// DrawKML reads a KML document, or a KMZ archive, from r and draws it on the
+// globe, as in DrawKMLFolder.
+func (g *Globe) DrawKML(r io.Reader, style ...Option) error {
+	f, err := kml.Decode(r)
+	if err != nil {
+		return err
+	}
+	g.DrawKMLFolder(f, style...)
+	return nil
+}
+
+// DrawKMLFolder draws the geometries of the placemarks in f and its nested
+// folders. Points are drawn as dots, lines along great circles between their
+// positions, and polygons filled and outlined. Polygons are drawn first, each
+// as a separate layer, then lines and dots in a layer for each color.
+// Placemarks are drawn in the colors of their LineStyle, PolyStyle and
+// IconStyle, and the default LineColor, FillColor and DotColor otherwise,
+// unless overridden by style Options.
+func (g *Globe) DrawKMLFolder(f *kml.Folder, style ...Option) {
+	var s kmlShapes
+	for _, p := range f.AllPlacemarks() {
+		if p.Geometry != nil {
+			s.add(p.Geometry, p.Style)
+		}
+	}
+
+	for _, polygon := range s.polygons {
+		g.drawPolygon(polygon.rings, append(kmlPolygonStyle(polygon.style), style...)...)
+	}
+
+	for _, group := range s.lines {
+		func() {
+			defer g.styled(Color(g.style.LineColor), append(kmlColor(group.color), style...)...)()
+			g.cur.simplify = true
+			for _, line := range group.lines {
+				g.drawPath(greatCirclePath(line))
+			}
+		}()
+	}
+
+	for _, group := range s.points {
+		func() {
+			defer g.styled(Color(g.style.DotColor), append(kmlColor(group.color), style...)...)()
+			for _, p := range group.points {
+				g.cur.dots = append(g.cur.dots, dot{latlng: p, radius: defaultDotRadius})
+			}
+		}()
+	}
+}
+
+// kmlPolygonStyle returns Options drawing polygons in the KML style s.
+func kmlPolygonStyle(s kml.Style) []Option {
+	var opts []Option
+	if s.LineColor != nil {
+		opts = append(opts, Color(s.LineColor))
+	}
+	if s.PolyColor != nil {
+		opts = append(opts, FillColor(s.PolyColor))
+	}
+	if s.NoOutline {
+		opts = append(opts, Color(nil))
+	}
+	if s.NoFill {
+		opts = append(opts, FillColor(nil))
+	}
+	return opts
+}
+
+// kmlColor returns Options drawing in KML color c, if it is set.
+func kmlColor(c color.Color) []Option {
+	if c == nil {
+		return nil
+	}
+	return []Option{Color(c)}
+}
+
+// kmlShapes collects the shapes in KML geometries, grouping lines and points
+// by color.
+type kmlShapes struct {
+	polygons []kmlPolygon
+	lines    []*kmlGroup
+	points   []*kmlGroup
+}
+
+// kmlPolygon is a polygon with the style of its placemark.
+type kmlPolygon struct {
+	rings [][]latlng
+	style kml.Style
+}
+
+// kmlGroup is a group of lines or points of the same color, or of no color.
+type kmlGroup struct {
+	color  color.Color
+	lines  [][]latlng
+	points []latlng
+}
+
+// add adds the shapes in geometry geom, of a placemark with style s.
+func (s *kmlShapes) add(geom *kml.Geometry, style kml.Style) {
+	switch geom.Type {
+	case kml.Point:
+		g := kmlGroupFor(&s.points, style.IconColor)
+		g.points = append(g.points, kmlCoordinate(geom.Point))
+	case kml.LineString, kml.LinearRing:
+		g := kmlGroupFor(&s.lines, style.LineColor)
+		g.lines = append(g.lines, kmlCoordinates(geom.Line))
+	case kml.Polygon:
+		var rings [][]latlng
+		for _, ring := range geom.Polygon {
+			rings = append(rings, kmlCoordinates(ring))
+		}
+		s.polygons = append(s.polygons, kmlPolygon{rings: rings, style: style})
+	case kml.MultiGeometry:
+		for _, child := range geom.Geometries {
+			s.add(child, style)
+		}
+	}
+}
+
+// kmlGroupFor returns the group in groups with color c, adding it if there is
+// none.
+func kmlGroupFor(groups *[]*kmlGroup, c color.Color) *kmlGroup {
+	for _, g := range *groups {
+		if g.color == c {
+			return g
+		}
+	}
+	g := &kmlGroup{color: c}
+	*groups = append(*groups, g)
+	return g
+}
+
+// kmlCoordinate converts a KML coordinate.
+func kmlCoordinate(c kml.Coordinate) latlng {
+	return latlng{c.Lat, c.Lng}
+}
+
+// kmlCoordinates converts a list of KML coordinates.
+func kmlCoordinates(cs []kml.Coordinate) []latlng {
+	ps := make([]latlng, len(cs))
+	for i, c := range cs {
+		ps[i] = kmlCoordinate(c)
+	}
+	return ps
+}
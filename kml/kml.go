@@ -0,0 +1,438 @@
+// Package kml decodes Keyhole Markup Language documents, and KMZ archives
+// containing them, as specified by OGC KML 2.2.
+package kml
+
+import (
+	"archive/zip"
+	"bufio"
+	"bytes"
+	"encoding/hex"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"image/color"
+	"io"
+	"io/ioutil"
+	"path"
+	"strconv"
+	"strings"
+)
+
+// GeometryType is the type of a geometry element.
+type GeometryType string
+
+// Supported geometry types.
+const (
+	Point         GeometryType = "Point"
+	LineString    GeometryType = "LineString"
+	LinearRing    GeometryType = "LinearRing"
+	Polygon       GeometryType = "Polygon"
+	MultiGeometry GeometryType = "MultiGeometry"
+)
+
+// Folder is a container of placemarks and nested folders. Document elements
+// are decoded as folders.
+type Folder struct {
+	Name       string
+	Placemarks []*Placemark
+	Folders    []*Folder
+}
+
+// Placemark is a named geometry, drawn with a style.
+type Placemark struct {
+	Name     string
+	Geometry *Geometry
+	Style    Style
+}
+
+// Geometry is a KML geometry. Only the fields corresponding to the geometry
+// type are set: Point for points, Line for line strings and linear rings,
+// Polygon for polygons, whose first ring is the outer boundary and the rest
+// holes, and Geometries for multi-geometries.
+type Geometry struct {
+	Type GeometryType
+
+	Point      Coordinate
+	Line       []Coordinate
+	Polygon    [][]Coordinate
+	Geometries []*Geometry
+}
+
+// Coordinate is a position in degrees, with altitude in meters.
+type Coordinate struct {
+	Lat, Lng, Alt float64
+}
+
+// Style is the style a placemark is drawn with, resolved from its shared and
+// inline styles. Colors are nil if not given.
+type Style struct {
+	LineColor color.Color
+	PolyColor color.Color
+	IconColor color.Color
+
+	// NoFill and NoOutline are set if polygons are not filled or not
+	// outlined.
+	NoFill    bool
+	NoOutline bool
+}
+
+// AllPlacemarks returns the placemarks in f and the folders nested in it, in
+// depth-first order.
+func (f *Folder) AllPlacemarks() []*Placemark {
+	ps := append([]*Placemark(nil), f.Placemarks...)
+	for _, child := range f.Folders {
+		ps = append(ps, child.AllPlacemarks()...)
+	}
+	return ps
+}
+
+// zipMagic is the start of the signatures of zip archives, which a KML
+// document cannot start with.
+var zipMagic = []byte("PK")
+
+// Decode reads a KML document, or a KMZ archive, from r. The returned folder
+// holds the contents of the kml element. The main document of a KMZ archive
+// is doc.kml, or else the first file with a .kml extension.
+func Decode(r io.Reader) (*Folder, error) {
+	br := bufio.NewReader(r)
+	magic, _ := br.Peek(len(zipMagic))
+	if !bytes.Equal(magic, zipMagic) {
+		return decodeKML(br)
+	}
+
+	b, err := ioutil.ReadAll(br)
+	if err != nil {
+		return nil, err
+	}
+	z, err := zip.NewReader(bytes.NewReader(b), int64(len(b)))
+	if err != nil {
+		return nil, fmt.Errorf("kml: kmz: %s", err)
+	}
+	var main *zip.File
+	for _, f := range z.File {
+		if f.Name == "doc.kml" {
+			main = f
+			break
+		}
+		if main == nil && strings.EqualFold(path.Ext(f.Name), ".kml") {
+			main = f
+		}
+	}
+	if main == nil {
+		return nil, errors.New("kml: kmz archive contains no kml document")
+	}
+	rc, err := main.Open()
+	if err != nil {
+		return nil, fmt.Errorf("kml: kmz: %s", err)
+	}
+	defer rc.Close()
+	return decodeKML(rc)
+}
+
+// decodeKML reads a KML document from r.
+func decodeKML(r io.Reader) (*Folder, error) {
+	var doc document
+	if err := xml.NewDecoder(r).Decode(&doc); err != nil {
+		if err == io.EOF {
+			return nil, errors.New("kml: empty document")
+		}
+		return nil, fmt.Errorf("kml: %s", err)
+	}
+
+	// Shared styles may be referenced from anywhere in the document.
+	styles := map[string]*style{}
+	maps := map[string]string{}
+	doc.walk(func(c *container) {
+		for i := range c.Styles {
+			s := &c.Styles[i]
+			if s.ID != "" {
+				styles[s.ID] = s
+			}
+		}
+		for _, m := range c.StyleMaps {
+			for _, pair := range m.Pairs {
+				if pair.Key == "normal" {
+					maps[m.ID] = pair.StyleURL
+				}
+			}
+		}
+	})
+	resolve := func(url string) *style {
+		id, ok := localID(url)
+		if !ok {
+			return nil
+		}
+		if s, ok := styles[id]; ok {
+			return s
+		}
+		if id, ok = localID(maps[id]); ok {
+			return styles[id]
+		}
+		return nil
+	}
+
+	return doc.folder(resolve)
+}
+
+// localID returns the id referenced by a style URL within the document.
+// References to other documents are not supported.
+func localID(url string) (string, bool) {
+	url = strings.TrimSpace(url)
+	if !strings.HasPrefix(url, "#") {
+		return "", false
+	}
+	return url[1:], true
+}
+
+// document is the XML representation of a kml element.
+type document struct {
+	XMLName xml.Name `xml:"kml"`
+	container
+}
+
+// container is the XML representation of the Document and Folder elements,
+// and of the kml element, which may hold the same features.
+type container struct {
+	Name       string      `xml:"name"`
+	Styles     []style     `xml:"Style"`
+	StyleMaps  []styleMap  `xml:"StyleMap"`
+	Documents  []container `xml:"Document"`
+	Folders    []container `xml:"Folder"`
+	Placemarks []placemark `xml:"Placemark"`
+}
+
+// walk calls fn for c and every container nested in it.
+func (c *container) walk(fn func(*container)) {
+	fn(c)
+	for i := range c.Documents {
+		c.Documents[i].walk(fn)
+	}
+	for i := range c.Folders {
+		c.Folders[i].walk(fn)
+	}
+}
+
+// folder converts c, resolving shared styles with resolve.
+func (c *container) folder(resolve func(url string) *style) (*Folder, error) {
+	f := &Folder{Name: strings.TrimSpace(c.Name)}
+	for _, children := range [][]container{c.Documents, c.Folders} {
+		for i := range children {
+			child, err := children[i].folder(resolve)
+			if err != nil {
+				return nil, err
+			}
+			f.Folders = append(f.Folders, child)
+		}
+	}
+	for i := range c.Placemarks {
+		p, err := c.Placemarks[i].placemark(resolve)
+		if err != nil {
+			return nil, err
+		}
+		f.Placemarks = append(f.Placemarks, p)
+	}
+	return f, nil
+}
+
+// placemark is the XML representation of a Placemark element.
+type placemark struct {
+	Name     string `xml:"name"`
+	StyleURL string `xml:"styleUrl"`
+	Style    *style `xml:"Style"`
+	geometries
+}
+
+// geometries holds the geometry elements of a placemark or multi-geometry.
+type geometries struct {
+	Points          []coordinated `xml:"Point"`
+	LineStrings     []coordinated `xml:"LineString"`
+	LinearRings     []coordinated `xml:"LinearRing"`
+	Polygons        []polygon     `xml:"Polygon"`
+	MultiGeometries []geometries  `xml:"MultiGeometry"`
+}
+
+// coordinated is the XML representation of a geometry given by a list of
+// coordinates.
+type coordinated struct {
+	Coordinates string `xml:"coordinates"`
+}
+
+// polygon is the XML representation of a Polygon element.
+type polygon struct {
+	Outer string   `xml:"outerBoundaryIs>LinearRing>coordinates"`
+	Inner []string `xml:"innerBoundaryIs>LinearRing>coordinates"`
+}
+
+// placemark converts p, resolving its shared style with resolve.
+func (p *placemark) placemark(resolve func(url string) *style) (*Placemark, error) {
+	name := strings.TrimSpace(p.Name)
+	gs, err := p.geometries.convert()
+	if err != nil {
+		return nil, fmt.Errorf("kml: placemark %q: %s", name, err)
+	}
+	s, err := mergeStyles(resolve(p.StyleURL), p.Style)
+	if err != nil {
+		return nil, fmt.Errorf("kml: placemark %q: %s", name, err)
+	}
+
+	pm := &Placemark{Name: name, Style: s}
+	switch len(gs) {
+	case 0:
+	case 1:
+		pm.Geometry = gs[0]
+	default:
+		pm.Geometry = &Geometry{Type: MultiGeometry, Geometries: gs}
+	}
+	return pm, nil
+}
+
+// convert converts the geometries in gs.
+func (gs *geometries) convert() ([]*Geometry, error) {
+	var out []*Geometry
+	for _, p := range gs.Points {
+		cs, err := coordinates(p.Coordinates)
+		if err != nil {
+			return nil, err
+		}
+		if len(cs) != 1 {
+			return nil, fmt.Errorf("point has %d coordinates", len(cs))
+		}
+		out = append(out, &Geometry{Type: Point, Point: cs[0]})
+	}
+	for _, lines := range []struct {
+		Type  GeometryType
+		Lines []coordinated
+	}{
+		{LineString, gs.LineStrings},
+		{LinearRing, gs.LinearRings},
+	} {
+		for _, l := range lines.Lines {
+			cs, err := coordinates(l.Coordinates)
+			if err != nil {
+				return nil, err
+			}
+			out = append(out, &Geometry{Type: lines.Type, Line: cs})
+		}
+	}
+	for _, p := range gs.Polygons {
+		outer, err := coordinates(p.Outer)
+		if err != nil {
+			return nil, err
+		}
+		if len(outer) == 0 {
+			return nil, errors.New("polygon has no outer boundary")
+		}
+		rings := [][]Coordinate{outer}
+		for _, inner := range p.Inner {
+			cs, err := coordinates(inner)
+			if err != nil {
+				return nil, err
+			}
+			rings = append(rings, cs)
+		}
+		out = append(out, &Geometry{Type: Polygon, Polygon: rings})
+	}
+	for _, m := range gs.MultiGeometries {
+		children, err := m.convert()
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, &Geometry{Type: MultiGeometry, Geometries: children})
+	}
+	return out, nil
+}
+
+// coordinates parses a list of whitespace separated longitude,latitude and
+// optional altitude tuples.
+func coordinates(s string) ([]Coordinate, error) {
+	var cs []Coordinate
+	for _, tuple := range strings.Fields(s) {
+		parts := strings.Split(tuple, ",")
+		if len(parts) < 2 || len(parts) > 3 {
+			return nil, fmt.Errorf("invalid coordinate %q", tuple)
+		}
+		var x [3]float64
+		for i, part := range parts {
+			v, err := strconv.ParseFloat(part, 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid coordinate %q", tuple)
+			}
+			x[i] = v
+		}
+		if x[0] < -180 || x[0] > 180 || x[1] < -90 || x[1] > 90 {
+			return nil, fmt.Errorf("coordinate %q out of range", tuple)
+		}
+		cs = append(cs, Coordinate{Lat: x[1], Lng: x[0], Alt: x[2]})
+	}
+	return cs, nil
+}
+
+// style is the XML representation of a Style element. Fields not given are
+// empty.
+type style struct {
+	ID          string `xml:"id,attr"`
+	LineColor   string `xml:"LineStyle>color"`
+	PolyColor   string `xml:"PolyStyle>color"`
+	PolyFill    string `xml:"PolyStyle>fill"`
+	PolyOutline string `xml:"PolyStyle>outline"`
+	IconColor   string `xml:"IconStyle>color"`
+}
+
+// styleMap is the XML representation of a StyleMap element.
+type styleMap struct {
+	ID    string `xml:"id,attr"`
+	Pairs []struct {
+		Key      string `xml:"key"`
+		StyleURL string `xml:"styleUrl"`
+	} `xml:"Pair"`
+}
+
+// mergeStyles resolves the style of a placemark with the given shared and
+// inline styles, either of which may be nil. Inline settings take precedence.
+func mergeStyles(styles ...*style) (Style, error) {
+	var s Style
+	for _, x := range styles {
+		if x == nil {
+			continue
+		}
+		for _, c := range []struct {
+			Value string
+			Color *color.Color
+		}{
+			{x.LineColor, &s.LineColor},
+			{x.PolyColor, &s.PolyColor},
+			{x.IconColor, &s.IconColor},
+		} {
+			if c.Value == "" {
+				continue
+			}
+			v, err := parseColor(c.Value)
+			if err != nil {
+				return Style{}, err
+			}
+			*c.Color = v
+		}
+		if x.PolyFill != "" {
+			s.NoFill = !parseBool(x.PolyFill)
+		}
+		if x.PolyOutline != "" {
+			s.NoOutline = !parseBool(x.PolyOutline)
+		}
+	}
+	return s, nil
+}
+
+// parseColor parses a KML color, which is hexadecimal in aabbggrr order.
+func parseColor(s string) (color.Color, error) {
+	b, err := hex.DecodeString(strings.TrimPrefix(strings.TrimSpace(s), "#"))
+	if err != nil || len(b) != 4 {
+		return nil, fmt.Errorf("invalid color %q", s)
+	}
+	return color.NRGBA{R: b[3], G: b[2], B: b[1], A: b[0]}, nil
+}
+
+// parseBool parses a KML boolean, which is 1 or true.
+func parseBool(s string) bool {
+	s = strings.TrimSpace(s)
+	return s == "1" || s == "true"
+}
@@ -0,0 +1,150 @@
+package kml
+
+import (
+	"archive/zip"
+	"bytes"
+	"image/color"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testKML = `<?xml version="1.0" encoding="UTF-8"?>
+<kml xmlns="http://www.opengis.net/kml/2.2">
+<Document>
+	<name>Partners</name>
+	<Style id="red">
+		<LineStyle><color>ff0000ff</color></LineStyle>
+		<PolyStyle><color>7f00ff00</color><outline>0</outline></PolyStyle>
+	</Style>
+	<StyleMap id="highlight">
+		<Pair><key>normal</key><styleUrl>#red</styleUrl></Pair>
+		<Pair><key>highlight</key><styleUrl>#blue</styleUrl></Pair>
+	</StyleMap>
+	<Placemark>
+		<name>Office</name>
+		<Style><IconStyle><color>ffff0000</color></IconStyle></Style>
+		<Point><coordinates>-2.588323,51.453349,10</coordinates></Point>
+	</Placemark>
+	<Folder>
+		<name>Regions</name>
+		<Folder>
+			<name>Nested</name>
+			<Placemark>
+				<name>Area</name>
+				<styleUrl>#highlight</styleUrl>
+				<Polygon>
+					<outerBoundaryIs><LinearRing><coordinates>
+						0,0 10,0 10,10 0,10 0,0
+					</coordinates></LinearRing></outerBoundaryIs>
+					<innerBoundaryIs><LinearRing><coordinates>2,2 4,2 4,4 2,2</coordinates></LinearRing></innerBoundaryIs>
+					<innerBoundaryIs><LinearRing><coordinates>6,6 8,6 8,8 6,6</coordinates></LinearRing></innerBoundaryIs>
+				</Polygon>
+			</Placemark>
+		</Folder>
+		<Placemark>
+			<name>Network</name>
+			<styleUrl>#red</styleUrl>
+			<Style><PolyStyle><fill>0</fill></PolyStyle></Style>
+			<MultiGeometry>
+				<LineString><coordinates>0,0 1,1</coordinates></LineString>
+				<MultiGeometry>
+					<Point><coordinates>1,1</coordinates></Point>
+				</MultiGeometry>
+			</MultiGeometry>
+		</Placemark>
+	</Folder>
+</Document>
+</kml>`
+
+func TestDecode(t *testing.T) {
+	root, err := Decode(strings.NewReader(testKML))
+	require.NoError(t, err)
+	require.Len(t, root.Folders, 1)
+	doc := root.Folders[0]
+	assert.Equal(t, "Partners", doc.Name)
+	require.Len(t, doc.Folders, 1)
+	assert.Equal(t, "Regions", doc.Folders[0].Name)
+	assert.Equal(t, "Nested", doc.Folders[0].Folders[0].Name)
+
+	ps := root.AllPlacemarks()
+	require.Len(t, ps, 3)
+
+	office := ps[0]
+	assert.Equal(t, "Office", office.Name)
+	assert.Equal(t, &Geometry{Type: Point, Point: Coordinate{51.453349, -2.588323, 10}}, office.Geometry)
+	assert.Equal(t, Style{IconColor: color.NRGBA{0, 0, 255, 255}}, office.Style)
+
+	network := ps[1]
+	assert.Equal(t, "Network", network.Name)
+	g := network.Geometry
+	assert.Equal(t, MultiGeometry, g.Type)
+	require.Len(t, g.Geometries, 2)
+	assert.Equal(t, []Coordinate{{0, 0, 0}, {1, 1, 0}}, g.Geometries[0].Line)
+	assert.Equal(t, MultiGeometry, g.Geometries[1].Type)
+	assert.Equal(t, Point, g.Geometries[1].Geometries[0].Type)
+	// The inline style is merged with the shared one.
+	assert.Equal(t, Style{
+		LineColor: color.NRGBA{255, 0, 0, 255},
+		PolyColor: color.NRGBA{0, 255, 0, 127},
+		NoFill:    true,
+		NoOutline: true,
+	}, network.Style)
+
+	area := ps[2]
+	assert.Equal(t, "Area", area.Name)
+	assert.Equal(t, Polygon, area.Geometry.Type)
+	require.Len(t, area.Geometry.Polygon, 3)
+	assert.Len(t, area.Geometry.Polygon[0], 5)
+	assert.Equal(t, Coordinate{Lat: 8, Lng: 8}, area.Geometry.Polygon[2][2])
+	// Style maps resolve to their normal style.
+	assert.Equal(t, color.NRGBA{255, 0, 0, 255}, area.Style.LineColor)
+}
+
+func TestDecodeKMZ(t *testing.T) {
+	var buf bytes.Buffer
+	z := zip.NewWriter(&buf)
+	for name, content := range map[string]string{
+		"files/icon.png": "",
+		"doc.kml":        testKML,
+	} {
+		w, err := z.Create(name)
+		require.NoError(t, err)
+		_, err = w.Write([]byte(content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, z.Close())
+
+	root, err := Decode(&buf)
+	require.NoError(t, err)
+	assert.Len(t, root.AllPlacemarks(), 3)
+}
+
+func TestDecodeErrors(t *testing.T) {
+	var empty bytes.Buffer
+	require.NoError(t, zip.NewWriter(&empty).Close())
+
+	cases := []struct {
+		Name  string
+		Input string
+		Error string
+	}{
+		{"Empty", ``, "kml: empty document"},
+		{"Malformed", `<kml><Placemark></kml>`, "kml: XML syntax error on line 1: element <Placemark> closed by </kml>"},
+		{"Root", `<gpx></gpx>`, "kml: expected element type <kml> but have <gpx>"},
+		{"Coordinate", `<kml><Placemark><name>A</name><Point><coordinates>1;2</coordinates></Point></Placemark></kml>`, `kml: placemark "A": invalid coordinate "1;2"`},
+		{"Range", `<kml><Placemark><LineString><coordinates>0,0 0,95</coordinates></LineString></Placemark></kml>`, `kml: placemark "": coordinate "0,95" out of range`},
+		{"Point", `<kml><Placemark><Point><coordinates>0,0 1,1</coordinates></Point></Placemark></kml>`, `kml: placemark "": point has 2 coordinates`},
+		{"Polygon", `<kml><Placemark><Polygon></Polygon></Placemark></kml>`, `kml: placemark "": polygon has no outer boundary`},
+		{"Color", `<kml><Placemark><Style><LineStyle><color>red</color></LineStyle></Style></Placemark></kml>`, `kml: placemark "": invalid color "red"`},
+		{"KMZ", empty.String(), "kml: kmz archive contains no kml document"},
+	}
+	for _, c := range cases {
+		t.Run(c.Name, func(t *testing.T) {
+			_, err := Decode(strings.NewReader(c.Input))
+			assert.EqualError(t, err, c.Error)
+		})
+	}
+}
@@ -0,0 +1,128 @@
+package globe
+
+import (
+	"image/color"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testKML = `<?xml version="1.0" encoding="UTF-8"?>
+<kml xmlns="http://www.opengis.net/kml/2.2">
+<Document>
+	<Style id="route">
+		<LineStyle><color>ff0000ff</color></LineStyle>
+	</Style>
+	<Style id="zone">
+		<LineStyle><color>ff00ff00</color></LineStyle>
+		<PolyStyle><color>8000ff00</color></PolyStyle>
+	</Style>
+	<Placemark>
+		<name>Bermuda Triangle</name>
+		<styleUrl>#zone</styleUrl>
+		<Polygon><outerBoundaryIs><LinearRing><coordinates>
+			-80.19,25.77 -64.78,32.30 -66.11,18.47 -80.19,25.77
+		</coordinates></LinearRing></outerBoundaryIs></Polygon>
+	</Placemark>
+	<Placemark>
+		<name>Unstyled</name>
+		<Polygon><outerBoundaryIs><LinearRing><coordinates>
+			-20,50 -10,50 -10,60 -20,60 -20,50
+		</coordinates></LinearRing></outerBoundaryIs></Polygon>
+	</Placemark>
+	<Folder>
+		<Placemark>
+			<name>Crossing</name>
+			<styleUrl>#route</styleUrl>
+			<MultiGeometry>
+				<LineString><coordinates>-2.588323,51.453349 -73.903879,40.645423</coordinates></LineString>
+				<Point><coordinates>-2.588323,51.453349</coordinates></Point>
+				<Point><coordinates>-73.903879,40.645423</coordinates></Point>
+			</MultiGeometry>
+		</Placemark>
+		<Placemark>
+			<name>Onward</name>
+			<LineString><coordinates>-73.903879,40.645423 -122.4194,37.7749</coordinates></LineString>
+		</Placemark>
+		<Placemark>
+			<name>Onward</name>
+			<styleUrl>#route</styleUrl>
+			<LineString><coordinates>-122.4194,37.7749 -157.8583,21.3069</coordinates></LineString>
+		</Placemark>
+	</Folder>
+</Document>
+</kml>`
+
+func TestDrawKML(t *testing.T) {
+	g := New()
+	err := g.DrawKML(strings.NewReader(testKML))
+	require.NoError(t, err)
+	require.Len(t, g.layers, 5)
+
+	red := color.NRGBA{255, 0, 0, 255}
+	green := color.NRGBA{0, 255, 0, 255}
+
+	zone := g.layers[0]
+	assert.Equal(t, green, zone.color)
+	assert.Equal(t, color.NRGBA{0, 255, 0, 128}, zone.fill)
+	require.Len(t, zone.rings, 1)
+
+	unstyled := g.layers[1]
+	assert.Equal(t, DefaultStyle.LineColor, unstyled.color)
+	assert.Equal(t, DefaultStyle.FillColor, unstyled.fill)
+
+	// Lines are grouped by color.
+	routes := g.layers[2]
+	assert.Equal(t, red, routes.color)
+	require.Len(t, routes.paths, 2)
+	assert.Equal(t, latlng{51.453349, -2.588323}, routes.paths[0][0])
+	assert.Equal(t, latlng{37.7749, -122.4194}, routes.paths[1][0])
+
+	onward := g.layers[3]
+	assert.Equal(t, DefaultStyle.LineColor, onward.color)
+	require.Len(t, onward.paths, 1)
+
+	dots := g.layers[4]
+	assert.Equal(t, DefaultStyle.DotColor, dots.color)
+	assert.Len(t, dots.dots, 2)
+}
+
+func TestDrawKMLStyle(t *testing.T) {
+	g := New()
+	blue := color.NRGBA{0, 0, 255, 255}
+	err := g.DrawKML(strings.NewReader(testKML), Color(blue))
+	require.NoError(t, err)
+	for _, l := range g.layers {
+		assert.Equal(t, blue, l.color)
+	}
+	assert.Equal(t, color.NRGBA{0, 255, 0, 128}, g.layers[0].fill)
+}
+
+func TestDrawKMLNoFill(t *testing.T) {
+	g := New()
+	err := g.DrawKML(strings.NewReader(`<kml><Placemark>
+		<Style><PolyStyle><fill>0</fill><outline>1</outline></PolyStyle></Style>
+		<Polygon><outerBoundaryIs><LinearRing><coordinates>0,0 1,0 1,1 0,0</coordinates></LinearRing></outerBoundaryIs></Polygon>
+	</Placemark></kml>`))
+	require.NoError(t, err)
+	require.Len(t, g.layers, 1)
+	assert.Nil(t, g.layers[0].fill)
+	assert.Equal(t, DefaultStyle.LineColor, g.layers[0].color)
+}
+
+func TestDrawKMLError(t *testing.T) {
+	g := New()
+	err := g.DrawKML(strings.NewReader(testGeoJSON))
+	assert.Error(t, err)
+	assert.Empty(t, g.layers)
+}
+
+func TestSVGDrawKML(t *testing.T) {
+	g := New()
+	g.DrawGraticule(10)
+	require.NoError(t, g.DrawKML(strings.NewReader(testKML)))
+	g.CenterOn(35, -60)
+	AssertSVGMD5(t, g, "9bd6e088d44a215b517a8e69580462f9")
+}
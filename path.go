@@ -0,0 +1,124 @@
+package globe
+
+import (
+	"image/color"
+	"math"
+
+	"github.com/mmcloughlin/globe/geo"
+)
+
+// DrawPath draws a path through points, such as a GPS track, joining
+// consecutive points as in DrawLine. The whole path is drawn as one piece of
+// geometry, which is much cheaper than a DrawLine call for each segment.
+// With the ClosePath option, the last point is joined back to the first, and
+// the enclosed region is filled if a FillColor is given.
+// Uses the default LineColor unless overridden by style Options, or the colors
+// of a VertexColors option.
+func (g *Globe) DrawPath(points []geo.LatLng, style ...Option) {
+	base := Color(g.style.LineColor)
+	sel := g.selected(append([]Option{base}, style...))
+	defer g.styled(base, style...)()
+	if len(points) < 2 {
+		return
+	}
+
+	vertices := make([]latlng, len(points))
+	for i, p := range points {
+		vertices[i] = latlng{p.Lat, p.Lng}
+	}
+	colors := vertexColors(sel.vertexColors, len(points), sel.color)
+	if sel.closed {
+		vertices = append(vertices, vertices[0])
+		if colors != nil {
+			colors = append(colors, colors[0])
+		}
+	}
+
+	if colors == nil {
+		path := []latlng{vertices[0]}
+		for i := 1; i < len(vertices); i++ {
+			path = append(path, sel.line(vertices[i-1], vertices[i])[1:]...)
+		}
+		g.drawPath(path)
+		if sel.closed {
+			g.fillRing(path)
+		}
+		return
+	}
+
+	// Draw runs of segments of the same color as one path, coloring each
+	// segment by its position along the edge between two vertices.
+	var ring []latlng
+	var run []latlng
+	var runColor color.Color
+	for i := 1; i < len(vertices); i++ {
+		edge := sel.line(vertices[i-1], vertices[i])
+		for k := 1; k < len(edge); k++ {
+			t := (float64(k) - 0.5) / float64(len(edge)-1)
+			c := mixColor(colors[i-1], colors[i], t)
+			if run != nil && c != runColor {
+				g.drawColoredPath(run, runColor)
+				run = nil
+			}
+			if run == nil {
+				run, runColor = []latlng{edge[k-1]}, c
+			}
+			run = append(run, edge[k])
+		}
+		ring = append(ring, edge[:len(edge)-1]...)
+	}
+	g.drawColoredPath(run, runColor)
+	if sel.closed {
+		g.fillRing(append(ring, vertices[0]))
+	}
+}
+
+// ClosePath draws paths given to DrawPath as closed rings, joining the last
+// point back to the first.
+var ClosePath Option = func(g *Globe) {
+	g.cur.closed = true
+}
+
+// VertexColors colors a path drawn by DrawPath with a gradient through the
+// given color of each point, for example to show speed or altitude along a
+// track. It is ignored unless there is one color for each point. Points with
+// a nil color take the color of the path.
+func VertexColors(colors []color.Color) Option {
+	return func(g *Globe) {
+		g.cur.vertexColors = colors
+	}
+}
+
+// vertexColors returns the colors of the n points of a path of color c, with
+// nil colors replaced by c, or nil if the path is drawn in a single color.
+func vertexColors(colors []color.Color, n int, c color.Color) []color.Color {
+	if len(colors) != n {
+		return nil
+	}
+	resolved := make([]color.Color, n)
+	for i, vc := range colors {
+		if vc == nil {
+			vc = c
+		}
+		if vc == nil {
+			return nil
+		}
+		resolved[i] = vc
+	}
+	return resolved
+}
+
+// mixColor returns the color fraction t of the way from a to b.
+func mixColor(a, b color.Color, t float64) color.Color {
+	p := color.NRGBAModel.Convert(a).(color.NRGBA)
+	q := color.NRGBAModel.Convert(b).(color.NRGBA)
+	mix := func(x, y uint8) uint8 {
+		return uint8(math.Round((1-t)*float64(x) + t*float64(y)))
+	}
+	return color.NRGBA{
+		R: mix(p.R, q.R),
+		G: mix(p.G, q.G),
+		B: mix(p.B, q.B),
+		A: mix(p.A, q.A),
+	}
+}
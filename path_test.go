@@ -0,0 +1,160 @@
+package globe
+
+import (
+	"image/color"
+	"testing"
+
+	"github.com/mmcloughlin/globe/geo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var track = []geo.LatLng{
+	{Lat: 51.453349, Lng: -2.588323},
+	{Lat: 48.856614, Lng: 2.352222},
+	{Lat: 41.902782, Lng: 12.496366},
+	{Lat: 40.645423, Lng: -73.903879},
+}
+
+func TestDrawPath(t *testing.T) {
+	g := New()
+	g.DrawPath(track)
+	require.Len(t, g.layers, 1)
+	l := g.layers[0]
+	require.Len(t, l.paths, 1)
+	assert.Nil(t, l.colors)
+	assert.Empty(t, l.rings)
+
+	// The path passes through every point along great circles.
+	path := l.paths[0]
+	var want []latlng
+	for _, p := range track {
+		want = append(want, latlng{p.Lat, p.Lng})
+	}
+	assert.Equal(t, greatCirclePath(want), path)
+
+	// Degenerate paths draw nothing.
+	g.DrawPath(track[:1])
+	g.DrawPath(nil)
+	require.Len(t, g.layers, 3)
+	assert.Empty(t, g.layers[1].paths)
+	assert.Empty(t, g.layers[2].paths)
+}
+
+func TestDrawPathClosed(t *testing.T) {
+	g := New()
+	g.DrawPath(track[:3], ClosePath, FillColor(color.Black))
+	l := g.layers[0]
+	require.Len(t, l.paths, 1)
+	path := l.paths[0]
+	assert.Equal(t, path[0], path[len(path)-1])
+	assert.Equal(t, [][]latlng{path}, l.rings)
+}
+
+func TestDrawPathVertexColors(t *testing.T) {
+	red := color.NRGBA{255, 0, 0, 255}
+	blue := color.NRGBA{0, 0, 255, 255}
+	colors := []color.Color{red, red, blue, blue}
+
+	g := New()
+	g.DrawPath(track, VertexColors(colors))
+	l := g.layers[0]
+	require.Equal(t, len(l.paths), len(l.colors))
+	assert.Equal(t, red, l.colors[0])
+	assert.Equal(t, blue, l.colors[len(l.colors)-1])
+
+	// Runs join up into the whole path.
+	var joined []latlng
+	for i, path := range l.paths {
+		if i > 0 {
+			assert.Equal(t, joined[len(joined)-1], path[0])
+			path = path[1:]
+		}
+		joined = append(joined, path...)
+	}
+	assert.Equal(t, greatCirclePath([]latlng{
+		{track[0].Lat, track[0].Lng},
+		{track[1].Lat, track[1].Lng},
+		{track[2].Lat, track[2].Lng},
+		{track[3].Lat, track[3].Lng},
+	}), joined)
+
+	// The colors of segments between red and blue vertices are mixed.
+	for _, c := range l.colors {
+		n := c.(color.NRGBA)
+		assert.InDelta(t, 255, int(n.R)+int(n.B), 1)
+	}
+
+	// Closed paths join the last color back to the first.
+	g = New()
+	g.DrawPath(track, VertexColors(colors), ClosePath)
+	l = g.layers[0]
+	n := l.colors[len(l.colors)-1].(color.NRGBA)
+	assert.True(t, n.R > n.B)
+	first, last := l.paths[0], l.paths[len(l.paths)-1]
+	assert.Equal(t, first[0], last[len(last)-1])
+
+	// Colors are ignored unless each point has one.
+	g = New()
+	g.DrawPath(track, VertexColors(colors[:2]))
+	assert.Nil(t, g.layers[0].colors)
+}
+
+func TestDrawPathVertexColorsNil(t *testing.T) {
+	red := color.NRGBA{255, 0, 0, 255}
+	blue := color.NRGBA{0, 0, 255, 255}
+
+	// Nil colors take the color of the path.
+	g := New()
+	g.DrawPath(track, Color(blue), VertexColors([]color.Color{red, red, nil, nil}))
+	l := g.layers[0]
+	require.Equal(t, len(l.paths), len(l.colors))
+	assert.Equal(t, red, l.colors[0])
+	assert.Equal(t, blue, l.colors[len(l.colors)-1])
+
+	// Without a path color, the path is drawn as if they were not given.
+	g = New()
+	g.DrawPath(track, Color(nil), VertexColors([]color.Color{red, nil, blue, red}))
+	assert.Nil(t, g.layers[0].colors)
+	assert.Len(t, g.layers[0].paths, 1)
+}
+
+func TestDrawPathBackFace(t *testing.T) {
+	red := color.NRGBA{255, 0, 0, 255}
+	blue := color.NRGBA{0, 0, 255, 255}
+	for _, mode := range []BackFace{BackFaceShow, BackFaceHide, BackFaceDim, BackFaceDash} {
+		g := New()
+		s := DefaultStyle
+		s.BackFace = mode
+		g.SetStyle(s)
+		g.DrawPath(track, VertexColors([]color.Color{red, blue, red, blue}))
+		g.CenterOn(50, 0)
+		_, meshes := g.render(g.camera, 256)
+		n := 0
+		for _, m := range meshes {
+			assert.Equal(t, len(m.paths), len(m.colors))
+			n += len(m.paths)
+		}
+		assert.True(t, n > 0)
+	}
+}
+
+func TestMixColor(t *testing.T) {
+	a, b := color.NRGBA{0, 100, 200, 255}, color.NRGBA{100, 100, 0, 55}
+	assert.Equal(t, a, mixColor(a, b, 0))
+	assert.Equal(t, b, mixColor(a, b, 1))
+	assert.Equal(t, color.NRGBA{50, 100, 100, 155}, mixColor(a, b, 0.5))
+}
+
+func TestSVGDrawPath(t *testing.T) {
+	g := New()
+	g.DrawPath(track, VertexColors([]color.Color{
+		color.NRGBA{255, 0, 0, 255},
+		color.NRGBA{255, 255, 0, 255},
+		color.NRGBA{0, 255, 0, 255},
+		color.NRGBA{0, 0, 255, 255},
+	}))
+	g.DrawPath(track[:3], ClosePath, FillColor(color.NRGBA{0, 0, 0, 64}))
+	g.CenterOn(45, -20)
+	AssertSVGMD5(t, g, "23b2c486ad006d51c9a83cf178b847d3")
+}
@@ -0,0 +1,88 @@
+package globe
+
+import (
+	"image/color"
+	"io"
+	"math"
+
+	"github.com/mmcloughlin/globe/points"
+)
+
+// DrawCSV reads points from CSV or TSV records in r, with the fields given by
+// c, and draws them as in DrawPoints. If some records are invalid, the valid
+// ones are drawn and an error of type points.RowErrors is returned.
+func (g *Globe) DrawCSV(r io.Reader, c points.Config, style ...Option) error {
+	ps, err := points.ReadCSV(r, c)
+	g.DrawPoints(ps, style...)
+	return err
+}
+
+// DrawJSONPoints reads points from a JSON array of objects in r, with the
+// fields given by c, and draws them as in DrawPoints. If some elements are
+// invalid, the valid ones are drawn and an error of type points.RowErrors is
+// returned.
+func (g *Globe) DrawJSONPoints(r io.Reader, c points.Config, style ...Option) error {
+	ps, err := points.ReadJSON(r, c)
+	g.DrawPoints(ps, style...)
+	return err
+}
+
+// DrawPoints draws a dot at each point. The ValueColors and ValueRadius
+// options color and size dots by the values of their points; dots for points
+// without a value are drawn as usual. Dots of each color are drawn as a
+// separate layer.
+// Uses the default DotColor and radius unless overridden by style Options.
+func (g *Globe) DrawPoints(ps []points.Point, style ...Option) {
+	if len(ps) == 0 {
+		return
+	}
+	sel := g.selected(style)
+
+	var colors []color.Color
+	groups := map[color.Color][]dot{}
+	for _, p := range ps {
+		d := dot{latlng: latlng{p.Lat, p.Lng}, radius: defaultDotRadius}
+		var c color.Color
+		if !math.IsNaN(p.Value) {
+			if sel.valueRadius != nil {
+				d.radius = sel.valueRadius(p.Value)
+			}
+			if sel.valueColors != nil {
+				c = color.NRGBAModel.Convert(sel.valueColors.Color(p.Value))
+			}
+		}
+		if _, ok := groups[c]; !ok {
+			colors = append(colors, c)
+		}
+		groups[c] = append(groups[c], d)
+	}
+
+	for _, c := range colors {
+		options := style
+		if c != nil {
+			options = append(style[:len(style):len(style)], Color(c))
+		}
+		func() {
+			defer g.styled(Color(g.style.DotColor), options...)()
+			g.cur.dots = groups[c]
+		}()
+	}
+}
+
+// ValueColors colors dots drawn by DrawPoints according to the values of
+// their points, with colors from scale. It takes precedence over a Color
+// option for points with values.
+func ValueColors(scale ColorScale) Option {
+	return func(g *Globe) {
+		g.cur.valueColors = scale
+	}
+}
+
+// ValueRadius sizes dots drawn by DrawPoints according to the values of their
+// points, with the radius given by f. For dot areas proportional to values,
+// use a radius proportional to the square root of the value.
+func ValueRadius(f func(v float64) float64) Option {
+	return func(g *Globe) {
+		g.cur.valueRadius = f
+	}
+}
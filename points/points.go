@@ -0,0 +1,285 @@
+// Package points reads datasets of points, such as store locations or cities,
+// from CSV, TSV and JSON.
+package points
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// Point is a location in degrees with an optional value. Value is NaN if the
+// dataset has no value for the point.
+type Point struct {
+	Lat, Lng float64
+	Value    float64
+}
+
+// Config names the fields of records holding the coordinates and value of
+// points.
+type Config struct {
+	// Lat and Lng are the names of the latitude and longitude fields. If
+	// empty, the first field named "lat" or "latitude", and "lng", "lon",
+	// "long" or "longitude", is used. Names are not case sensitive.
+	Lat, Lng string
+
+	// Value is the name of the field holding the values of points, if any.
+	Value string
+
+	// Comma is the field delimiter of CSV records. It is a comma if zero;
+	// use a tab for TSV.
+	Comma rune
+}
+
+// Default field names.
+var (
+	latNames = []string{"lat", "latitude"}
+	lngNames = []string{"lng", "lon", "long", "longitude"}
+)
+
+// RowError is an error in a record, which is skipped. Row is the index of the
+// record, counting from 1 for the first record after the CSV header or the
+// first element of the JSON array.
+type RowError struct {
+	Row int
+	Err error
+}
+
+func (e *RowError) Error() string {
+	return fmt.Sprintf("points: row %d: %s", e.Row, e.Err)
+}
+
+// RowErrors is returned, along with the valid points, when records are
+// skipped.
+type RowErrors []*RowError
+
+func (e RowErrors) Error() string {
+	switch len(e) {
+	case 0:
+		return "points: no errors"
+	case 1:
+		return e[0].Error()
+	default:
+		return fmt.Sprintf("%s (and %d more errors)", e[0], len(e)-1)
+	}
+}
+
+// ReadCSV reads points from CSV records in r, with a header row naming the
+// fields. Invalid records are skipped and reported by an error of type
+// RowErrors, which is returned with the points of the valid records.
+func ReadCSV(r io.Reader, c Config) ([]Point, error) {
+	cr := csv.NewReader(r)
+	if c.Comma != 0 {
+		cr.Comma = c.Comma
+	}
+	cr.FieldsPerRecord = -1
+
+	header, err := cr.Read()
+	if err == io.EOF {
+		return nil, errors.New("points: no header")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("points: %s", err)
+	}
+	// Spreadsheets may start files with a byte order mark.
+	if len(header) > 0 {
+		header[0] = strings.TrimPrefix(header[0], "\ufeff")
+	}
+	index := map[string]int{}
+	for i := len(header) - 1; i >= 0; i-- {
+		index[strings.ToLower(strings.TrimSpace(header[i]))] = i
+	}
+	f, err := c.fields(func(name string) bool {
+		_, ok := index[name]
+		return ok
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var ps []Point
+	var errs RowErrors
+	for row := 1; ; row++ {
+		record, err := cr.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("points: %s", err)
+		}
+		get := func(name string) (string, bool) {
+			i := index[name]
+			if i >= len(record) {
+				return "", false
+			}
+			return record[i], true
+		}
+		p, err := f.point(func(name string) (float64, bool, error) {
+			s, ok := get(name)
+			if !ok || strings.TrimSpace(s) == "" {
+				return 0, false, nil
+			}
+			v, err := strconv.ParseFloat(strings.TrimSpace(s), 64)
+			if err != nil {
+				return 0, false, fmt.Errorf("invalid %s %q", name, s)
+			}
+			return v, true, nil
+		})
+		if err != nil {
+			errs = append(errs, &RowError{Row: row, Err: err})
+			continue
+		}
+		ps = append(ps, p)
+	}
+	if errs != nil {
+		return ps, errs
+	}
+	return ps, nil
+}
+
+// ReadJSON reads points from a JSON array of objects in r. Coordinates and
+// values may be numbers or strings holding numbers. Invalid elements are
+// skipped and reported by an error of type RowErrors, which is returned with
+// the points of the valid elements.
+func ReadJSON(r io.Reader, c Config) ([]Point, error) {
+	var elements []json.RawMessage
+	if err := json.NewDecoder(r).Decode(&elements); err != nil {
+		return nil, fmt.Errorf("points: %s", err)
+	}
+
+	var ps []Point
+	var errs RowErrors
+	var f *fields
+	for i, raw := range elements {
+		row := i + 1
+		var obj map[string]json.RawMessage
+		if err := json.Unmarshal(raw, &obj); err != nil || obj == nil {
+			errs = append(errs, &RowError{Row: row, Err: errors.New("not an object")})
+			continue
+		}
+		keys := map[string]json.RawMessage{}
+		for k, v := range obj {
+			keys[strings.ToLower(k)] = v
+		}
+
+		// Field names are found from the first object.
+		if f == nil {
+			var err error
+			f, err = c.fields(func(name string) bool {
+				_, ok := keys[name]
+				return ok
+			})
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		p, err := f.point(func(name string) (float64, bool, error) {
+			v, ok := keys[name]
+			if !ok || string(v) == "null" {
+				return 0, false, nil
+			}
+			var n json.Number
+			if err := json.Unmarshal(v, &n); err != nil {
+				return 0, false, fmt.Errorf("invalid %s %s", name, v)
+			}
+			x, err := n.Float64()
+			if err != nil {
+				return 0, false, fmt.Errorf("invalid %s %s", name, v)
+			}
+			return x, true, nil
+		})
+		if err != nil {
+			errs = append(errs, &RowError{Row: row, Err: err})
+			continue
+		}
+		ps = append(ps, p)
+	}
+	if errs != nil {
+		return ps, errs
+	}
+	return ps, nil
+}
+
+// fields holds the lower case names of the fields of a dataset.
+type fields struct {
+	lat, lng, value string
+}
+
+// fields finds the names of the fields configured by c, where has reports
+// whether a dataset has a field.
+func (c Config) fields(has func(name string) bool) (*fields, error) {
+	find := func(name, kind string, defaults []string) (string, error) {
+		if name != "" {
+			name = strings.ToLower(name)
+			if !has(name) {
+				return "", fmt.Errorf("points: no field %q", name)
+			}
+			return name, nil
+		}
+		for _, d := range defaults {
+			if has(d) {
+				return d, nil
+			}
+		}
+		return "", fmt.Errorf("points: no %s field", kind)
+	}
+
+	var f fields
+	var err error
+	if f.lat, err = find(c.Lat, "latitude", latNames); err != nil {
+		return nil, err
+	}
+	if f.lng, err = find(c.Lng, "longitude", lngNames); err != nil {
+		return nil, err
+	}
+	if c.Value != "" {
+		if f.value, err = find(c.Value, "value", nil); err != nil {
+			return nil, err
+		}
+	}
+	return &f, nil
+}
+
+// point reads a point with the fields f, where get returns the number in a
+// field and whether it is set.
+func (f *fields) point(get func(name string) (float64, bool, error)) (Point, error) {
+	lat, ok, err := get(f.lat)
+	if err != nil {
+		return Point{}, err
+	}
+	if !ok {
+		return Point{}, fmt.Errorf("missing %s", f.lat)
+	}
+	if math.IsNaN(lat) || lat < -90 || lat > 90 {
+		return Point{}, fmt.Errorf("%s %v out of range", f.lat, lat)
+	}
+
+	lng, ok, err := get(f.lng)
+	if err != nil {
+		return Point{}, err
+	}
+	if !ok {
+		return Point{}, fmt.Errorf("missing %s", f.lng)
+	}
+	if math.IsNaN(lng) || lng < -180 || lng > 180 {
+		return Point{}, fmt.Errorf("%s %v out of range", f.lng, lng)
+	}
+
+	p := Point{Lat: lat, Lng: lng, Value: math.NaN()}
+	if f.value != "" {
+		v, ok, err := get(f.value)
+		if err != nil {
+			return Point{}, err
+		}
+		if ok {
+			p.Value = v
+		}
+	}
+	return p, nil
+}
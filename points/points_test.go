@@ -0,0 +1,116 @@
+package points
+
+import (
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadCSV(t *testing.T) {
+	src := "\ufeffName,Latitude,Longitude,Population\n" +
+		"Bristol,51.453349,-2.588323,467099\n" +
+		"\"New York, NY\",40.645423,-73.903879,\n"
+	ps, err := ReadCSV(strings.NewReader(src), Config{Value: "population"})
+	require.NoError(t, err)
+	require.Len(t, ps, 2)
+	assert.Equal(t, Point{51.453349, -2.588323, 467099}, ps[0])
+	assert.Equal(t, 40.645423, ps[1].Lat)
+	assert.Equal(t, -73.903879, ps[1].Lng)
+	assert.True(t, math.IsNaN(ps[1].Value))
+}
+
+func TestReadTSV(t *testing.T) {
+	src := "y\tx\n10\t20\n"
+	ps, err := ReadCSV(strings.NewReader(src), Config{Lat: "Y", Lng: "X", Comma: '\t'})
+	require.NoError(t, err)
+	require.Len(t, ps, 1)
+	assert.Equal(t, 10.0, ps[0].Lat)
+	assert.Equal(t, 20.0, ps[0].Lng)
+	assert.True(t, math.IsNaN(ps[0].Value))
+}
+
+func TestReadCSVRowErrors(t *testing.T) {
+	src := "lat,lng,v\n" +
+		"1,2,3\n" +
+		"north,2,3\n" +
+		"1\n" +
+		"95,2,3\n" +
+		"1,2,lots\n" +
+		"4,5,6\n"
+	ps, err := ReadCSV(strings.NewReader(src), Config{Value: "v"})
+	require.Len(t, ps, 2)
+	assert.Equal(t, Point{4, 5, 6}, ps[1])
+
+	require.IsType(t, RowErrors{}, err)
+	errs := err.(RowErrors)
+	require.Len(t, errs, 4)
+	assert.Equal(t, []int{2, 3, 4, 5}, []int{errs[0].Row, errs[1].Row, errs[2].Row, errs[3].Row})
+	assert.EqualError(t, errs[0], `points: row 2: invalid lat "north"`)
+	assert.EqualError(t, errs[1], "points: row 3: missing lng")
+	assert.EqualError(t, errs[2], "points: row 4: lat 95 out of range")
+	assert.EqualError(t, errs[3], `points: row 5: invalid v "lots"`)
+	assert.EqualError(t, err, `points: row 2: invalid lat "north" (and 3 more errors)`)
+}
+
+func TestReadCSVErrors(t *testing.T) {
+	cases := []struct {
+		Name   string
+		Input  string
+		Config Config
+		Error  string
+	}{
+		{"Empty", "", Config{}, "points: no header"},
+		{"NoLat", "y,lng\n", Config{}, "points: no latitude field"},
+		{"NoLng", "lat,x\n", Config{}, "points: no longitude field"},
+		{"NoValue", "lat,lng\n", Config{Value: "count"}, `points: no field "count"`},
+	}
+	for _, c := range cases {
+		t.Run(c.Name, func(t *testing.T) {
+			_, err := ReadCSV(strings.NewReader(c.Input), c.Config)
+			assert.EqualError(t, err, c.Error)
+		})
+	}
+}
+
+func TestReadCSVMalformed(t *testing.T) {
+	// The CSV parser's messages vary between Go versions.
+	_, err := ReadCSV(strings.NewReader("lat,lng\n1,\"2\n"), Config{})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "points: ")
+	assert.Contains(t, err.Error(), "parse error")
+}
+
+func TestReadJSON(t *testing.T) {
+	src := `[
+		{"latitude": 51.453349, "longitude": -2.588323, "count": 3},
+		{"latitude": "40.645423", "longitude": "-73.903879", "count": null},
+		{"latitude": true, "longitude": 0},
+		[1, 2],
+		{"latitude": 1}
+	]`
+	ps, err := ReadJSON(strings.NewReader(src), Config{Value: "Count"})
+	require.Len(t, ps, 2)
+	assert.Equal(t, Point{51.453349, -2.588323, 3}, ps[0])
+	assert.Equal(t, 40.645423, ps[1].Lat)
+	assert.True(t, math.IsNaN(ps[1].Value))
+
+	require.IsType(t, RowErrors{}, err)
+	errs := err.(RowErrors)
+	require.Len(t, errs, 3)
+	assert.EqualError(t, errs[0], "points: row 3: invalid latitude true")
+	assert.EqualError(t, errs[1], "points: row 4: not an object")
+	assert.EqualError(t, errs[2], "points: row 5: missing longitude")
+}
+
+func TestReadJSONErrors(t *testing.T) {
+	_, err := ReadJSON(strings.NewReader(`{"lat": 1, "lng": 2}`), Config{})
+	assert.Error(t, err)
+	_, err = ReadJSON(strings.NewReader(`[{"x": 1, "lng": 2}]`), Config{})
+	assert.EqualError(t, err, "points: no latitude field")
+	ps, err := ReadJSON(strings.NewReader(`[]`), Config{})
+	assert.NoError(t, err)
+	assert.Empty(t, ps)
+}
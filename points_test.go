@@ -0,0 +1,87 @@
+package globe
+
+import (
+	"image/color"
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/mmcloughlin/globe/points"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testCSV = `city,lat,lng,population
+Bristol,51.453349,-2.588323,0.47
+New York,40.645423,-73.903879,8.3
+San Francisco,37.7749,-122.4194,0.87
+Atlantis,,,
+Honolulu,21.3069,-157.8583,
+`
+
+func TestDrawCSV(t *testing.T) {
+	g := New()
+	err := g.DrawCSV(strings.NewReader(testCSV), points.Config{})
+	require.Len(t, g.layers, 1)
+	l := g.layers[0]
+	assert.Equal(t, DefaultStyle.DotColor, l.color)
+	require.Len(t, l.dots, 4)
+	assert.Equal(t, dot{latlng{51.453349, -2.588323}, defaultDotRadius}, l.dots[0])
+
+	// Invalid rows are reported after drawing the others.
+	assert.EqualError(t, err, "points: row 4: missing lat")
+}
+
+func TestDrawPointsValues(t *testing.T) {
+	ps := []points.Point{
+		{Lat: 0, Lng: 0, Value: 0},
+		{Lat: 0, Lng: 10, Value: 4},
+		{Lat: 0, Lng: 20, Value: 0},
+		{Lat: 0, Lng: 30, Value: math.NaN()},
+	}
+	red := color.NRGBA{255, 0, 0, 255}
+	blue := color.NRGBA{0, 0, 255, 255}
+	g := New()
+	g.DrawPoints(ps,
+		ValueColors(LinearScale{Min: 0, Max: 4, Colors: []color.Color{red, blue}}),
+		ValueRadius(func(v float64) float64 { return 0.01 * math.Sqrt(v) }),
+		Color(color.Black),
+	)
+
+	// Dots are grouped by color, and the scale takes precedence over Color.
+	require.Len(t, g.layers, 3)
+	assert.Equal(t, red, g.layers[0].color)
+	assert.Equal(t, []dot{{latlng{0, 0}, 0}, {latlng{0, 20}, 0}}, g.layers[0].dots)
+	assert.Equal(t, blue, g.layers[1].color)
+	assert.Equal(t, []dot{{latlng{0, 10}, 0.02}}, g.layers[1].dots)
+	assert.Equal(t, color.Black, g.layers[2].color)
+	assert.Equal(t, []dot{{latlng{0, 30}, defaultDotRadius}}, g.layers[2].dots)
+}
+
+func TestDrawJSONPoints(t *testing.T) {
+	g := New()
+	src := `[{"latitude": "51.45", "longitude": "-2.59"}, {"latitude": 40.6, "longitude": -73.9}]`
+	err := g.DrawJSONPoints(strings.NewReader(src), points.Config{}, Radius(0.02))
+	require.NoError(t, err)
+	require.Len(t, g.layers, 1)
+	assert.Equal(t, []dot{{latlng{51.45, -2.59}, 0.02}, {latlng{40.6, -73.9}, 0.02}}, g.layers[0].dots)
+
+	err = g.DrawJSONPoints(strings.NewReader(`{}`), points.Config{})
+	assert.Error(t, err)
+	assert.Len(t, g.layers, 1)
+}
+
+func TestSVGDrawCSV(t *testing.T) {
+	g := New()
+	g.DrawGraticule(10)
+	err := g.DrawCSV(strings.NewReader(testCSV), points.Config{Value: "population"},
+		ValueColors(LinearScale{Min: 0, Max: 10, Colors: []color.Color{
+			color.NRGBA{255, 200, 0, 255},
+			color.NRGBA{200, 0, 0, 255},
+		}}),
+		ValueRadius(func(v float64) float64 { return 0.05 * math.Sqrt(v) }),
+	)
+	require.Error(t, err)
+	g.CenterOn(40, -90)
+	AssertSVGMD5(t, g, "d9e1816583e7ebad2a072a3cbf2015ac")
+}
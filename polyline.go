@@ -0,0 +1,18 @@
+package globe
+
+import (
+	"github.com/mmcloughlin/globe/polyline"
+)
+
+// DrawEncodedPolyline draws the path encoded in s in the encoded polyline
+// format, with coordinates to precision decimal places, as in DrawPath. See
+// polyline.Decode for the format and precision.
+// Uses the default LineColor unless overridden by style Options.
+func (g *Globe) DrawEncodedPolyline(s string, precision int, style ...Option) error {
+	points, err := polyline.Decode(s, precision)
+	if err != nil {
+		return err
+	}
+	g.DrawPath(points, style...)
+	return nil
+}
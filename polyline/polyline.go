@@ -0,0 +1,98 @@
+// Package polyline encodes and decodes paths in the encoded polyline format
+// used by Google Maps and many routing services.
+package polyline
+
+import (
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/mmcloughlin/globe/geo"
+)
+
+// Decode decodes a path in the encoded polyline format, with coordinates to
+// precision decimal places. The precision is usually 5, or 6 for services such
+// as OSRM and Valhalla. Decoding with the wrong precision usually gives
+// coordinates out of range, which is an error.
+func Decode(s string, precision int) ([]geo.LatLng, error) {
+	scale := math.Pow10(precision)
+	var points []geo.LatLng
+	var lat, lng int64
+	for pos := 0; pos < len(s); {
+		var deltas [2]int64
+		for i := range deltas {
+			var err error
+			deltas[i], pos, err = decodeValue(s, pos)
+			if err != nil {
+				return nil, err
+			}
+		}
+		lat += deltas[0]
+		lng += deltas[1]
+		p := geo.LatLng{Lat: float64(lat) / scale, Lng: float64(lng) / scale}
+		if math.Abs(p.Lat) > 90 || math.Abs(p.Lng) > 180 {
+			return nil, fmt.Errorf("polyline: point %d (%v, %v) out of range for precision %d", len(points)+1, p.Lat, p.Lng, precision)
+		}
+		points = append(points, p)
+	}
+	return points, nil
+}
+
+// decodeValue decodes the value starting at pos in the encoded polyline s,
+// returning the value and the position following it.
+func decodeValue(s string, pos int) (int64, int, error) {
+	var u uint64
+	for shift := uint(0); ; shift += 5 {
+		if pos == len(s) {
+			return 0, pos, fmt.Errorf("polyline: truncated at offset %d", pos)
+		}
+		c := s[pos]
+		if c < 63 || c > 126 {
+			return 0, pos, fmt.Errorf("polyline: invalid character %q at offset %d", c, pos)
+		}
+		if shift > 60 {
+			return 0, pos, fmt.Errorf("polyline: value too long at offset %d", pos)
+		}
+		pos++
+		b := uint64(c - 63)
+		u |= (b & 0x1f) << shift
+		if b < 0x20 {
+			break
+		}
+	}
+	// The sign is held in the lowest bit, with negative values inverted.
+	v := int64(u >> 1)
+	if u&1 != 0 {
+		v = ^v
+	}
+	return v, pos, nil
+}
+
+// Encode encodes the path through points in the encoded polyline format, with
+// coordinates rounded to precision decimal places, as decoded by Decode.
+func Encode(points []geo.LatLng, precision int) string {
+	scale := math.Pow10(precision)
+	var sb strings.Builder
+	var lat, lng int64
+	for _, p := range points {
+		plat := int64(math.Round(p.Lat * scale))
+		plng := int64(math.Round(p.Lng * scale))
+		encodeValue(&sb, plat-lat)
+		encodeValue(&sb, plng-lng)
+		lat, lng = plat, plng
+	}
+	return sb.String()
+}
+
+// encodeValue writes the encoding of v to sb.
+func encodeValue(sb *strings.Builder, v int64) {
+	u := uint64(v) << 1
+	if v < 0 {
+		u = ^u
+	}
+	for u >= 0x20 {
+		sb.WriteByte(byte(0x20|u&0x1f) + 63)
+		u >>= 5
+	}
+	sb.WriteByte(byte(u) + 63)
+}
@@ -0,0 +1,87 @@
+package polyline
+
+import (
+	"testing"
+
+	"github.com/mmcloughlin/globe/geo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// googlePolyline is the example from the encoded polyline format
+// documentation.
+const googlePolyline = "_p~iF~ps|U_ulLnnqC_mqNvxq`@"
+
+var googlePoints = []geo.LatLng{
+	{Lat: 38.5, Lng: -120.2},
+	{Lat: 40.7, Lng: -120.95},
+	{Lat: 43.252, Lng: -126.453},
+}
+
+var track = []geo.LatLng{
+	{Lat: 51.453349, Lng: -2.588323},
+	{Lat: 48.856614, Lng: 2.352222},
+	{Lat: 41.902782, Lng: 12.496366},
+	{Lat: 40.645423, Lng: -73.903879},
+}
+
+func TestDecode(t *testing.T) {
+	points, err := Decode(googlePolyline, 5)
+	require.NoError(t, err)
+	require.Len(t, points, len(googlePoints))
+	for i, p := range points {
+		assert.InDelta(t, googlePoints[i].Lat, p.Lat, 1e-9)
+		assert.InDelta(t, googlePoints[i].Lng, p.Lng, 1e-9)
+	}
+
+	points, err = Decode("A?", 6)
+	require.NoError(t, err)
+	assert.Equal(t, []geo.LatLng{{Lat: 1e-6, Lng: 0}}, points)
+
+	points, err = Decode("", 5)
+	require.NoError(t, err)
+	assert.Empty(t, points)
+}
+
+func TestEncode(t *testing.T) {
+	assert.Equal(t, googlePolyline, Encode(googlePoints, 5))
+	assert.Equal(t, "A?", Encode([]geo.LatLng{{Lat: 1e-6, Lng: 0}}, 6))
+	assert.Equal(t, "", Encode(nil, 5))
+}
+
+func TestRoundTrip(t *testing.T) {
+	for _, precision := range []int{5, 6} {
+		s := Encode(track, precision)
+		points, err := Decode(s, precision)
+		require.NoError(t, err)
+		require.Len(t, points, len(track))
+		for i, p := range points {
+			assert.InDelta(t, track[i].Lat, p.Lat, 0.5e-5)
+			assert.InDelta(t, track[i].Lng, p.Lng, 0.5e-5)
+		}
+	}
+
+	// Extremes.
+	extremes := []geo.LatLng{{Lat: -90, Lng: -180}, {Lat: 90, Lng: 180}, {Lat: 0, Lng: 0}}
+	points, err := Decode(Encode(extremes, 6), 6)
+	require.NoError(t, err)
+	assert.Equal(t, extremes, points)
+}
+
+func TestDecodeErrors(t *testing.T) {
+	cases := []struct {
+		Polyline  string
+		Precision int
+		Error     string
+	}{
+		{"_p~iF", 5, "polyline: truncated at offset 5"},
+		{"_p~i", 5, "polyline: truncated at offset 4"},
+		{"_p~iF ps|U", 5, `polyline: invalid character ' ' at offset 5`},
+		{"~~~~~~~~~~~~~~~?", 5, "polyline: value too long at offset 13"},
+		{googlePolyline, 4, "polyline: point 1 (385, -1202) out of range for precision 4"},
+	}
+	for _, c := range cases {
+		_, err := Decode(c.Polyline, c.Precision)
+		assert.EqualError(t, err, c.Error, c.Polyline)
+	}
+}
@@ -0,0 +1,36 @@
+package globe
+
+import (
+	"testing"
+
+	"github.com/mmcloughlin/globe/polyline"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// googlePolyline is the example from the encoded polyline format
+// documentation.
+const googlePolyline = "_p~iF~ps|U_ulLnnqC_mqNvxq`@"
+
+func TestDrawEncodedPolyline(t *testing.T) {
+	g := New()
+	require.NoError(t, g.DrawEncodedPolyline(googlePolyline, 5))
+	require.Len(t, g.layers, 1)
+	l := g.layers[0]
+	assert.Equal(t, DefaultStyle.LineColor, l.color)
+	require.Len(t, l.paths, 1)
+	path := l.paths[0]
+	assert.InDelta(t, 38.5, path[0].lat, 1e-9)
+	assert.InDelta(t, -126.453, path[len(path)-1].lng, 1e-9)
+
+	assert.Error(t, g.DrawEncodedPolyline("_p~iF", 5))
+	assert.Len(t, g.layers, 1)
+}
+
+func TestSVGDrawEncodedPolyline(t *testing.T) {
+	g := New()
+	g.DrawGraticule(10)
+	require.NoError(t, g.DrawEncodedPolyline(polyline.Encode(track, 6), 6))
+	g.CenterOn(track[0].Lat, track[0].Lng)
+	AssertSVGMD5(t, g, "544060c78f18e2c2240358ffb91f7c6f")
+}
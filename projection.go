@@ -0,0 +1,529 @@
+package globe
+
+import "math"
+
+// Projection is a map projection used to render a globe.
+type Projection int
+
+// Supported projections. Cylindrical and pseudocylindrical maps are centered
+// on the longitude of the camera, with north up, and are cut along the
+// opposite meridian. Azimuthal maps are centered on the camera position, with
+// its heading up.
+const (
+	// ProjectionGlobe renders the globe in 3D, as seen from the camera.
+	ProjectionGlobe Projection = iota
+
+	// ProjectionEquirectangular maps longitude and latitude to a grid of
+	// squares.
+	ProjectionEquirectangular
+
+	// ProjectionMercator preserves angles. It is cut off at about 85° of
+	// latitude, so the map is square.
+	ProjectionMercator
+
+	// ProjectionRobinson is a compromise between preserving areas and angles,
+	// for world maps.
+	ProjectionRobinson
+
+	// ProjectionMollweide preserves areas, on an ellipse.
+	ProjectionMollweide
+
+	// ProjectionAzimuthalEquidistant preserves distances and directions from
+	// the center of the view. The region around the opposite point, where the
+	// projection is singular, is not drawn.
+	ProjectionAzimuthalEquidistant
+
+	// ProjectionStereographic preserves angles, and is clipped to the
+	// hemisphere centered on the view.
+	ProjectionStereographic
+)
+
+// Projection constants.
+const (
+	// mercatorMaxLat is the latitude (in radians) at which Mercator maps are
+	// cut off, where they are as tall as they are wide.
+	mercatorMaxLat = 1.4844222297453324
+
+	// azimuthalStep is the max angle (in radians) between points of paths on
+	// azimuthal maps before clipping, so that no segment can pass over the
+	// region clipped around the opposite point without a vertex inside it.
+	azimuthalStep = math.Pi / 360
+
+	// cutEpsilon is the distance in camera space within which points are
+	// taken to be on the meridian a map is cut along.
+	cutEpsilon = 1e-9
+
+	// resampleTolerance is the distance in pixels that lines on flat maps may
+	// deviate from the projected curves they approximate.
+	resampleTolerance = 0.25
+
+	// resampleChord is the max distance in camera space between points of
+	// lines on flat maps.
+	resampleChord = math.Pi / 18
+
+	// resampleDepth is the max number of times segments are halved when
+	// resampling.
+	resampleDepth = 10
+)
+
+// robinsonTable holds the length of the parallel and distance from the equator
+// of the Robinson projection at every 5° of latitude, relative to the equator
+// and the pole.
+var robinsonTable = [...][2]float64{
+	{1.0000, 0.0000}, {0.9986, 0.0620}, {0.9954, 0.1240}, {0.9900, 0.1860},
+	{0.9822, 0.2480}, {0.9730, 0.3100}, {0.9600, 0.3720}, {0.9427, 0.4340},
+	{0.9216, 0.4958}, {0.8962, 0.5571}, {0.8679, 0.6176}, {0.8350, 0.6769},
+	{0.7986, 0.7346}, {0.7597, 0.7903}, {0.7186, 0.8435}, {0.6732, 0.8936},
+	{0.6213, 0.9394}, {0.5722, 0.9761}, {0.5322, 1.0000},
+}
+
+// cut reports whether maps in projection p are cut along the meridian opposite
+// the center of the view, as cylindrical and pseudocylindrical maps are.
+func (p Projection) cut() bool {
+	switch p {
+	case ProjectionEquirectangular, ProjectionMercator, ProjectionRobinson, ProjectionMollweide:
+		return true
+	}
+	return false
+}
+
+// clipAngle returns the angle (in radians) from the center of the view that
+// azimuthal maps in projection p are clipped to, or zero for other projections.
+func (p Projection) clipAngle() float64 {
+	switch p {
+	case ProjectionAzimuthalEquidistant:
+		return degToRad(179)
+	case ProjectionStereographic:
+		return math.Pi / 2
+	}
+	return 0
+}
+
+// extent returns the distance in the plane from the center of maps in
+// projection p to their furthest edge.
+func (p Projection) extent() float64 {
+	switch p {
+	case ProjectionRobinson:
+		x, _ := robinson(math.Pi, 0)
+		return x
+	case ProjectionMollweide:
+		return 2 * math.Sqrt2
+	case ProjectionAzimuthalEquidistant:
+		return p.clipAngle()
+	case ProjectionStereographic:
+		return 2 * math.Tan(p.clipAngle()/2)
+	}
+	return math.Pi
+}
+
+// robinson maps (lng, lat), in radians, to the plane in the Robinson
+// projection, interpolating linearly between entries of robinsonTable.
+func robinson(lng, lat float64) (x, y float64) {
+	i := math.Abs(lat) / degToRad(5)
+	k := math.Min(math.Floor(i), float64(len(robinsonTable)-2))
+	t := i - k
+	a, b := robinsonTable[int(k)], robinsonTable[int(k)+1]
+	plen := a[0] + t*(b[0]-a[0])
+	pdfe := a[1] + t*(b[1]-a[1])
+	return 0.8487 * plen * lng, math.Copysign(1.3523*pdfe, lat)
+}
+
+// mollweide maps (lng, lat), in radians, to the plane in the Mollweide
+// projection.
+func mollweide(lng, lat float64) (x, y float64) {
+	// Solve 2θ + sin 2θ = π sin(lat) for the auxiliary angle θ.
+	theta := lat
+	if math.Abs(lat) < math.Pi/2-1e-9 {
+		t := 2 * lat
+		for i := 0; i < 32; i++ {
+			dt := (t + math.Sin(t) - math.Pi*math.Sin(lat)) / (1 + math.Cos(t))
+			t -= dt
+			if math.Abs(dt) < 1e-12 {
+				break
+			}
+		}
+		theta = t / 2
+	}
+	return 2 * math.Sqrt2 / math.Pi * lng * math.Cos(theta), math.Sqrt2 * math.Sin(theta)
+}
+
+// framePoint is a point in camera space with its longitude and latitude (in
+// radians) relative to the center of the view. Points on the meridian that
+// maps are cut along have a longitude of π or -π, depending on which edge of
+// the map they are drawn on.
+type framePoint struct {
+	point
+	lng, lat float64
+}
+
+// ambiguous reports whether the longitude of p, in camera space, is ambiguous
+// because it is on the cut or at a pole.
+func ambiguous(p point) bool {
+	return math.Abs(p.x) < cutEpsilon && p.z > -cutEpsilon
+}
+
+// frame returns the frame point of p, in camera space. Points on the cut are
+// placed on the edge of the map on the same side as the longitude prev, and
+// points at the poles take the longitude prev.
+func frame(p point, prev float64) framePoint {
+	f := framePoint{point: p, lat: math.Asin(math.Max(-1, math.Min(1, p.y)))}
+	switch {
+	case !ambiguous(p):
+		f.lng = math.Atan2(p.x, -p.z)
+	case math.Abs(p.z) < cutEpsilon:
+		f.lng = prev
+	default:
+		f.lng = math.Copysign(math.Pi, prev)
+	}
+	return f
+}
+
+// framePath returns the frame points of path, in camera space. Points with
+// ambiguous longitudes follow the point before them, or the first point with
+// an unambiguous longitude if they start the path. If ring is set, path is
+// cyclic, so the first points follow the last.
+func framePath(path []point, ring bool) []framePoint {
+	start := 0
+	for start < len(path) && ambiguous(path[start]) {
+		start++
+	}
+	prev := 0.0
+	if start < len(path) {
+		prev = math.Atan2(path[start].x, -path[start].z)
+	}
+	fs := make([]framePoint, len(path))
+	for k := range path {
+		i := k
+		if ring {
+			i = (start + k) % len(path)
+		}
+		fs[i] = frame(path[i], prev)
+		prev = fs[i].lng
+	}
+	return fs
+}
+
+// crossCut reports whether the segment from a to b crosses the cut, returning
+// the points where it leaves the map on one edge and enters on the other.
+func crossCut(a, b framePoint) (exit, entry framePoint, ok bool) {
+	if (a.lng < 0) == (b.lng < 0) || a.x == b.x {
+		return exit, entry, false
+	}
+	t := math.Max(0, math.Min(1, a.x/(a.x-b.x)))
+	q := lerp(a.point, b.point, t)
+	if q.z <= 0 {
+		// The segment crosses the meridian at the center of the view.
+		return exit, entry, false
+	}
+	lat := math.Asin(math.Max(-1, math.Min(1, q.y)))
+	exit = framePoint{point: q, lng: math.Copysign(math.Pi, a.lng), lat: lat}
+	entry = framePoint{point: q, lng: math.Copysign(math.Pi, b.lng), lat: lat}
+	return exit, entry, true
+}
+
+// cutPath cuts path where it crosses the cut.
+func cutPath(path []framePoint) [][]framePoint {
+	if len(path) == 0 {
+		return nil
+	}
+	var pieces [][]framePoint
+	piece := []framePoint{path[0]}
+	for i := 1; i < len(path); i++ {
+		if exit, entry, ok := crossCut(path[i-1], path[i]); ok {
+			pieces = append(pieces, append(piece, exit))
+			piece = []framePoint{entry}
+		}
+		piece = append(piece, path[i])
+	}
+	return append(pieces, piece)
+}
+
+// planar maps f to the plane of a flat map, returned in camera space with zero
+// depth.
+func (v view) planar(f framePoint) point {
+	var x, y float64
+	switch v.Projection {
+	case ProjectionEquirectangular:
+		x, y = f.lng, f.lat
+	case ProjectionMercator:
+		lat := math.Max(-mercatorMaxLat, math.Min(mercatorMaxLat, f.lat))
+		x, y = f.lng, math.Log(math.Tan(math.Pi/4+lat/2))
+	case ProjectionRobinson:
+		x, y = robinson(f.lng, f.lat)
+	case ProjectionMollweide:
+		x, y = mollweide(f.lng, f.lat)
+	case ProjectionAzimuthalEquidistant:
+		// Scale the direction from the center by the angle from it.
+		if s := math.Hypot(f.x, f.y); s > 0 {
+			c := math.Atan2(s, -f.z)
+			x, y = f.x*c/s, f.y*c/s
+		}
+	case ProjectionStereographic:
+		x, y = 2*f.x/(1-f.z), 2*f.y/(1-f.z)
+	}
+	return point{x * v.unit, y * v.unit, 0}
+}
+
+// resamplePrecision returns the distance in camera space that lines on a flat
+// map may deviate from the projected curves they approximate, for an image
+// with dimensions (side, side).
+func (v view) resamplePrecision(side float64) float64 {
+	return resampleTolerance / (v.zoom * v.perspective * side / 2)
+}
+
+// resample maps path to the plane, adding points where the projection of
+// its segments curves away from straight lines.
+func (v view) resample(path []framePoint) []point {
+	if len(path) == 0 {
+		return nil
+	}
+	a := v.planar(path[0])
+	out := []point{a}
+	for i := 1; i < len(path); i++ {
+		b := v.planar(path[i])
+		out = v.resampleSegment(out, path[i-1], path[i], a, b, resampleDepth)
+		a = b
+	}
+	return out
+}
+
+// resampleRing maps ring to the plane as in resample, including the segment
+// joining its last point to the first.
+func (v view) resampleRing(ring []framePoint) []point {
+	if len(ring) == 0 {
+		return nil
+	}
+	out := v.resample(append(ring[:len(ring):len(ring)], ring[0]))
+	return out[:len(out)-1]
+}
+
+// resampleSegment appends the points of the segment from a to b, excluding a,
+// to out. The points pa and pb are a and b in the plane. The segment is split
+// in half while its midpoint is too far from the line between its ends, or it
+// is too long, up to depth times.
+func (v view) resampleSegment(out []point, a, b framePoint, pa, pb point, depth int) []point {
+	if depth > 0 {
+		m := frame(midpoint(a.point, b.point), a.lng)
+		pm := v.planar(m)
+		dx, dy := pm.x-(pa.x+pb.x)/2, pm.y-(pa.y+pb.y)/2
+		if math.Hypot(dx, dy) > v.precision || distance(a.point, b.point) > resampleChord {
+			out = v.resampleSegment(out, a, m, pa, pm, depth-1)
+			return v.resampleSegment(out, m, b, pm, pb, depth-1)
+		}
+	}
+	return append(out, pb)
+}
+
+// densify returns path with points added between those more than step apart
+// in camera space.
+func densify(path []point, step float64) []point {
+	if len(path) == 0 {
+		return nil
+	}
+	out := []point{path[0]}
+	for i := 1; i < len(path); i++ {
+		a, b := path[i-1], path[i]
+		n := math.Ceil(distance(a, b) / step)
+		for k := 1.0; k < n; k++ {
+			out = append(out, lerp(a, b, k/n))
+		}
+		out = append(out, b)
+	}
+	return out
+}
+
+// meshPath returns the pieces of path, in camera space, that are drawn. On the
+// globe this is the whole path, which is split at the horizon later. On flat
+// maps it is the pieces cut and clipped to the map, mapped to the plane.
+func (v view) meshPath(path []point) [][]point {
+	if v.Projection == ProjectionGlobe {
+		return [][]point{path}
+	}
+	if v.Projection.clipAngle() != 0 {
+		path = densify(path, azimuthalStep)
+	}
+	var pieces [][]point
+	for _, piece := range v.splitPath(path) {
+		if !v.visiblePiece(piece) {
+			continue
+		}
+		fs := framePath(piece, false)
+		if !v.Projection.cut() {
+			pieces = append(pieces, v.resample(fs))
+			continue
+		}
+		for _, cut := range cutPath(fs) {
+			pieces = append(pieces, v.resample(cut))
+		}
+	}
+	return pieces
+}
+
+// meshRing clips ring, in camera space, to the visible part of the view,
+// returning rings bounding the visible part of the region it encloses. On flat
+// maps the rings are mapped to the plane.
+func (v view) meshRing(ring []point) [][]point {
+	switch {
+	case v.Projection == ProjectionGlobe:
+		return v.clipRing(ring)
+	case v.Projection.cut():
+		return v.cutRing(ring)
+	}
+	var rings [][]point
+	for _, r := range v.clipRing(densify(ring, azimuthalStep)) {
+		rings = append(rings, v.resampleRing(framePath(r, true)))
+	}
+	return rings
+}
+
+// outline returns a ring around the visible part of the view: the horizon of
+// the globe, or the edge of a flat map.
+func (v view) outline() []point {
+	if v.Projection.cut() {
+		return v.outlineArc(0, 2*math.Pi, 1)
+	}
+	arc := v.horizonArc(0, 2*math.Pi, 1)
+	if v.Projection != ProjectionGlobe {
+		for i, p := range arc {
+			arc[i] = v.planar(framePoint{point: p})
+		}
+	}
+	return arc
+}
+
+// cutRing cuts ring, in camera space, where it crosses the cut of a
+// cylindrical or pseudocylindrical map, returning rings in the plane bounding
+// the region it encloses. Pieces of the ring are joined along the edge of the
+// map, like rings clipped to the horizon. Positions along the edge are given
+// by angles, running from the north pole down the east edge and up the west.
+func (v view) cutRing(ring []point) [][]point {
+	n := len(ring)
+	if n > 1 && ring[0] == ring[n-1] {
+		ring = ring[:n-1]
+		n--
+	}
+	if n < 3 {
+		return nil
+	}
+	fs := framePath(ring, true)
+
+	// Start from a crossing, so that no piece wraps around.
+	start := -1
+	for i := range fs {
+		if _, _, ok := crossCut(fs[i], fs[(i+1)%n]); ok {
+			start = i
+			break
+		}
+	}
+
+	// Rings that do not cross the cut either enclose all of it or none of it.
+	if start < 0 {
+		out := v.resampleRing(fs)
+		if ringContains(ring, point{0, 0, 1}) {
+			return [][]point{v.outline(), out}
+		}
+		return [][]point{out}
+	}
+
+	var pieces []clipPiece
+	var piece []framePoint
+	add := func(exit framePoint) {
+		piece = append(piece, exit)
+		pieces = append(pieces, clipPiece{
+			points: v.resample(piece),
+			entry:  outlinePosition(piece[0]),
+			exit:   outlinePosition(exit),
+		})
+	}
+	for k := 0; k < n; k++ {
+		a, b := fs[(start+k)%n], fs[(start+k+1)%n]
+		if exit, entry, ok := crossCut(a, b); ok {
+			if k > 0 {
+				add(exit)
+			}
+			piece = []framePoint{entry}
+		}
+		piece = append(piece, b)
+	}
+	// The last piece leaves the map where the first entered it.
+	exit, _, _ := crossCut(fs[start], fs[(start+1)%n])
+	add(exit)
+
+	return joinPieces(ring, pieces, boundary{cutPoint, v.outlineArc})
+}
+
+// outlinePosition returns the angle along the edge of a cut map of f, which
+// must be on the cut.
+func outlinePosition(f framePoint) float64 {
+	if f.lng > 0 {
+		return math.Pi/2 - f.lat
+	}
+	return 3*math.Pi/2 + f.lat
+}
+
+// outlineFrame returns the frame point at angle a along the edge of a cut map.
+func outlineFrame(a float64) framePoint {
+	a = angularDistance(0, a, 1)
+	f := framePoint{lng: math.Pi, lat: math.Pi/2 - a}
+	if a > math.Pi {
+		f.lng, f.lat = -math.Pi, a-3*math.Pi/2
+	}
+	f.point = cutPoint(a)
+	return f
+}
+
+// cutPoint returns the point in camera space at angle a along the edge of a
+// cut map.
+func cutPoint(a float64) point {
+	lat := math.Pi/2 - angularDistance(0, a, 1)
+	if lat < -math.Pi/2 {
+		lat = -math.Pi - lat
+	}
+	return point{0, math.Sin(lat), math.Cos(lat)}
+}
+
+// outlineArc returns points in the plane along the edge of a cut map starting
+// at angle a and travelling angle length in direction d, as in horizonArc.
+// Where the arc passes a pole, it turns along the pole from one edge of the
+// map to the other.
+func (v view) outlineArc(a, length, d float64) []point {
+	steps := int(math.Ceil(length / horizonArcStep))
+	var arc []point
+	prev := a
+	for k := 1; k <= steps; k++ {
+		s := a + d*length*float64(k)/float64(steps)
+		// Poles are at multiples of π. Those after prev, up to and including
+		// s, are passed in this step.
+		m := math.Floor(prev/math.Pi) + 1
+		if d < 0 {
+			m = math.Ceil(prev/math.Pi) - 1
+		}
+		pole := false
+		for ; d*(s-m*math.Pi) >= 0; m += d {
+			arc = append(arc, v.poleCorners(m*math.Pi, d)...)
+			pole = m*math.Pi == s
+		}
+		if k < steps && !pole {
+			arc = append(arc, v.planar(outlineFrame(s)))
+		}
+		prev = s
+	}
+	if length >= 2*math.Pi {
+		arc = append(arc, v.planar(outlineFrame(a)))
+	}
+	return arc
+}
+
+// poleCorners returns the corners in the plane where the edge of a cut map at
+// angle a, a pole, turns from one side of the map to the other, travelling in
+// direction d. Anticlockwise, the edge turns from east to west at the south
+// pole and from west to east at the north pole.
+func (v view) poleCorners(a, d float64) []point {
+	p := cutPoint(a)
+	lat := math.Copysign(math.Pi/2, p.y)
+	lng := -d * math.Copysign(math.Pi, lat)
+	return []point{
+		v.planar(framePoint{point: p, lng: lng, lat: lat}),
+		v.planar(framePoint{point: p, lng: -lng, lat: lat}),
+	}
+}
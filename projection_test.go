@@ -0,0 +1,146 @@
+package globe
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func ProjectedGlobe(p Projection) *Globe {
+	g := New()
+	s := DefaultStyle
+	s.Projection = p
+	g.SetStyle(s)
+	g.DrawGraticule(15.0)
+	g.DrawLand()
+	g.DrawLandBoundaries()
+	g.DrawLine(37.6, -122.4, -33.9, 151.2)
+	g.DrawDot(51.453349, -2.588323, 0.1)
+	g.CenterOn(40, 150)
+	return g
+}
+
+func TestProjectionEquirectangular(t *testing.T) {
+	AssertSVGMD5(t, ProjectedGlobe(ProjectionEquirectangular), "ff1be1b094fa289324a05d881d62a72f")
+}
+
+func TestProjectionMercator(t *testing.T) {
+	AssertSVGMD5(t, ProjectedGlobe(ProjectionMercator), "42c3bd7f5f23812bc8a5aa5d7945a1c9")
+}
+
+func TestProjectionRobinson(t *testing.T) {
+	AssertSVGMD5(t, ProjectedGlobe(ProjectionRobinson), "a782945aed9ba5de01be0f75e77b2adf")
+}
+
+func TestProjectionMollweide(t *testing.T) {
+	AssertSVGMD5(t, ProjectedGlobe(ProjectionMollweide), "e1f3aeafb6c3ccfdc786f36fbcfe15cf")
+}
+
+func TestProjectionAzimuthalEquidistant(t *testing.T) {
+	AssertSVGMD5(t, ProjectedGlobe(ProjectionAzimuthalEquidistant), "77baf75661de6b3f5bd9ce5ca6744bca")
+}
+
+func TestProjectionStereographic(t *testing.T) {
+	AssertSVGMD5(t, ProjectedGlobe(ProjectionStereographic), "39d557e954fddb34bb8d4584ad70cfc5")
+}
+
+func TestProjectionPlanar(t *testing.T) {
+	cases := []struct {
+		Projection Projection
+		Lat, Lng   float64
+		X, Y       float64
+	}{
+		{ProjectionEquirectangular, 30, -45, -math.Pi / 4, math.Pi / 6},
+		{ProjectionMercator, 45, 90, math.Pi / 2, 0.881373587},
+		{ProjectionMercator, 89, 0, 0, math.Pi},
+		{ProjectionRobinson, 45, 180, 0.8962 * 0.8487 * math.Pi, 0.5571 * 1.3523},
+		{ProjectionRobinson, -2.5, 0, 0, -1.3523 * 0.031},
+		{ProjectionMollweide, 90, 0, 0, math.Sqrt2},
+		{ProjectionMollweide, 0, 180, 2 * math.Sqrt2, 0},
+		{ProjectionAzimuthalEquidistant, 0, 90, math.Pi / 2, 0},
+		{ProjectionAzimuthalEquidistant, -60, 0, 0, -math.Pi / 3},
+		{ProjectionStereographic, 0, 90, 2, 0},
+		{ProjectionStereographic, 0, 0, 0, 0},
+	}
+	for _, c := range cases {
+		v := view{Style: Style{Projection: c.Projection}, unit: 1}
+		p := v.planar(frame(v.transform(latlng{c.Lat, c.Lng}), 0))
+		assert.InDelta(t, c.X, p.x, 1e-6, "projection %d (%v, %v)", c.Projection, c.Lat, c.Lng)
+		assert.InDelta(t, c.Y, p.y, 1e-6, "projection %d (%v, %v)", c.Projection, c.Lat, c.Lng)
+		assert.Equal(t, 0.0, p.z)
+	}
+}
+
+func TestCutPath(t *testing.T) {
+	v := New().view(Camera{Lng: 0})
+	var path []point
+	for _, lng := range []float64{170, 175, -175, -170} {
+		path = append(path, v.transform(latlng{10, lng}))
+	}
+	pieces := cutPath(framePath(path, false))
+	require.Len(t, pieces, 2)
+	east, west := pieces[0], pieces[1]
+	require.Len(t, east, 3)
+	require.Len(t, west, 3)
+	assert.Equal(t, math.Pi, east[2].lng)
+	assert.Equal(t, -math.Pi, west[0].lng)
+	assert.InDelta(t, degToRad(10), east[2].lat, 1e-2)
+	assert.Equal(t, east[2].lat, west[0].lat)
+
+	// Paths crossing the meridian at the center of the view are not cut.
+	path = path[:0]
+	for _, lng := range []float64{-10, 10} {
+		path = append(path, v.transform(latlng{10, lng}))
+	}
+	assert.Len(t, cutPath(framePath(path, false)), 1)
+}
+
+func TestFramePathAmbiguous(t *testing.T) {
+	v := New().view(Camera{Lng: 0})
+	var path []point
+	for _, ll := range []latlng{{-90, 30}, {0, 180}, {10, 170}, {20, 180}, {90, 0}} {
+		path = append(path, v.transform(ll))
+	}
+	fs := framePath(path, false)
+	assert.InDelta(t, degToRad(170), fs[0].lng, 1e-9)
+	assert.Equal(t, math.Pi, fs[1].lng)
+	assert.Equal(t, math.Pi, fs[3].lng)
+	assert.Equal(t, math.Pi, fs[4].lng)
+}
+
+func TestCutRingPole(t *testing.T) {
+	g := New()
+	s := DefaultStyle
+	s.Projection = ProjectionEquirectangular
+	g.SetStyle(s)
+	v := g.view(Camera{Lng: 0})
+	v.precision = 1e-3
+
+	// A ring around the north pole is closed along the top of the map.
+	var ring []point
+	for lng := -180.0; lng < 180; lng += 10 {
+		ring = append(ring, v.transform(latlng{60, lng + 5}))
+	}
+	rings := v.cutRing(ring)
+	require.Len(t, rings, 1)
+	east := v.planar(framePoint{lng: math.Pi, lat: math.Pi / 2})
+	west := v.planar(framePoint{lng: -math.Pi, lat: math.Pi / 2})
+	assert.Contains(t, rings[0], east)
+	assert.Contains(t, rings[0], west)
+	for _, p := range rings[0] {
+		assert.True(t, p.y >= v.planar(framePoint{lat: degToRad(59)}).y)
+	}
+
+	// Rings not crossing the cut are unchanged, unless they enclose it.
+	var small []point
+	for _, ll := range []latlng{{5, -5}, {5, 5}, {-5, 5}, {-5, -5}} {
+		small = append(small, v.transform(ll))
+	}
+	require.Len(t, v.cutRing(small), 1)
+	for i := range small {
+		small[i].x, small[i].z = -small[i].x, -small[i].z
+	}
+	assert.Len(t, v.cutRing(small), 2)
+}
@@ -0,0 +1,27 @@
+package globe
+
+import (
+	"github.com/mmcloughlin/globe/shapefile"
+)
+
+// DrawShapefile reads the shapefile with the given .shp file name and draws its
+// shapes, as in DrawFeatureCollection. Attributes are not needed to draw
+// shapes; use shapefile.Open to select features by attribute before drawing.
+// Nothing is drawn if any coordinates are out of range for longitude and
+// latitude, as in shapefiles with a projected coordinate system.
+func (g *Globe) DrawShapefile(filename string, style ...Option) error {
+	fc, err := shapefile.Open(filename)
+	if err != nil {
+		return err
+	}
+	for _, f := range fc.Features {
+		if f.Geometry == nil {
+			continue
+		}
+		if err := checkLatLngs(f.Geometry); err != nil {
+			return err
+		}
+	}
+	g.DrawFeatureCollection(fc, style...)
+	return nil
+}
@@ -0,0 +1,118 @@
+package shapefile
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strconv"
+	"strings"
+)
+
+// dBASE table layout.
+const (
+	dbfHeaderSize     = 32
+	dbfFieldSize      = 32
+	dbfFieldNameSize  = 11
+	dbfFieldTerminate = 0x0d
+	dbfDeleted        = '*'
+)
+
+// table is a dBASE table. Deleted records are nil.
+type table struct {
+	records []map[string]interface{}
+}
+
+// field describes a column of a dBASE table.
+type field struct {
+	name   string
+	typ    byte
+	length int
+}
+
+// readTable reads a dBASE table from r. Character fields are returned as
+// strings with trailing spaces removed, numeric fields as float64, logical
+// fields as bool, and other fields as strings. Blank numeric and logical
+// values are nil.
+func readTable(r io.Reader) (*table, error) {
+	b, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	if len(b) < dbfHeaderSize {
+		return nil, errors.New("shapefile: dbf too short for header")
+	}
+	numRecords := int(binary.LittleEndian.Uint32(b[4:]))
+	headerLen := int(binary.LittleEndian.Uint16(b[8:]))
+	recordLen := int(binary.LittleEndian.Uint16(b[10:]))
+	if headerLen > len(b) || recordLen < 1 {
+		return nil, errors.New("shapefile: invalid dbf header")
+	}
+
+	var fields []field
+	width := 1
+	for pos := dbfHeaderSize; pos+dbfFieldSize <= headerLen && b[pos] != dbfFieldTerminate; pos += dbfFieldSize {
+		d := b[pos : pos+dbfFieldSize]
+		name := string(d[:dbfFieldNameSize])
+		if i := strings.IndexByte(name, 0); i >= 0 {
+			name = name[:i]
+		}
+		f := field{name: name, typ: d[11], length: int(d[16])}
+		fields = append(fields, f)
+		width += f.length
+	}
+	if width > recordLen {
+		return nil, fmt.Errorf("shapefile: dbf fields are %d bytes wide but records are %d", width, recordLen)
+	}
+	if headerLen+numRecords*recordLen > len(b) {
+		return nil, errors.New("shapefile: truncated dbf")
+	}
+
+	t := &table{records: make([]map[string]interface{}, numRecords)}
+	for i := range t.records {
+		rec := b[headerLen+i*recordLen : headerLen+(i+1)*recordLen]
+		if rec[0] == dbfDeleted {
+			continue
+		}
+		props := map[string]interface{}{}
+		pos := 1
+		for _, f := range fields {
+			v, err := f.value(string(rec[pos : pos+f.length]))
+			if err != nil {
+				return nil, fmt.Errorf("shapefile: dbf record %d: %s", i+1, err)
+			}
+			props[f.name] = v
+			pos += f.length
+		}
+		t.records[i] = props
+	}
+	return t, nil
+}
+
+// value parses the value s of field f.
+func (f field) value(s string) (interface{}, error) {
+	switch f.typ {
+	case 'N', 'F':
+		s = strings.TrimSpace(s)
+		if s == "" || strings.Trim(s, "*") == "" {
+			return nil, nil
+		}
+		v, err := strconv.ParseFloat(s, 64)
+		if err != nil {
+			return nil, fmt.Errorf("field %s: invalid number %q", f.name, s)
+		}
+		return v, nil
+	case 'L':
+		switch strings.TrimSpace(s) {
+		case "T", "t", "Y", "y":
+			return true, nil
+		case "F", "f", "N", "n":
+			return false, nil
+		}
+		return nil, nil
+	case 'C':
+		return strings.TrimRight(s, " \x00"), nil
+	}
+	return strings.TrimSpace(s), nil
+}
@@ -0,0 +1,119 @@
+package shapefile
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// buildTable returns a dBASE table with the given fields and records. Records
+// whose index is in deleted are marked deleted.
+func buildTable(fields []field, records [][]string, deleted map[int]bool) []byte {
+	recordLen := 1
+	for _, f := range fields {
+		recordLen += f.length
+	}
+	headerLen := dbfHeaderSize + dbfFieldSize*len(fields) + 1
+
+	var buf bytes.Buffer
+	h := make([]byte, dbfHeaderSize)
+	h[0] = 3
+	binary.LittleEndian.PutUint32(h[4:], uint32(len(records)))
+	binary.LittleEndian.PutUint16(h[8:], uint16(headerLen))
+	binary.LittleEndian.PutUint16(h[10:], uint16(recordLen))
+	buf.Write(h)
+	for _, f := range fields {
+		d := make([]byte, dbfFieldSize)
+		copy(d, f.name)
+		d[11] = f.typ
+		d[16] = byte(f.length)
+		buf.Write(d)
+	}
+	buf.WriteByte(dbfFieldTerminate)
+
+	for i, r := range records {
+		flag := byte(' ')
+		if deleted[i] {
+			flag = dbfDeleted
+		}
+		buf.WriteByte(flag)
+		for j, f := range fields {
+			v := make([]byte, f.length)
+			for k := range v {
+				v[k] = ' '
+			}
+			copy(v, r[j])
+			buf.Write(v)
+		}
+	}
+	buf.WriteByte(0x1a)
+	return buf.Bytes()
+}
+
+func TestReadTable(t *testing.T) {
+	fields := []field{
+		{name: "NAME", typ: 'C', length: 12},
+		{name: "POP_EST", typ: 'N', length: 10},
+		{name: "SCALE", typ: 'F', length: 6},
+		{name: "ISLAND", typ: 'L', length: 1},
+		{name: "UPDATED", typ: 'D', length: 8},
+	}
+	b := buildTable(fields, [][]string{
+		{"São Tomé", "  211028", "1.5", "T", "20200101"},
+		{"Deleted", "1", "", "F", ""},
+		{"Blank", "", "", "?", ""},
+	}, map[int]bool{1: true})
+
+	tbl, err := readTable(bytes.NewReader(b))
+	require.NoError(t, err)
+	require.Len(t, tbl.records, 3)
+	assert.Equal(t, map[string]interface{}{
+		"NAME":    "São Tomé",
+		"POP_EST": 211028.0,
+		"SCALE":   1.5,
+		"ISLAND":  true,
+		"UPDATED": "20200101",
+	}, tbl.records[0])
+	assert.Nil(t, tbl.records[1])
+	assert.Equal(t, map[string]interface{}{
+		"NAME":    "Blank",
+		"POP_EST": nil,
+		"SCALE":   nil,
+		"ISLAND":  nil,
+		"UPDATED": "",
+	}, tbl.records[2])
+}
+
+func TestReadTableErrors(t *testing.T) {
+	fields := []field{{name: "N", typ: 'N', length: 4}}
+	b := buildTable(fields, [][]string{{"1"}, {"x"}}, nil)
+
+	_, err := readTable(bytes.NewReader(b[:10]))
+	assert.EqualError(t, err, "shapefile: dbf too short for header")
+	_, err = readTable(bytes.NewReader(b[:len(b)-4]))
+	assert.EqualError(t, err, "shapefile: truncated dbf")
+	_, err = readTable(bytes.NewReader(b))
+	assert.EqualError(t, err, `shapefile: dbf record 2: field N: invalid number "x"`)
+}
+
+func TestDecodeTable(t *testing.T) {
+	shp, _ := build(shape{Point, 1.0, 2.0}, shape{Point, 3.0, 4.0}, shape{Point, 5.0, 6.0})
+	fields := []field{{name: "ISO_N3", typ: 'C', length: 3}}
+	dbf := buildTable(fields, [][]string{{"826"}, {"250"}, {"840"}}, map[int]bool{1: true})
+
+	fc, err := Decode(bytes.NewReader(shp), nil, bytes.NewReader(dbf))
+	require.NoError(t, err)
+	// Deleted records are skipped.
+	require.Len(t, fc.Features, 2)
+	assert.Equal(t, []float64{5, 6}, fc.Features[1].Geometry.Point)
+	v, ok := fc.Features[1].PropertyString("ISO_N3")
+	assert.True(t, ok)
+	assert.Equal(t, "840", v)
+
+	short := buildTable(fields, [][]string{{"826"}}, nil)
+	_, err = Decode(bytes.NewReader(shp), nil, bytes.NewReader(short))
+	assert.EqualError(t, err, "shapefile: 3 shapes but 1 dbf records")
+}
@@ -0,0 +1,397 @@
+// Package shapefile reads ESRI shapefiles, with the attributes of their dBASE
+// tables, as GeoJSON features.
+package shapefile
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/mmcloughlin/globe/geojson"
+)
+
+// ShapeType is the type of the shapes in a shapefile.
+type ShapeType int32
+
+// Shape types.
+const (
+	Null        ShapeType = 0
+	Point       ShapeType = 1
+	PolyLine    ShapeType = 3
+	Polygon     ShapeType = 5
+	MultiPoint  ShapeType = 8
+	PointZ      ShapeType = 11
+	PolyLineZ   ShapeType = 13
+	PolygonZ    ShapeType = 15
+	MultiPointZ ShapeType = 18
+	PointM      ShapeType = 21
+	PolyLineM   ShapeType = 23
+	PolygonM    ShapeType = 25
+	MultiPointM ShapeType = 28
+)
+
+// base returns the shape type without Z or M values corresponding to t.
+func (t ShapeType) base() ShapeType {
+	switch t {
+	case PointZ, PointM:
+		return Point
+	case PolyLineZ, PolyLineM:
+		return PolyLine
+	case PolygonZ, PolygonM:
+		return Polygon
+	case MultiPointZ, MultiPointM:
+		return MultiPoint
+	}
+	return t
+}
+
+// Main file header layout.
+const (
+	fileCode   = 9994
+	headerSize = 100
+	version    = 1000
+)
+
+// Open reads the shapefile with the given .shp file name, as in Decode. The
+// .shx index and .dbf attributes are read from files with the same name and
+// those extensions, if they exist.
+func Open(filename string) (*geojson.FeatureCollection, error) {
+	shp, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer shp.Close()
+
+	ext := filepath.Ext(filename)
+	base := strings.TrimSuffix(filename, ext)
+	sibling := func(e string) (*os.File, error) {
+		if ext == strings.ToUpper(ext) {
+			e = strings.ToUpper(e)
+		}
+		f, err := os.Open(base + e)
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return f, err
+	}
+
+	var shx, dbf io.Reader
+	if f, err := sibling(".shx"); err != nil {
+		return nil, err
+	} else if f != nil {
+		defer f.Close()
+		shx = f
+	}
+	if f, err := sibling(".dbf"); err != nil {
+		return nil, err
+	} else if f != nil {
+		defer f.Close()
+		dbf = f
+	}
+	return Decode(shp, shx, dbf)
+}
+
+// Decode reads the shapes of a shapefile from the main file shp, with the
+// attributes of each shape from the dBASE table dbf as feature properties.
+// Records are located with the index shx. Both shx and dbf may be nil.
+//
+// Points, multipoints, polylines and polygons are supported, including those
+// with Z and M values, which are ignored. Polygon rings are grouped into
+// polygons by their winding order: clockwise rings are outer boundaries, and
+// counterclockwise rings are holes in the outer ring containing them. Shapes
+// are converted to the GeoJSON geometry of the same type, or the
+// multi-geometry if they have several parts. Null shapes have no geometry.
+func Decode(shp, shx, dbf io.Reader) (*geojson.FeatureCollection, error) {
+	b, err := ioutil.ReadAll(shp)
+	if err != nil {
+		return nil, err
+	}
+	size, err := readHeader(b)
+	if err != nil {
+		return nil, err
+	}
+	if size > len(b) {
+		size = len(b)
+	}
+
+	// Locate records with the index, or by reading sequentially.
+	var offsets []int
+	if shx != nil {
+		offsets, err = readIndex(shx)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		for pos := headerSize; pos+8 <= size; {
+			offsets = append(offsets, pos)
+			pos += 8 + 2*int(binary.BigEndian.Uint32(b[pos+4:]))
+		}
+	}
+
+	var table *table
+	if dbf != nil {
+		table, err = readTable(dbf)
+		if err != nil {
+			return nil, err
+		}
+		if len(table.records) != len(offsets) {
+			return nil, fmt.Errorf("shapefile: %d shapes but %d dbf records", len(offsets), len(table.records))
+		}
+	}
+
+	fc := &geojson.FeatureCollection{}
+	for i, offset := range offsets {
+		if table != nil && table.records[i] == nil {
+			// Deleted record.
+			continue
+		}
+		geom, err := readRecord(b, offset)
+		if err != nil {
+			return nil, fmt.Errorf("shapefile: record %d: %s", i+1, err)
+		}
+		f := &geojson.Feature{Geometry: geom}
+		if table != nil {
+			f.Properties = table.records[i]
+		}
+		fc.Features = append(fc.Features, f)
+	}
+	return fc, nil
+}
+
+// readHeader reads the header of a main or index file, returning its length
+// in bytes.
+func readHeader(b []byte) (int, error) {
+	if len(b) < headerSize {
+		return 0, errors.New("shapefile: file too short for header")
+	}
+	if code := int32(binary.BigEndian.Uint32(b)); code != fileCode {
+		return 0, fmt.Errorf("shapefile: invalid file code %d", code)
+	}
+	if v := int32(binary.LittleEndian.Uint32(b[28:])); v != version {
+		return 0, fmt.Errorf("shapefile: unsupported version %d", v)
+	}
+	return 2 * int(binary.BigEndian.Uint32(b[24:])), nil
+}
+
+// readIndex reads the offsets of records from the index file r.
+func readIndex(r io.Reader) ([]int, error) {
+	b, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := readHeader(b); err != nil {
+		return nil, fmt.Errorf("%s in index", err)
+	}
+	if (len(b)-headerSize)%8 != 0 {
+		return nil, errors.New("shapefile: truncated index")
+	}
+	var offsets []int
+	for pos := headerSize; pos < len(b); pos += 8 {
+		offsets = append(offsets, 2*int(binary.BigEndian.Uint32(b[pos:])))
+	}
+	return offsets, nil
+}
+
+// readRecord reads the shape of the record at offset in the main file b.
+func readRecord(b []byte, offset int) (*geojson.Geometry, error) {
+	if offset < headerSize || offset+8 > len(b) {
+		return nil, fmt.Errorf("offset %d out of range", offset)
+	}
+	length := 2 * int(binary.BigEndian.Uint32(b[offset+4:]))
+	start := offset + 8
+	if length < 4 || start+length > len(b) {
+		return nil, errors.New("truncated")
+	}
+	r := &record{b: b[start : start+length]}
+
+	typ := ShapeType(r.int())
+	switch typ.base() {
+	case Null:
+		return nil, nil
+	case Point:
+		return &geojson.Geometry{Type: geojson.Point, Point: r.point()}, r.err
+	case MultiPoint:
+		r.skip(32)
+		n := r.count(16)
+		g := &geojson.Geometry{Type: geojson.MultiPoint}
+		for i := 0; i < n && r.err == nil; i++ {
+			g.MultiPoint = append(g.MultiPoint, r.point())
+		}
+		return g, r.err
+	case PolyLine, Polygon:
+		parts := r.parts()
+		if r.err != nil {
+			return nil, r.err
+		}
+		if len(parts) == 0 {
+			return nil, nil
+		}
+		if typ.base() == Polygon {
+			return polygon(parts), nil
+		}
+		if len(parts) == 1 {
+			return &geojson.Geometry{Type: geojson.LineString, LineString: parts[0]}, nil
+		}
+		return &geojson.Geometry{Type: geojson.MultiLineString, MultiLineString: parts}, nil
+	}
+	return nil, fmt.Errorf("unsupported shape type %d", typ)
+}
+
+// polygon groups rings into a polygon, or multipolygon if there are several
+// outer rings. Each hole is assigned to the first outer ring containing it.
+func polygon(rings [][][]float64) *geojson.Geometry {
+	var outer, holes [][][]float64
+	for _, ring := range rings {
+		if signedArea(ring) <= 0 {
+			outer = append(outer, ring)
+		} else {
+			holes = append(holes, ring)
+		}
+	}
+	// Some writers ignore winding order.
+	if len(outer) == 0 {
+		outer, holes = holes, nil
+	}
+
+	polygons := make([][][][]float64, len(outer))
+	for i, ring := range outer {
+		polygons[i] = [][][]float64{ring}
+	}
+	for _, hole := range holes {
+		i := len(polygons) - 1
+		for j, ring := range outer {
+			if contains(ring, hole[0]) {
+				i = j
+				break
+			}
+		}
+		polygons[i] = append(polygons[i], hole)
+	}
+
+	if len(polygons) == 1 {
+		return &geojson.Geometry{Type: geojson.Polygon, Polygon: polygons[0]}
+	}
+	return &geojson.Geometry{Type: geojson.MultiPolygon, MultiPolygon: polygons}
+}
+
+// signedArea returns twice the planar area of ring, which is negative if the
+// ring is clockwise.
+func signedArea(ring [][]float64) float64 {
+	a := 0.0
+	for i := 1; i < len(ring); i++ {
+		a += ring[i-1][0]*ring[i][1] - ring[i][0]*ring[i-1][1]
+	}
+	return a
+}
+
+// contains reports whether the planar ring contains p.
+func contains(ring [][]float64, p []float64) bool {
+	in := false
+	for i, j := 0, len(ring)-1; i < len(ring); j, i = i, i+1 {
+		a, b := ring[i], ring[j]
+		if (a[1] > p[1]) != (b[1] > p[1]) &&
+			p[0] < (b[0]-a[0])*(p[1]-a[1])/(b[1]-a[1])+a[0] {
+			in = !in
+		}
+	}
+	return in
+}
+
+// record reads the little endian values of a record's content. After an
+// error, reads return zero values.
+type record struct {
+	b   []byte
+	pos int
+	err error
+}
+
+func (r *record) skip(n int) {
+	if r.err == nil && r.pos+n > len(r.b) {
+		r.err = errors.New("truncated")
+	}
+	if r.err != nil {
+		return
+	}
+	r.pos += n
+}
+
+func (r *record) int() int32 {
+	start := r.pos
+	r.skip(4)
+	if r.err != nil {
+		return 0
+	}
+	return int32(binary.LittleEndian.Uint32(r.b[start:]))
+}
+
+func (r *record) float() float64 {
+	start := r.pos
+	r.skip(8)
+	if r.err != nil {
+		return 0
+	}
+	return math.Float64frombits(binary.LittleEndian.Uint64(r.b[start:]))
+}
+
+// point reads an x, y position.
+func (r *record) point() []float64 {
+	x := r.float()
+	y := r.float()
+	return []float64{x, y}
+}
+
+// count reads the number of items in a list, checking that there are enough
+// bytes left for items of size bytes.
+func (r *record) count(size int) int {
+	n := int(r.int())
+	if r.err == nil && (n < 0 || n*size > len(r.b)-r.pos) {
+		r.err = fmt.Errorf("invalid count %d", n)
+	}
+	if r.err != nil {
+		return 0
+	}
+	return n
+}
+
+// parts reads the parts of a polyline or polygon.
+func (r *record) parts() [][][]float64 {
+	r.skip(32)
+	numParts := r.count(4)
+	numPoints := int(r.int())
+	starts := make([]int, numParts)
+	for i := range starts {
+		starts[i] = int(r.int())
+	}
+	if r.err == nil && (numPoints < 0 || numPoints*16 > len(r.b)-r.pos) {
+		r.err = fmt.Errorf("invalid count %d", numPoints)
+	}
+	if r.err != nil {
+		return nil
+	}
+
+	var parts [][][]float64
+	for i, start := range starts {
+		end := numPoints
+		if i+1 < numParts {
+			end = starts[i+1]
+		}
+		if start < 0 || start > end || end > numPoints {
+			r.err = fmt.Errorf("invalid part %d", i)
+			return nil
+		}
+		var part [][]float64
+		for j := start; j < end; j++ {
+			part = append(part, r.point())
+		}
+		if len(part) > 0 {
+			parts = append(parts, part)
+		}
+	}
+	return parts
+}
@@ -0,0 +1,185 @@
+package shapefile
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/mmcloughlin/globe/geojson"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// shape is the content of a shapefile record, written little endian. Ints
+// are written as int32 and floats as float64.
+type shape []interface{}
+
+func (s shape) bytes() []byte {
+	var buf bytes.Buffer
+	for _, v := range s {
+		switch v := v.(type) {
+		case int:
+			binary.Write(&buf, binary.LittleEndian, int32(v))
+		case ShapeType:
+			binary.Write(&buf, binary.LittleEndian, int32(v))
+		case float64:
+			binary.Write(&buf, binary.LittleEndian, v)
+		}
+	}
+	return buf.Bytes()
+}
+
+// header returns a main or index file header for a file of size bytes.
+func header(size int) []byte {
+	h := make([]byte, headerSize)
+	binary.BigEndian.PutUint32(h, fileCode)
+	binary.BigEndian.PutUint32(h[24:], uint32(size/2))
+	binary.LittleEndian.PutUint32(h[28:], version)
+	return h
+}
+
+// build returns the main and index files of a shapefile of the given shapes.
+func build(shapes ...shape) (shp, shx []byte) {
+	var records, index bytes.Buffer
+	for i, s := range shapes {
+		content := s.bytes()
+		offset := headerSize + records.Len()
+		binary.Write(&records, binary.BigEndian, int32(i+1))
+		binary.Write(&records, binary.BigEndian, int32(len(content)/2))
+		records.Write(content)
+		binary.Write(&index, binary.BigEndian, int32(offset/2))
+		binary.Write(&index, binary.BigEndian, int32(len(content)/2))
+	}
+	shp = append(header(headerSize+records.Len()), records.Bytes()...)
+	shx = append(header(headerSize+index.Len()), index.Bytes()...)
+	return shp, shx
+}
+
+// square returns a clockwise square ring with corners x0, y0 and x1, y1.
+func square(x0, y0, x1, y1 float64) []interface{} {
+	return []interface{}{x0, y0, x0, y1, x1, y1, x1, y0, x0, y0}
+}
+
+// polyShape returns a polyline or polygon record with the given parts, each a
+// flat list of coordinates.
+func polyShape(typ ShapeType, parts ...[]interface{}) shape {
+	s := shape{typ, 0.0, 0.0, 0.0, 0.0, len(parts)}
+	n := 0
+	for _, p := range parts {
+		n += len(p) / 2
+	}
+	s = append(s, n)
+	start := 0
+	for _, p := range parts {
+		s = append(s, start)
+		start += len(p) / 2
+	}
+	for _, p := range parts {
+		s = append(s, p...)
+	}
+	return s
+}
+
+var testShapes = []shape{
+	{Point, 1.0, 2.0},
+	{Null},
+	{MultiPoint, 0.0, 0.0, 0.0, 0.0, 2, 1.0, 2.0, 3.0, 4.0},
+	polyShape(PolyLine, []interface{}{0.0, 0.0, 1.0, 1.0}),
+	polyShape(PolyLine, []interface{}{0.0, 0.0, 1.0, 1.0}, []interface{}{2.0, 2.0, 3.0, 3.0}),
+	// A clockwise outer ring with a counterclockwise hole, and a second outer
+	// ring.
+	polyShape(Polygon,
+		square(0, 0, 10, 10),
+		[]interface{}{2.0, 2.0, 4.0, 2.0, 4.0, 4.0, 2.0, 4.0, 2.0, 2.0},
+		square(20, 0, 30, 10),
+	),
+	append(polyShape(PolygonZ, square(0, 0, 10, 10)), 0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 0.0),
+	{PointM, 5.0, 6.0, 7.0},
+}
+
+// reader returns a reader of b, or nil if b is nil.
+func reader(b []byte) io.Reader {
+	if b == nil {
+		return nil
+	}
+	return bytes.NewReader(b)
+}
+
+func TestDecode(t *testing.T) {
+	shp, shx := build(testShapes...)
+	for _, index := range [][]byte{nil, shx} {
+		fc, err := Decode(bytes.NewReader(shp), reader(index), nil)
+		require.NoError(t, err)
+		require.Len(t, fc.Features, len(testShapes))
+
+		gs := make([]*geojson.Geometry, len(fc.Features))
+		for i, f := range fc.Features {
+			gs[i] = f.Geometry
+			assert.Nil(t, f.Properties)
+		}
+		assert.Equal(t, &geojson.Geometry{Type: geojson.Point, Point: []float64{1, 2}}, gs[0])
+		assert.Nil(t, gs[1])
+		assert.Equal(t, &geojson.Geometry{Type: geojson.MultiPoint, MultiPoint: [][]float64{{1, 2}, {3, 4}}}, gs[2])
+		assert.Equal(t, &geojson.Geometry{Type: geojson.LineString, LineString: [][]float64{{0, 0}, {1, 1}}}, gs[3])
+		assert.Equal(t, geojson.MultiLineString, gs[4].Type)
+		assert.Len(t, gs[4].MultiLineString, 2)
+
+		require.Equal(t, geojson.MultiPolygon, gs[5].Type)
+		require.Len(t, gs[5].MultiPolygon, 2)
+		assert.Len(t, gs[5].MultiPolygon[0], 2)
+		assert.Equal(t, []float64{2, 2}, gs[5].MultiPolygon[0][1][0])
+		assert.Len(t, gs[5].MultiPolygon[1], 1)
+
+		require.Equal(t, geojson.Polygon, gs[6].Type)
+		assert.Len(t, gs[6].Polygon[0], 5)
+		assert.Equal(t, &geojson.Geometry{Type: geojson.Point, Point: []float64{5, 6}}, gs[7])
+	}
+}
+
+func TestDecodeErrors(t *testing.T) {
+	shp, shx := build(shape{Point, 1.0, 2.0})
+	multipatch, _ := build(shape{ShapeType(31), 0.0})
+	truncated, _ := build(shape{MultiPoint, 0.0, 0.0, 0.0, 0.0, 2, 1.0, 2.0})
+	cases := []struct {
+		Name     string
+		SHP, SHX []byte
+		Error    string
+	}{
+		{"Short", shp[:50], nil, "shapefile: file too short for header"},
+		{"FileCode", append([]byte{0, 0, 0, 1}, shp[4:]...), nil, "shapefile: invalid file code 1"},
+		{"Index", shp, shx[:50], "shapefile: file too short for header in index"},
+		{"Type", multipatch, nil, "shapefile: record 1: unsupported shape type 31"},
+		{"Count", truncated, nil, "shapefile: record 1: invalid count 2"},
+	}
+	for _, c := range cases {
+		t.Run(c.Name, func(t *testing.T) {
+			_, err := Decode(bytes.NewReader(c.SHP), reader(c.SHX), nil)
+			assert.EqualError(t, err, c.Error)
+		})
+	}
+}
+
+func TestOpen(t *testing.T) {
+	dir, err := ioutil.TempDir("", "shapefile")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	shp, shx := build(shape{Point, 1.0, 2.0}, shape{Point, 3.0, 4.0})
+	dbf := buildTable([]field{{name: "NAME", typ: 'C', length: 8}}, [][]string{{"first"}, {"second"}}, nil)
+	for ext, b := range map[string][]byte{".SHP": shp, ".SHX": shx, ".DBF": dbf} {
+		require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "points"+ext), b, 0644))
+	}
+
+	fc, err := Open(filepath.Join(dir, "points.SHP"))
+	require.NoError(t, err)
+	require.Len(t, fc.Features, 2)
+	assert.Equal(t, map[string]interface{}{"NAME": "second"}, fc.Features[1].Properties)
+	assert.Equal(t, []float64{3, 4}, fc.Features[1].Geometry.Point)
+
+	_, err = Open(filepath.Join(dir, "missing.shp"))
+	assert.True(t, os.IsNotExist(err))
+}
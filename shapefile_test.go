@@ -0,0 +1,74 @@
+package globe
+
+import (
+	"encoding/binary"
+	"io/ioutil"
+	"math"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testdata/features.shp holds the polygon and line of testGeoJSON and a point
+// at Bristol, with NAME attributes.
+
+func TestDrawShapefile(t *testing.T) {
+	g := New()
+	require.NoError(t, g.DrawShapefile("testdata/features.shp"))
+	require.Len(t, g.layers, 3)
+
+	polygon := g.layers[0]
+	assert.Equal(t, DefaultStyle.FillColor, polygon.fill)
+	require.Len(t, polygon.rings, 1)
+
+	lines := g.layers[1]
+	require.Len(t, lines.paths, 1)
+	path := lines.paths[0]
+	assert.Equal(t, latlng{51.453349, -2.588323}, path[0])
+	assert.Equal(t, latlng{37.7749, -122.4194}, path[len(path)-1])
+
+	assert.Equal(t, []dot{{latlng{51.453349, -2.588323}, defaultDotRadius}}, g.layers[2].dots)
+}
+
+func TestDrawShapefileError(t *testing.T) {
+	g := New()
+	err := g.DrawShapefile("testdata/missing.shp")
+	assert.True(t, os.IsNotExist(err))
+	assert.Empty(t, g.layers)
+}
+
+func TestDrawShapefileProjected(t *testing.T) {
+	dir, err := ioutil.TempDir("", "globe")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	// A point shapefile with British National Grid coordinates of Bristol.
+	shp := make([]byte, 128)
+	binary.BigEndian.PutUint32(shp, 9994)
+	binary.BigEndian.PutUint32(shp[24:], 64)
+	binary.LittleEndian.PutUint32(shp[28:], 1000)
+	binary.LittleEndian.PutUint32(shp[32:], 1)
+	binary.BigEndian.PutUint32(shp[100:], 1)
+	binary.BigEndian.PutUint32(shp[104:], 10)
+	binary.LittleEndian.PutUint32(shp[108:], 1)
+	binary.LittleEndian.PutUint64(shp[112:], math.Float64bits(358800))
+	binary.LittleEndian.PutUint64(shp[120:], math.Float64bits(172700))
+	filename := filepath.Join(dir, "projected.shp")
+	require.NoError(t, ioutil.WriteFile(filename, shp, 0644))
+
+	g := New()
+	err = g.DrawShapefile(filename)
+	assert.EqualError(t, err, "globe: position (358800 172700) out of range for longitude and latitude")
+	assert.Empty(t, g.layers)
+}
+
+func TestSVGDrawShapefile(t *testing.T) {
+	g := New()
+	g.DrawGraticule(10)
+	require.NoError(t, g.DrawShapefile("testdata/features.shp"))
+	g.CenterOn(35, -60)
+	AssertSVGMD5(t, g, "6b3ff48254d0d62ce44b5800b84da34b")
+}
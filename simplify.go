@@ -0,0 +1,102 @@
+package globe
+
+import "math"
+
+// defaultTolerance is the tolerance, in pixels, used when the Style Tolerance
+// is zero.
+const defaultTolerance = 0.25
+
+// simplifyTolerance returns the distance in camera space that simplified paths
+// may deviate from the original, for an image with dimensions (side, side). It
+// is zero if simplification is disabled.
+func (v view) simplifyTolerance(side float64) float64 {
+	t := v.Tolerance
+	switch {
+	case t < 0:
+		return 0
+	case t == 0:
+		t = defaultTolerance
+	}
+	f := side / 2
+	if v.Projection != ProjectionGlobe {
+		// Flat maps are drawn at about their scale at the center of the view,
+		// though some projections magnify regions far from it.
+		return t / (v.unit * v.zoom * v.perspective * f)
+	}
+	// The scale in pixels of camera space is greatest at the center of the
+	// view, so the tolerance holds everywhere.
+	return t * (1 - v.perspective) / (v.zoom * v.perspective * f)
+}
+
+// simplifyPath removes points from path, in camera space, which deviate from
+// the simplified path by at most tolerance, using the Douglas-Peucker
+// algorithm on the sphere. Segments are also kept short enough that their
+// chords deviate from the surface of the globe by at most tolerance, so
+// simplified paths still follow its curve. The path is returned unchanged if
+// tolerance is zero.
+func simplifyPath(path []point, tolerance float64) []point {
+	if tolerance <= 0 || len(path) < 3 {
+		return path
+	}
+
+	keep := make([]bool, len(path))
+	keep[0], keep[len(path)-1] = true, true
+	spans := [][2]int{{0, len(path) - 1}}
+	for len(spans) > 0 {
+		i, j := spans[len(spans)-1][0], spans[len(spans)-1][1]
+		spans = spans[:len(spans)-1]
+		if j-i < 2 {
+			continue
+		}
+
+		a, b := path[i], path[j]
+		k, d := i, -1.0
+		for m := i + 1; m < j; m++ {
+			if e := arcDistance(path[m], a, b); e > d {
+				k, d = m, e
+			}
+		}
+		if d <= tolerance {
+			if sagitta(a, b) <= tolerance {
+				continue
+			}
+			k = (i + j) / 2
+		}
+
+		keep[k] = true
+		spans = append(spans, [2]int{i, k}, [2]int{k, j})
+	}
+
+	simplified := make([]point, 0, len(path))
+	for i, p := range path {
+		if keep[i] {
+			simplified = append(simplified, p)
+		}
+	}
+	return simplified
+}
+
+// arcDistance returns the distance, for small distances, of p from the shorter
+// great circle arc between a and b. All points are on the unit sphere.
+func arcDistance(p, a, b point) float64 {
+	n := cross(a, b)
+	if norm(n) < 1e-12 {
+		return math.Min(distance(p, a), distance(p, b))
+	}
+	// Points beyond the ends of the arc are nearest to an end.
+	if dot3(cross(a, p), n) < 0 || dot3(cross(p, b), n) < 0 {
+		return math.Min(distance(p, a), distance(p, b))
+	}
+	return math.Abs(dot3(p, n)) / norm(n)
+}
+
+// sagitta returns the greatest distance of the chord between a and b, on the
+// unit sphere, from the surface of the sphere.
+func sagitta(a, b point) float64 {
+	return 1 - norm(point{a.x + b.x, a.y + b.y, a.z + b.z})/2
+}
+
+// norm returns the length of p.
+func norm(p point) float64 {
+	return math.Sqrt(dot3(p, p))
+}
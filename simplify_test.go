@@ -0,0 +1,109 @@
+package globe
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// ArcPath returns n+1 points in camera space along the meridian through
+// (0, 0), from latitude 0 to lat.
+func ArcPath(lat float64, n int) []point {
+	v := New().view(Camera{})
+	path := make([]point, n+1)
+	for i := range path {
+		path[i] = v.transform(latlng{lat * float64(i) / float64(n), 0})
+	}
+	return path
+}
+
+func TestSimplifyPath(t *testing.T) {
+	short := ArcPath(1, 100)
+	assert.Equal(t, short, simplifyPath(short, 0))
+	assert.Equal(t, []point{short[0], short[100]}, simplifyPath(short, 1e-3))
+
+	// Long arcs are kept close to the surface of the globe.
+	long := ArcPath(90, 100)
+	simplified := simplifyPath(long, 1e-3)
+	assert.True(t, len(simplified) < len(long))
+	for i := 1; i < len(simplified); i++ {
+		assert.True(t, sagitta(simplified[i-1], simplified[i]) <= 1e-3)
+	}
+
+	// Deviations larger than the tolerance are kept.
+	zigzag := ArcPath(1, 4)
+	zigzag[2].x += 0.01
+	assert.Len(t, simplifyPath(zigzag, 1e-3), 5)
+	assert.Len(t, simplifyPath(zigzag, 0.1), 2)
+
+	// Closed rings keep their extent.
+	v := New().view(Camera{})
+	var ring []point
+	for lng := 0.0; lng <= 360; lng += 10 {
+		ring = append(ring, v.transform(latlng{60, lng}))
+	}
+	simplified = simplifyPath(ring, 1e-3)
+	require.True(t, len(simplified) > 4)
+	assert.Equal(t, ring[0], simplified[0])
+	assert.Equal(t, ring[len(ring)-1], simplified[len(simplified)-1])
+}
+
+func TestArcDistance(t *testing.T) {
+	a, b := point{1, 0, 0}, point{0, 1, 0}
+	assert.InDelta(t, 0, arcDistance(normalize(point{1, 1, 0}), a, b), 1e-12)
+	assert.InDelta(t, 0.1, arcDistance(normalize(point{1, 1, 0.1 * 1.4142135623730951}), a, b), 1e-3)
+	// Beyond the end of the arc.
+	assert.InDelta(t, distance(point{0, -1, 0}, a), arcDistance(point{0, -1, 0}, a, b), 1e-12)
+	// Degenerate arcs.
+	assert.InDelta(t, distance(b, a), arcDistance(b, a, a), 1e-12)
+}
+
+func TestSimplifyTolerance(t *testing.T) {
+	g := New()
+	v := g.view(g.camera)
+	base := v.simplifyTolerance(512)
+	assert.True(t, base > 0)
+	assert.InDelta(t, base/2, v.simplifyTolerance(1024), 1e-15)
+
+	v = g.view(Camera{Zoom: 4})
+	assert.InDelta(t, base/4, v.simplifyTolerance(512), 1e-15)
+
+	s := DefaultStyle
+	s.Tolerance = 1
+	g.SetStyle(s)
+	v = g.view(g.camera)
+	assert.InDelta(t, base/defaultTolerance, v.simplifyTolerance(512), 1e-15)
+
+	s.Tolerance = -1
+	g.SetStyle(s)
+	v = g.view(g.camera)
+	assert.Equal(t, 0.0, v.simplifyTolerance(512))
+}
+
+func TestSimplifyLevelOfDetail(t *testing.T) {
+	count := func(g *Globe, side float64) int {
+		_, meshes := g.render(g.camera, side)
+		n := 0
+		for _, m := range meshes {
+			for _, path := range m.paths {
+				n += len(path)
+			}
+		}
+		return n
+	}
+
+	g := New()
+	g.DrawLandBoundaries()
+	small, large := count(g, 128), count(g, 4096)
+	assert.True(t, small < large)
+
+	exact := g.Clone()
+	exact.SetStyle(ExactStyle())
+	assert.True(t, large < count(exact, 4096))
+
+	// Geometry drawn by other methods is not simplified.
+	graticule := New()
+	graticule.DrawGraticule(10)
+	assert.Equal(t, count(graticule, 4096), count(graticule, 128))
+}
@@ -0,0 +1,118 @@
+package globe
+
+import (
+	"bytes"
+	"fmt"
+	"image/color"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// WriteSVG writes the visualization to w in SVG format with dimensions
+// (side, side). Each drawing call is written as one path element, with dots as
+// circles, except that paths drawn with VertexColors are written as one element
+// for each color. Filled regions are written beneath all lines and dots, and
+// the legend above them.
+func (g *Globe) WriteSVG(w io.Writer, side int) error {
+	s := float64(side)
+	v, meshes := g.render(g.camera, s)
+	buf := new(bytes.Buffer)
+	fmt.Fprintf(buf, "<svg xmlns=\"http://www.w3.org/2000/svg\" width=\"%d\" height=\"%d\" viewBox=\"0 0 %d %d\">\n", side, side, side, side)
+	if v.Background != nil {
+		fmt.Fprintf(buf, "<rect width=\"%d\" height=\"%d\"%s/>\n", side, side, svgPaint("fill", v.Background))
+	}
+
+	for _, l := range meshes {
+		if len(l.fills) > 0 {
+			buf.WriteString("<path d=\"")
+			v.svgPathData(buf, l.fills, s, true)
+			fmt.Fprintf(buf, "\"%s fill-rule=\"evenodd\"/>\n", svgPaint("fill", l.fill))
+		}
+	}
+
+	width := v.lineWidthAtZ(0, s)
+	for _, l := range meshes {
+		if l.colors != nil {
+			// Paths with their own colors are written as separate elements.
+			for i, path := range l.paths {
+				v.svgStroke(buf, [][]point{path}, l.colors[i], width, s)
+			}
+		} else if len(l.paths) > 0 {
+			v.svgStroke(buf, l.paths, l.color, width, s)
+		}
+		for _, d := range l.dots {
+			x, y := v.project(d.point, s)
+			r := v.lineWidthAtZ(d.z, s) * dotScale(d.radius) / 2
+			fmt.Fprintf(buf, "<circle cx=\"%s\" cy=\"%s\" r=\"%s\"%s/>\n",
+				svgNumber(x), svgNumber(y), svgNumber(r), svgPaint("fill", l.color))
+		}
+	}
+
+	if g.legend != nil {
+		v.svgLegend(buf, g.legend, s)
+	}
+
+	buf.WriteString("</svg>\n")
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+// SaveSVG writes the visualization to filename in SVG format with dimensions
+// (side, side).
+func (g *Globe) SaveSVG(filename string, side int) error {
+	f, err := os.Create(filename)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	return g.WriteSVG(f, side)
+}
+
+// svgStroke writes a path element drawing paths, in camera space, in color c.
+func (v view) svgStroke(buf *bytes.Buffer, paths [][]point, c color.Color, width, side float64) {
+	buf.WriteString("<path d=\"")
+	v.svgPathData(buf, paths, side, false)
+	fmt.Fprintf(buf, "\" fill=\"none\"%s stroke-width=\"%s\" stroke-linecap=\"round\" stroke-linejoin=\"round\"/>\n",
+		svgPaint("stroke", c), svgNumber(width))
+}
+
+// svgPathData writes SVG path data for paths in camera space to buf, closing
+// each path if closed is set.
+func (v view) svgPathData(buf *bytes.Buffer, paths [][]point, side float64, closed bool) {
+	for _, path := range paths {
+		for i, p := range path {
+			cmd := 'L'
+			if i == 0 {
+				cmd = 'M'
+			}
+			x, y := v.project(p, side)
+			fmt.Fprintf(buf, "%c%s %s", cmd, svgNumber(x), svgNumber(y))
+		}
+		if closed {
+			buf.WriteByte('Z')
+		}
+	}
+}
+
+// svgPaint formats color c as the SVG paint attribute attr, with an opacity
+// attribute if required.
+func svgPaint(attr string, c color.Color) string {
+	n := color.NRGBAModel.Convert(c).(color.NRGBA)
+	s := fmt.Sprintf(" %s=\"#%02x%02x%02x\"", attr, n.R, n.G, n.B)
+	if n.A != 0xff {
+		s += fmt.Sprintf(" %s-opacity=\"%s\"", attr, svgNumber(float64(n.A)/0xff))
+	}
+	return s
+}
+
+// svgNumber formats x for SVG output.
+func svgNumber(x float64) string {
+	s := strconv.FormatFloat(x, 'f', 2, 64)
+	s = strings.TrimRight(strings.TrimRight(s, "0"), ".")
+	if s == "-0" {
+		return "0"
+	}
+	return s
+}
@@ -0,0 +1,74 @@
+package globe
+
+import (
+	"bytes"
+	"crypto/md5"
+	"encoding/hex"
+	"fmt"
+	"image/color"
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func AssertSVGMD5(t *testing.T, g *Globe, expect string) {
+	buf := new(bytes.Buffer)
+	err := g.WriteSVG(buf, 1024)
+	require.NoError(t, err)
+	if *outputImages {
+		filename := fmt.Sprintf("%s.svg", CallingFunction())
+		err := ioutil.WriteFile(filename, buf.Bytes(), 0644)
+		require.NoError(t, err)
+	}
+	h := md5.Sum(buf.Bytes())
+	assert.Equal(t, expect, hex.EncodeToString(h[:]))
+}
+
+func TestSVGGraticule(t *testing.T) {
+	g := New()
+	g.DrawGraticule(10.0)
+	AssertSVGMD5(t, g, "2587c5fd9156615822aa68c5e38019d8")
+}
+
+func TestSVGDrawLand(t *testing.T) {
+	g := New()
+	g.DrawLandBoundaries()
+	g.CenterOn(51.453349, -2.588323)
+	AssertSVGMD5(t, g, "5033eaeced300d917dd9fbfc0f6ae228")
+}
+
+func TestSVGLineDots(t *testing.T) {
+	g := New()
+	g.DrawLine(51.453349, -2.588323, 40.645423, -73.903879, Color(color.NRGBA{0, 0, 255, 128}))
+	g.DrawDot(51.453349, -2.588323, 0.1)
+	g.DrawDot(40.645423, -73.903879, 0.1)
+	g.CenterOn(30, -37)
+	AssertSVGMD5(t, g, "7df3b0e725c141089d1d1045be869b25")
+}
+
+func TestSVGRhumbLine(t *testing.T) {
+	g := New()
+	g.DrawLine(51.453349, -2.588323, 40.645423, -73.903879)
+	g.DrawRhumbLine(51.453349, -2.588323, 40.645423, -73.903879, Color(color.NRGBA{255, 0, 0, 255}))
+	g.DrawRhumbLine(70, -120, 80, 100, Color(color.NRGBA{0, 0, 255, 255}))
+	g.CenterOn(60, -37)
+	AssertSVGMD5(t, g, "fa07bbf08529898633d2df8a4aeabeaf")
+}
+
+func TestSVGElements(t *testing.T) {
+	g := New()
+	g.DrawGraticule(30.0)
+	g.DrawDot(0, 0, 0.1)
+	buf := new(bytes.Buffer)
+	require.NoError(t, g.WriteSVG(buf, 400))
+	s := buf.String()
+	assert.True(t, strings.HasPrefix(s, "<svg "))
+	assert.Equal(t, 1, strings.Count(s, "<rect "))
+	assert.Equal(t, 5+12, strings.Count(s, "<path "))
+	assert.Equal(t, 1, strings.Count(s, "<circle "))
+	assert.Contains(t, s, `stroke="#c0c0c0"`)
+	assert.Contains(t, s, `fill="#ff0000"`)
+}
@@ -0,0 +1,62 @@
+package globe
+
+import (
+	"io"
+
+	"github.com/mmcloughlin/globe/topojson"
+)
+
+// DrawTopoJSON reads a TopoJSON topology from r and draws the named object, as
+// in DrawFeatureCollection, except that lines and the outlines of polygons are
+// drawn from the arcs of the topology in a single layer. Each arc is drawn
+// once, so borders shared by polygons are not drawn twice. All objects are
+// drawn, in order of name, if object is empty.
+func (g *Globe) DrawTopoJSON(r io.Reader, object string, style ...Option) error {
+	t, err := topojson.Decode(r)
+	if err != nil {
+		return err
+	}
+
+	names := []string{object}
+	if object == "" {
+		names = t.ObjectNames()
+	}
+	for _, name := range names {
+		fc, err := t.FeatureCollection(name)
+		if err != nil {
+			return err
+		}
+		mesh, err := t.Mesh(name)
+		if err != nil {
+			return err
+		}
+
+		var s geojsonShapes
+		for _, f := range fc.Features {
+			if f.Geometry != nil {
+				s.add(f.Geometry)
+			}
+		}
+		for _, polygon := range s.polygons {
+			g.fillPolygon(polygon, style...)
+		}
+		lines := make([][]latlng, len(mesh))
+		for i, arc := range mesh {
+			lines[i] = geojsonPositions(arc)
+		}
+		g.drawLines(lines, style...)
+		g.drawPoints(s.points, style...)
+	}
+	return nil
+}
+
+// fillPolygon fills a polygon with the given rings, the first of which is the
+// exterior and the rest holes, without outlining it. Edges follow great
+// circles.
+func (g *Globe) fillPolygon(rings [][]latlng, style ...Option) {
+	defer g.styled(FillColor(g.style.FillColor), style...)()
+	g.cur.simplify = true
+	for _, ring := range rings {
+		g.fillRing(greatCirclePath(ring))
+	}
+}
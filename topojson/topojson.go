@@ -0,0 +1,428 @@
+// Package topojson decodes TopoJSON topologies and converts their objects to
+// GeoJSON.
+package topojson
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/mmcloughlin/globe/geojson"
+)
+
+// TypeTopology is the type of a TopoJSON topology object.
+const TypeTopology = "Topology"
+
+// Topology is a decoded TopoJSON topology. Quantized and delta-encoded
+// positions are decoded, so all positions are absolute.
+type Topology struct {
+	BBox    []float64
+	Objects map[string]*Geometry
+	Arcs    [][][]float64
+}
+
+// Geometry is a TopoJSON geometry object. Lines and polygons reference arcs of
+// the topology by index, where the ones' complement of an index refers to the
+// arc reversed. Only the fields corresponding to the geometry type are set.
+type Geometry struct {
+	Type       geojson.GeometryType
+	ID         interface{}
+	Properties map[string]interface{}
+
+	Point      []float64
+	MultiPoint [][]float64
+
+	LineString      []int
+	MultiLineString [][]int
+	Polygon         [][]int
+	MultiPolygon    [][][]int
+
+	Geometries []*Geometry
+}
+
+// transform is a TopoJSON quantization transform.
+type transform struct {
+	Scale     [2]float64 `json:"scale"`
+	Translate [2]float64 `json:"translate"`
+}
+
+// position decodes the quantized position p in place.
+func (t *transform) position(p []float64) {
+	if t == nil {
+		return
+	}
+	p[0] = p[0]*t.Scale[0] + t.Translate[0]
+	p[1] = p[1]*t.Scale[1] + t.Translate[1]
+}
+
+// topology is the JSON representation of a topology.
+type topology struct {
+	Type      string                     `json:"type"`
+	BBox      []float64                  `json:"bbox"`
+	Transform *transform                 `json:"transform"`
+	Objects   map[string]json.RawMessage `json:"objects"`
+	Arcs      [][][]float64              `json:"arcs"`
+}
+
+// geometry is the JSON representation of a geometry object.
+type geometry struct {
+	Type        string                 `json:"type"`
+	ID          interface{}            `json:"id"`
+	Properties  map[string]interface{} `json:"properties"`
+	Coordinates json.RawMessage        `json:"coordinates"`
+	Arcs        json.RawMessage        `json:"arcs"`
+	Geometries  []json.RawMessage      `json:"geometries"`
+}
+
+// Decode reads a TopoJSON topology from r.
+func Decode(r io.Reader) (*Topology, error) {
+	var raw json.RawMessage
+	if err := json.NewDecoder(r).Decode(&raw); err != nil {
+		return nil, err
+	}
+	return Unmarshal(raw)
+}
+
+// Unmarshal parses the TopoJSON topology b.
+func Unmarshal(b []byte) (*Topology, error) {
+	var top topology
+	if err := json.Unmarshal(b, &top); err != nil {
+		return nil, err
+	}
+	if top.Type != TypeTopology {
+		return nil, fmt.Errorf("topojson: expected topology, got type %q", top.Type)
+	}
+
+	t := &Topology{
+		BBox:    top.BBox,
+		Objects: map[string]*Geometry{},
+		Arcs:    top.Arcs,
+	}
+
+	// Arc positions are delta-encoded when quantized.
+	for _, arc := range t.Arcs {
+		var x, y float64
+		for _, p := range arc {
+			if len(p) < 2 {
+				return nil, errPosition
+			}
+			if top.Transform != nil {
+				x += p[0]
+				y += p[1]
+				p[0], p[1] = x, y
+			}
+			top.Transform.position(p)
+		}
+	}
+
+	for name, raw := range top.Objects {
+		g, err := unmarshalGeometry(raw, top.Transform)
+		if err != nil {
+			return nil, fmt.Errorf("topojson: object %q: %s", name, err)
+		}
+		t.Objects[name] = g
+	}
+
+	return t, nil
+}
+
+// errPosition is returned for positions with fewer than two coordinates.
+var errPosition = errors.New("topojson: position must have at least two coordinates")
+
+// unmarshalGeometry parses the geometry object b, decoding positions with t.
+func unmarshalGeometry(b []byte, t *transform) (*Geometry, error) {
+	var raw geometry
+	if err := json.Unmarshal(b, &raw); err != nil {
+		return nil, err
+	}
+
+	g := &Geometry{
+		Type:       geojson.GeometryType(raw.Type),
+		ID:         raw.ID,
+		Properties: raw.Properties,
+	}
+
+	var field interface{}
+	data := raw.Arcs
+	switch g.Type {
+	case geojson.Point:
+		field, data = &g.Point, raw.Coordinates
+	case geojson.MultiPoint:
+		field, data = &g.MultiPoint, raw.Coordinates
+	case geojson.LineString:
+		field = &g.LineString
+	case geojson.MultiLineString:
+		field = &g.MultiLineString
+	case geojson.Polygon:
+		field = &g.Polygon
+	case geojson.MultiPolygon:
+		field = &g.MultiPolygon
+	case geojson.GeometryCollection:
+		for _, child := range raw.Geometries {
+			c, err := unmarshalGeometry(child, t)
+			if err != nil {
+				return nil, err
+			}
+			g.Geometries = append(g.Geometries, c)
+		}
+		return g, nil
+	case "":
+		// Null geometry objects have no type.
+		return g, nil
+	default:
+		return nil, fmt.Errorf("unknown geometry type %q", raw.Type)
+	}
+
+	if len(data) == 0 {
+		return nil, fmt.Errorf("%s geometry has no coordinates or arcs", g.Type)
+	}
+	if err := json.Unmarshal(data, field); err != nil {
+		return nil, fmt.Errorf("%s: %s", g.Type, err)
+	}
+
+	var points [][]float64
+	switch g.Type {
+	case geojson.Point:
+		points = [][]float64{g.Point}
+	case geojson.MultiPoint:
+		points = g.MultiPoint
+	}
+	for _, p := range points {
+		if len(p) < 2 {
+			return nil, errPosition
+		}
+		t.position(p)
+	}
+
+	return g, nil
+}
+
+// ObjectNames returns the names of the objects in the topology in sorted
+// order.
+func (t *Topology) ObjectNames() []string {
+	var names []string
+	for name := range t.Objects {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// FeatureCollection converts the named object to GeoJSON. As with the
+// reference implementation, a geometry collection is converted to a feature
+// for each of its geometries, and any other geometry to a single feature.
+func (t *Topology) FeatureCollection(name string) (*geojson.FeatureCollection, error) {
+	o, ok := t.Objects[name]
+	if !ok {
+		return nil, fmt.Errorf("topojson: unknown object %q", name)
+	}
+
+	geometries := []*Geometry{o}
+	if o.Type == geojson.GeometryCollection {
+		geometries = o.Geometries
+	}
+
+	fc := &geojson.FeatureCollection{}
+	for _, g := range geometries {
+		f, err := t.Feature(g)
+		if err != nil {
+			return nil, err
+		}
+		fc.Features = append(fc.Features, f)
+	}
+	return fc, nil
+}
+
+// Mesh returns the arcs referenced by the lines and polygons of the named
+// object, as lists of positions. Each arc is included once however many
+// geometries share it, as with the mesh function of the reference
+// implementation, so that borders between polygons may be drawn only once.
+func (t *Topology) Mesh(name string) ([][][]float64, error) {
+	o, ok := t.Objects[name]
+	if !ok {
+		return nil, fmt.Errorf("topojson: unknown object %q", name)
+	}
+
+	var arcs []int
+	seen := map[int]bool{}
+	add := func(refs []int) {
+		for _, i := range refs {
+			if i < 0 {
+				i = ^i
+			}
+			if !seen[i] {
+				seen[i] = true
+				arcs = append(arcs, i)
+			}
+		}
+	}
+	var walk func(g *Geometry)
+	walk = func(g *Geometry) {
+		add(g.LineString)
+		for _, line := range g.MultiLineString {
+			add(line)
+		}
+		for _, ring := range g.Polygon {
+			add(ring)
+		}
+		for _, polygon := range g.MultiPolygon {
+			for _, ring := range polygon {
+				add(ring)
+			}
+		}
+		for _, child := range g.Geometries {
+			walk(child)
+		}
+	}
+	walk(o)
+
+	var lines [][][]float64
+	for _, i := range arcs {
+		line, err := t.stitch([]int{i})
+		if err != nil {
+			return nil, err
+		}
+		if len(line) > 0 {
+			lines = append(lines, line)
+		}
+	}
+	return lines, nil
+}
+
+// Feature converts g to a GeoJSON feature. The feature geometry is nil for
+// null geometry objects.
+func (t *Topology) Feature(g *Geometry) (*geojson.Feature, error) {
+	f := &geojson.Feature{
+		ID:         g.ID,
+		Properties: g.Properties,
+	}
+	if g.Type == "" {
+		return f, nil
+	}
+	geom, err := t.geometry(g)
+	if err != nil {
+		return nil, err
+	}
+	f.Geometry = geom
+	return f, nil
+}
+
+// geometry converts g to a GeoJSON geometry.
+func (t *Topology) geometry(g *Geometry) (*geojson.Geometry, error) {
+	out := &geojson.Geometry{Type: g.Type}
+	var err error
+	switch g.Type {
+	case geojson.Point:
+		out.Point = g.Point
+	case geojson.MultiPoint:
+		out.MultiPoint = g.MultiPoint
+	case geojson.LineString:
+		out.LineString, err = t.line(g.LineString)
+	case geojson.MultiLineString:
+		out.MultiLineString, err = t.lines(g.MultiLineString)
+	case geojson.Polygon:
+		out.Polygon, err = t.polygon(g.Polygon)
+	case geojson.MultiPolygon:
+		for _, arcs := range g.MultiPolygon {
+			var polygon [][][]float64
+			polygon, err = t.polygon(arcs)
+			if err != nil {
+				break
+			}
+			out.MultiPolygon = append(out.MultiPolygon, polygon)
+		}
+	case geojson.GeometryCollection:
+		for _, child := range g.Geometries {
+			if child.Type == "" {
+				continue
+			}
+			var c *geojson.Geometry
+			c, err = t.geometry(child)
+			if err != nil {
+				break
+			}
+			out.Geometries = append(out.Geometries, c)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// stitch joins the referenced arcs into a single list of positions. The shared
+// end point of consecutive arcs is included once. Empty arcs are skipped.
+func (t *Topology) stitch(arcs []int) ([][]float64, error) {
+	var points [][]float64
+	for _, i := range arcs {
+		reverse := i < 0
+		if reverse {
+			i = ^i
+		}
+		if i >= len(t.Arcs) {
+			return nil, fmt.Errorf("topojson: arc index %d out of range", i)
+		}
+		arc := t.Arcs[i]
+		if len(arc) == 0 {
+			continue
+		}
+		if len(points) > 0 {
+			points = points[:len(points)-1]
+		}
+		for k := range arc {
+			p := arc[k]
+			if reverse {
+				p = arc[len(arc)-1-k]
+			}
+			points = append(points, []float64{p[0], p[1]})
+		}
+	}
+	return points, nil
+}
+
+// line converts the arcs of a line to positions.
+func (t *Topology) line(arcs []int) ([][]float64, error) {
+	points, err := t.stitch(arcs)
+	if err != nil {
+		return nil, err
+	}
+	// Degenerate lines are given a second position, as in the reference
+	// implementation.
+	if len(points) == 1 {
+		points = append(points, points[0])
+	}
+	return points, nil
+}
+
+// lines converts the arcs of several lines to positions.
+func (t *Topology) lines(arcs [][]int) ([][][]float64, error) {
+	var lines [][][]float64
+	for _, a := range arcs {
+		line, err := t.line(a)
+		if err != nil {
+			return nil, err
+		}
+		lines = append(lines, line)
+	}
+	return lines, nil
+}
+
+// polygon converts the arcs of the rings of a polygon to positions.
+func (t *Topology) polygon(arcs [][]int) ([][][]float64, error) {
+	var rings [][][]float64
+	for _, a := range arcs {
+		ring, err := t.stitch(a)
+		if err != nil {
+			return nil, err
+		}
+		// Degenerate rings are padded to four positions, as in the
+		// reference implementation.
+		for len(ring) > 0 && len(ring) < 4 {
+			ring = append(ring, ring[0])
+		}
+		rings = append(rings, ring)
+	}
+	return rings, nil
+}
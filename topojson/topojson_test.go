@@ -0,0 +1,209 @@
+package topojson
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/mmcloughlin/globe/geojson"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testTopology has two triangles sharing an edge, with quantized and
+// delta-encoded arcs.
+const testTopology = `{
+	"type": "Topology",
+	"transform": {"scale": [0.5, 0.25], "translate": [10, 20]},
+	"objects": {
+		"shapes": {
+			"type": "GeometryCollection",
+			"geometries": [
+				{"type": "Polygon", "id": "a", "properties": {"name": "A"}, "arcs": [[0, 1]]},
+				{"type": "Polygon", "id": 2, "arcs": [[-1, 2]]},
+				{"type": null, "id": "empty"}
+			]
+		},
+		"border": {"type": "LineString", "arcs": [0]},
+		"edges": {"type": "MultiLineString", "arcs": [[1], [-3]]},
+		"both": {"type": "MultiPolygon", "arcs": [[[0, 1]], [[-1, 2]]]},
+		"points": {"type": "MultiPoint", "coordinates": [[0, 0], [4, 8]]},
+		"point": {"type": "Point", "coordinates": [2, 4]}
+	},
+	"arcs": [
+		[[0, 0], [0, 4]],
+		[[0, 4], [-2, 0], [2, -4]],
+		[[0, 0], [2, 0], [-2, 4]]
+	]
+}`
+
+func TestDecodeArcs(t *testing.T) {
+	top, err := Decode(strings.NewReader(testTopology))
+	require.NoError(t, err)
+	assert.Equal(t, [][][]float64{
+		{{10, 20}, {10, 21}},
+		{{10, 21}, {9, 21}, {10, 20}},
+		{{10, 20}, {11, 20}, {10, 21}},
+	}, top.Arcs)
+	assert.Equal(t, []string{"border", "both", "edges", "point", "points", "shapes"}, top.ObjectNames())
+}
+
+func TestDecodeUnquantized(t *testing.T) {
+	src := `{
+		"type": "Topology",
+		"objects": {"line": {"type": "LineString", "arcs": [0, 1]}},
+		"arcs": [[[1.5, 2.5], [3.5, 4.5]], [[3.5, 4.5], [5.5, 6.5]]]
+	}`
+	top, err := Unmarshal([]byte(src))
+	require.NoError(t, err)
+	fc, err := top.FeatureCollection("line")
+	require.NoError(t, err)
+	require.Len(t, fc.Features, 1)
+	assert.Equal(t, [][]float64{{1.5, 2.5}, {3.5, 4.5}, {5.5, 6.5}}, fc.Features[0].Geometry.LineString)
+}
+
+func TestFeatureCollection(t *testing.T) {
+	top, err := Unmarshal([]byte(testTopology))
+	require.NoError(t, err)
+
+	fc, err := top.FeatureCollection("shapes")
+	require.NoError(t, err)
+	require.Len(t, fc.Features, 3)
+
+	a := fc.Features[0]
+	assert.Equal(t, "a", a.ID)
+	assert.Equal(t, map[string]interface{}{"name": "A"}, a.Properties)
+	assert.Equal(t, &geojson.Geometry{
+		Type:    geojson.Polygon,
+		Polygon: [][][]float64{{{10, 20}, {10, 21}, {9, 21}, {10, 20}}},
+	}, a.Geometry)
+
+	b := fc.Features[1]
+	assert.Equal(t, 2.0, b.ID)
+	assert.Equal(t, &geojson.Geometry{
+		Type:    geojson.Polygon,
+		Polygon: [][][]float64{{{10, 21}, {10, 20}, {11, 20}, {10, 21}}},
+	}, b.Geometry)
+
+	assert.Equal(t, "empty", fc.Features[2].ID)
+	assert.Nil(t, fc.Features[2].Geometry)
+}
+
+func TestFeatureCollectionGeometryTypes(t *testing.T) {
+	top, err := Unmarshal([]byte(testTopology))
+	require.NoError(t, err)
+
+	cases := []struct {
+		Object string
+		Expect *geojson.Geometry
+	}{
+		{
+			"border",
+			&geojson.Geometry{Type: geojson.LineString, LineString: [][]float64{{10, 20}, {10, 21}}},
+		},
+		{
+			"edges",
+			&geojson.Geometry{Type: geojson.MultiLineString, MultiLineString: [][][]float64{
+				{{10, 21}, {9, 21}, {10, 20}},
+				{{10, 21}, {11, 20}, {10, 20}},
+			}},
+		},
+		{
+			"both",
+			&geojson.Geometry{Type: geojson.MultiPolygon, MultiPolygon: [][][][]float64{
+				{{{10, 20}, {10, 21}, {9, 21}, {10, 20}}},
+				{{{10, 21}, {10, 20}, {11, 20}, {10, 21}}},
+			}},
+		},
+		{
+			"points",
+			&geojson.Geometry{Type: geojson.MultiPoint, MultiPoint: [][]float64{{10, 20}, {12, 22}}},
+		},
+		{
+			"point",
+			&geojson.Geometry{Type: geojson.Point, Point: []float64{11, 21}},
+		},
+	}
+	for _, c := range cases {
+		fc, err := top.FeatureCollection(c.Object)
+		require.NoError(t, err)
+		require.Len(t, fc.Features, 1)
+		assert.Equal(t, c.Expect, fc.Features[0].Geometry, c.Object)
+	}
+}
+
+func TestDegenerateRing(t *testing.T) {
+	src := `{
+		"type": "Topology",
+		"objects": {"p": {"type": "Polygon", "arcs": [[0]]}},
+		"arcs": [[[1, 2], [3, 4]]]
+	}`
+	top, err := Unmarshal([]byte(src))
+	require.NoError(t, err)
+	fc, err := top.FeatureCollection("p")
+	require.NoError(t, err)
+	assert.Equal(t, [][][]float64{{{1, 2}, {3, 4}, {1, 2}, {1, 2}}}, fc.Features[0].Geometry.Polygon)
+}
+
+func TestEmptyArc(t *testing.T) {
+	src := `{
+		"type": "Topology",
+		"objects": {"p": {"type": "Polygon", "arcs": [[0, 1, 2]]}},
+		"arcs": [[[0, 0], [1, 0], [1, 1]], [], [[1, 1], [0, 1], [0, 0]]]
+	}`
+	top, err := Unmarshal([]byte(src))
+	require.NoError(t, err)
+	fc, err := top.FeatureCollection("p")
+	require.NoError(t, err)
+	assert.Equal(t, [][][]float64{{{0, 0}, {1, 0}, {1, 1}, {0, 1}, {0, 0}}}, fc.Features[0].Geometry.Polygon)
+}
+
+func TestMesh(t *testing.T) {
+	top, err := Decode(strings.NewReader(testTopology))
+	require.NoError(t, err)
+
+	// The edge shared by the two triangles is included once.
+	mesh, err := top.Mesh("shapes")
+	require.NoError(t, err)
+	assert.Equal(t, [][][]float64{
+		{{10, 20}, {10, 21}},
+		{{10, 21}, {9, 21}, {10, 20}},
+		{{10, 20}, {11, 20}, {10, 21}},
+	}, mesh)
+
+	mesh, err = top.Mesh("edges")
+	require.NoError(t, err)
+	assert.Equal(t, [][][]float64{
+		{{10, 21}, {9, 21}, {10, 20}},
+		{{10, 20}, {11, 20}, {10, 21}},
+	}, mesh)
+
+	mesh, err = top.Mesh("points")
+	require.NoError(t, err)
+	assert.Empty(t, mesh)
+
+	_, err = top.Mesh("y")
+	assert.EqualError(t, err, `topojson: unknown object "y"`)
+}
+
+func TestErrors(t *testing.T) {
+	cases := []struct {
+		Source string
+		Error  string
+	}{
+		{`{"type": "FeatureCollection"}`, `topojson: expected topology, got type "FeatureCollection"`},
+		{`{"type": "Topology", "arcs": [[[1]]]}`, "topojson: position must have at least two coordinates"},
+		{`{"type": "Topology", "objects": {"x": {"type": "Circle"}}}`, `topojson: object "x": unknown geometry type "Circle"`},
+		{`{"type": "Topology", "objects": {"x": {"type": "Polygon"}}}`, `topojson: object "x": Polygon geometry has no coordinates or arcs`},
+	}
+	for _, c := range cases {
+		_, err := Unmarshal([]byte(c.Source))
+		assert.EqualError(t, err, c.Error)
+	}
+
+	top, err := Unmarshal([]byte(`{"type": "Topology", "objects": {"x": {"type": "LineString", "arcs": [3]}}, "arcs": []}`))
+	require.NoError(t, err)
+	_, err = top.FeatureCollection("x")
+	assert.EqualError(t, err, "topojson: arc index 3 out of range")
+	_, err = top.FeatureCollection("y")
+	assert.EqualError(t, err, `topojson: unknown object "y"`)
+}
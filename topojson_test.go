@@ -0,0 +1,100 @@
+package globe
+
+import (
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testTopoJSON = `{
+	"type": "Topology",
+	"transform": {"scale": [0.01, 0.01], "translate": [-10, 40]},
+	"objects": {
+		"regions": {
+			"type": "GeometryCollection",
+			"geometries": [
+				{"type": "Polygon", "id": "west", "arcs": [[0, 1]]},
+				{"type": "Polygon", "id": "east", "arcs": [[-1, 2]]}
+			]
+		},
+		"cities": {"type": "MultiPoint", "coordinates": [[500, 500], [1500, 500]]}
+	},
+	"arcs": [
+		[[1000, 0], [0, 1000]],
+		[[1000, 1000], [-1000, 0], [0, -1000], [1000, 0]],
+		[[1000, 0], [1000, 0], [0, 1000], [-1000, 0]]
+	]
+}`
+
+func TestDrawTopoJSON(t *testing.T) {
+	g := New()
+	err := g.DrawTopoJSON(strings.NewReader(testTopoJSON), "regions")
+	require.NoError(t, err)
+	require.Len(t, g.layers, 3)
+	for _, l := range g.layers[:2] {
+		assert.Equal(t, DefaultStyle.FillColor, l.fill)
+		assert.Empty(t, l.paths)
+		require.Len(t, l.rings, 1)
+	}
+	west := g.layers[0].rings[0]
+	assert.Equal(t, latlng{40, 0}, west[0])
+	assert.Equal(t, west[0], west[len(west)-1])
+
+	// Outlines are drawn from the three arcs, in one layer.
+	mesh := g.layers[2]
+	assert.Equal(t, DefaultStyle.LineColor, mesh.color)
+	assert.Nil(t, mesh.fill)
+	assert.Len(t, mesh.paths, 3)
+}
+
+func TestDrawTopoJSONSharedBorder(t *testing.T) {
+	g := New()
+	err := g.DrawTopoJSON(strings.NewReader(testTopoJSON), "regions")
+	require.NoError(t, err)
+
+	// The border between west and east runs along the prime meridian from
+	// 40 to 50 degrees north. Each of its segments is stroked once.
+	strokes := map[[2]latlng]int{}
+	for _, l := range g.layers {
+		for _, path := range l.paths {
+			for i := 1; i < len(path); i++ {
+				a, b := path[i-1], path[i]
+				if math.Abs(a.lng) > 1e-9 || math.Abs(b.lng) > 1e-9 {
+					continue
+				}
+				if b.lat < a.lat {
+					a, b = b, a
+				}
+				strokes[[2]latlng{a, b}]++
+			}
+		}
+	}
+	require.NotEmpty(t, strokes)
+	for segment, n := range strokes {
+		assert.Equal(t, 1, n, segment)
+	}
+}
+
+func TestDrawTopoJSONAllObjects(t *testing.T) {
+	g := New()
+	err := g.DrawTopoJSON(strings.NewReader(testTopoJSON), "")
+	require.NoError(t, err)
+	require.Len(t, g.layers, 4)
+	// Objects are drawn in order of name.
+	assert.Len(t, g.layers[0].dots, 2)
+	assert.Len(t, g.layers[1].rings, 1)
+	assert.Len(t, g.layers[2].rings, 1)
+	assert.Len(t, g.layers[3].paths, 3)
+}
+
+func TestDrawTopoJSONErrors(t *testing.T) {
+	g := New()
+	err := g.DrawTopoJSON(strings.NewReader(testTopoJSON), "rivers")
+	assert.EqualError(t, err, `topojson: unknown object "rivers"`)
+	err = g.DrawTopoJSON(strings.NewReader(testGeoJSON), "")
+	assert.EqualError(t, err, `topojson: expected topology, got type "FeatureCollection"`)
+	assert.Empty(t, g.layers)
+}
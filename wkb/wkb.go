@@ -0,0 +1,294 @@
+// Package wkb parses geometries in the well-known binary representation of the
+// OGC Simple Features specification, and PostGIS extended WKB with an SRID,
+// into GeoJSON geometries.
+package wkb
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"math"
+
+	"github.com/mmcloughlin/globe/geojson"
+)
+
+// Geometry type codes.
+var types = map[uint32]geojson.GeometryType{
+	1: geojson.Point,
+	2: geojson.LineString,
+	3: geojson.Polygon,
+	4: geojson.MultiPoint,
+	5: geojson.MultiLineString,
+	6: geojson.MultiPolygon,
+	7: geojson.GeometryCollection,
+}
+
+// Extended WKB flags of the geometry type.
+const (
+	flagZ    = 0x80000000
+	flagM    = 0x40000000
+	flagSRID = 0x20000000
+)
+
+// Unmarshal parses the WKB geometry b, which may also be hex encoded as output
+// by PostGIS. It returns the geometry and its SRID, or zero if b has none.
+// Coordinates are taken to be longitude then latitude. Z coordinates are kept
+// as altitudes and M coordinates dropped. Empty geometries are returned as
+// empty geometry collections.
+func Unmarshal(b []byte) (*geojson.Geometry, int, error) {
+	// Binary WKB starts with a byte order of 0 or 1, never an ASCII digit.
+	b = bytes.TrimSpace(b)
+	if len(b) > 0 && (b[0] == '0' || b[0] == '\\') {
+		h := bytes.TrimPrefix(b, []byte(`\x`))
+		b = make([]byte, hex.DecodedLen(len(h)))
+		if _, err := hex.Decode(b, h); err != nil {
+			return nil, 0, fmt.Errorf("wkb: invalid hex: %s", err)
+		}
+	}
+
+	p := &parser{b: b}
+	g, srid, err := p.geometry()
+	if err != nil {
+		return nil, 0, err
+	}
+	if p.pos < len(p.b) {
+		return nil, 0, fmt.Errorf("wkb: %d trailing bytes", len(p.b)-p.pos)
+	}
+	return g, srid, nil
+}
+
+// parser reads WKB.
+type parser struct {
+	b     []byte
+	pos   int
+	order binary.ByteOrder
+
+	// z and m are set if the coordinates of the current geometry have Z and
+	// M values.
+	z, m bool
+}
+
+// geometry reads a geometry and its SRID, if given.
+func (p *parser) geometry() (*geojson.Geometry, int, error) {
+	start := p.pos
+	if p.pos >= len(p.b) {
+		return nil, 0, p.truncated()
+	}
+	switch p.b[p.pos] {
+	case 0:
+		p.order = binary.BigEndian
+	case 1:
+		p.order = binary.LittleEndian
+	default:
+		return nil, 0, fmt.Errorf("wkb: invalid byte order %d at offset %d", p.b[p.pos], p.pos)
+	}
+	p.pos++
+
+	code, err := p.uint32()
+	if err != nil {
+		return nil, 0, err
+	}
+	srid := 0
+	if code&flagSRID != 0 {
+		s, err := p.uint32()
+		if err != nil {
+			return nil, 0, err
+		}
+		srid = int(int32(s))
+	}
+	z, m := code&flagZ != 0, code&flagM != 0
+	base := code &^ (flagZ | flagM | flagSRID)
+	switch base / 1000 {
+	case 1:
+		z = true
+	case 2:
+		m = true
+	case 3:
+		z, m = true, true
+	}
+	typ, ok := types[base%1000]
+	if !ok || base >= 4000 {
+		return nil, 0, fmt.Errorf("wkb: unknown geometry type %d at offset %d", code, start)
+	}
+
+	order, pz, pm := p.order, p.z, p.m
+	p.z, p.m = z, m
+	defer func() { p.order, p.z, p.m = order, pz, pm }()
+
+	g := &geojson.Geometry{Type: typ}
+	switch typ {
+	case geojson.Point:
+		pos, err := p.position()
+		if err != nil {
+			return nil, 0, err
+		}
+		if math.IsNaN(pos[0]) && math.IsNaN(pos[1]) {
+			return &geojson.Geometry{Type: geojson.GeometryCollection}, srid, nil
+		}
+		g.Point = pos
+	case geojson.LineString:
+		g.LineString, err = p.positions()
+	case geojson.Polygon:
+		g.Polygon, err = p.polygon()
+	default:
+		err = p.children(g)
+	}
+	if err != nil {
+		return nil, 0, err
+	}
+	if g.Type != geojson.Point && g.Type != geojson.GeometryCollection && isEmpty(g) {
+		return &geojson.Geometry{Type: geojson.GeometryCollection}, srid, nil
+	}
+	return g, srid, nil
+}
+
+// children reads the geometries of the multi-geometry or collection g.
+func (p *parser) children(g *geojson.Geometry) error {
+	n, err := p.count(5)
+	if err != nil {
+		return err
+	}
+	for i := 0; i < n; i++ {
+		start := p.pos
+		child, _, err := p.geometry()
+		if err != nil {
+			return err
+		}
+		if g.Type == geojson.GeometryCollection {
+			g.Geometries = append(g.Geometries, child)
+			continue
+		}
+		if isEmpty(child) {
+			continue
+		}
+		switch {
+		case g.Type == geojson.MultiPoint && child.Type == geojson.Point:
+			g.MultiPoint = append(g.MultiPoint, child.Point)
+		case g.Type == geojson.MultiLineString && child.Type == geojson.LineString:
+			g.MultiLineString = append(g.MultiLineString, child.LineString)
+		case g.Type == geojson.MultiPolygon && child.Type == geojson.Polygon:
+			g.MultiPolygon = append(g.MultiPolygon, child.Polygon)
+		default:
+			return fmt.Errorf("wkb: %s contains %s at offset %d", g.Type, child.Type, start)
+		}
+	}
+	return nil
+}
+
+// isEmpty reports whether g has no positions.
+func isEmpty(g *geojson.Geometry) bool {
+	switch g.Type {
+	case geojson.LineString:
+		return len(g.LineString) == 0
+	case geojson.Polygon:
+		return len(g.Polygon) == 0
+	case geojson.MultiPoint:
+		return len(g.MultiPoint) == 0
+	case geojson.MultiLineString:
+		return len(g.MultiLineString) == 0
+	case geojson.MultiPolygon:
+		return len(g.MultiPolygon) == 0
+	case geojson.GeometryCollection:
+		return len(g.Geometries) == 0
+	}
+	return false
+}
+
+// polygon reads a list of rings.
+func (p *parser) polygon() ([][][]float64, error) {
+	n, err := p.count(4)
+	if err != nil {
+		return nil, err
+	}
+	var rings [][][]float64
+	for i := 0; i < n; i++ {
+		ring, err := p.positions()
+		if err != nil {
+			return nil, err
+		}
+		rings = append(rings, ring)
+	}
+	return rings, nil
+}
+
+// positions reads a list of positions.
+func (p *parser) positions() ([][]float64, error) {
+	n, err := p.count(p.dims() * 8)
+	if err != nil {
+		return nil, err
+	}
+	var ps [][]float64
+	for i := 0; i < n; i++ {
+		pos, err := p.position()
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, pos)
+	}
+	return ps, nil
+}
+
+// position reads a position, keeping x, y and any z coordinate.
+func (p *parser) position() ([]float64, error) {
+	xs := make([]float64, p.dims())
+	for i := range xs {
+		u, err := p.uint64()
+		if err != nil {
+			return nil, err
+		}
+		xs[i] = math.Float64frombits(u)
+	}
+	if p.m {
+		xs = xs[:len(xs)-1]
+	}
+	return xs, nil
+}
+
+// dims returns the number of coordinates of positions.
+func (p *parser) dims() int {
+	n := 2
+	if p.z {
+		n++
+	}
+	if p.m {
+		n++
+	}
+	return n
+}
+
+// count reads the number of items in a list, checking that there are enough
+// bytes left for items of at least size bytes.
+func (p *parser) count(size int) (int, error) {
+	u, err := p.uint32()
+	if err != nil {
+		return 0, err
+	}
+	if uint64(u)*uint64(size) > uint64(len(p.b)-p.pos) {
+		return 0, p.truncated()
+	}
+	return int(u), nil
+}
+
+func (p *parser) uint32() (uint32, error) {
+	if len(p.b)-p.pos < 4 {
+		return 0, p.truncated()
+	}
+	u := p.order.Uint32(p.b[p.pos:])
+	p.pos += 4
+	return u, nil
+}
+
+func (p *parser) uint64() (uint64, error) {
+	if len(p.b)-p.pos < 8 {
+		return 0, p.truncated()
+	}
+	u := p.order.Uint64(p.b[p.pos:])
+	p.pos += 8
+	return u, nil
+}
+
+// truncated returns an error for input ending at the current position.
+func (p *parser) truncated() error {
+	return fmt.Errorf("wkb: truncated at offset %d", p.pos)
+}
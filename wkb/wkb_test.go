@@ -0,0 +1,164 @@
+package wkb
+
+import (
+	"bytes"
+	"encoding/binary"
+	"math"
+	"testing"
+
+	"github.com/mmcloughlin/globe/geojson"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// build encodes values as WKB in the given byte order. Bytes are written as
+// is, ints as uint32 and floats as float64.
+func build(order binary.ByteOrder, values ...interface{}) []byte {
+	var buf bytes.Buffer
+	for _, v := range values {
+		switch v := v.(type) {
+		case byte:
+			buf.WriteByte(v)
+		case int:
+			binary.Write(&buf, order, uint32(v))
+		case uint32:
+			binary.Write(&buf, order, v)
+		case float64:
+			binary.Write(&buf, order, v)
+		}
+	}
+	return buf.Bytes()
+}
+
+const (
+	big    byte = 0
+	little byte = 1
+)
+
+func TestUnmarshal(t *testing.T) {
+	le, be := binary.LittleEndian, binary.BigEndian
+	cases := []struct {
+		Name string
+		WKB  []byte
+		Want *geojson.Geometry
+	}{
+		{
+			"Point",
+			build(le, little, 1, 30.0, 10.0),
+			&geojson.Geometry{Type: geojson.Point, Point: []float64{30, 10}},
+		},
+		{
+			"PointBigEndian",
+			build(be, big, 1, 30.0, 10.0),
+			&geojson.Geometry{Type: geojson.Point, Point: []float64{30, 10}},
+		},
+		{
+			"LineString",
+			build(le, little, 2, 2, 30.0, 10.0, 10.0, 30.0),
+			&geojson.Geometry{Type: geojson.LineString, LineString: [][]float64{{30, 10}, {10, 30}}},
+		},
+		{
+			"Polygon",
+			build(le, little, 3, 1, 4, 0.0, 0.0, 1.0, 0.0, 1.0, 1.0, 0.0, 0.0),
+			&geojson.Geometry{Type: geojson.Polygon, Polygon: [][][]float64{{{0, 0}, {1, 0}, {1, 1}, {0, 0}}}},
+		},
+		{
+			"MultiPoint",
+			append(build(le, little, 4, 2, little, 1, 1.0, 2.0), build(binary.BigEndian, big, 1, 3.0, 4.0)...),
+			&geojson.Geometry{Type: geojson.MultiPoint, MultiPoint: [][]float64{{1, 2}, {3, 4}}},
+		},
+		{
+			"MultiLineString",
+			build(le, little, 5, 1, little, 2, 2, 1.0, 2.0, 3.0, 4.0),
+			&geojson.Geometry{Type: geojson.MultiLineString, MultiLineString: [][][]float64{{{1, 2}, {3, 4}}}},
+		},
+		{
+			"MultiPolygon",
+			build(le, little, 6, 1, little, 3, 1, 4, 0.0, 0.0, 1.0, 0.0, 1.0, 1.0, 0.0, 0.0),
+			&geojson.Geometry{Type: geojson.MultiPolygon, MultiPolygon: [][][][]float64{{{{0, 0}, {1, 0}, {1, 1}, {0, 0}}}}},
+		},
+		{
+			"GeometryCollection",
+			build(le, little, 7, 2, little, 1, 1.0, 2.0, little, 2, 0),
+			&geojson.Geometry{Type: geojson.GeometryCollection, Geometries: []*geojson.Geometry{
+				{Type: geojson.Point, Point: []float64{1, 2}},
+				{Type: geojson.GeometryCollection},
+			}},
+		},
+		{
+			"EmptyPoint",
+			build(le, little, 1, math.NaN(), math.NaN()),
+			&geojson.Geometry{Type: geojson.GeometryCollection},
+		},
+		{
+			"ISOZ",
+			build(le, little, 1001, 1.0, 2.0, 3.0),
+			&geojson.Geometry{Type: geojson.Point, Point: []float64{1, 2, 3}},
+		},
+		{
+			"ISOM",
+			build(le, little, 2002, 1, 1.0, 2.0, 3.0),
+			&geojson.Geometry{Type: geojson.LineString, LineString: [][]float64{{1, 2}}},
+		},
+		{
+			"ISOZM",
+			build(le, little, 3001, 1.0, 2.0, 3.0, 4.0),
+			&geojson.Geometry{Type: geojson.Point, Point: []float64{1, 2, 3}},
+		},
+		{
+			"EWKBZM",
+			build(le, little, uint32(flagZ|flagM|1), 1.0, 2.0, 3.0, 4.0),
+			&geojson.Geometry{Type: geojson.Point, Point: []float64{1, 2, 3}},
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.Name, func(t *testing.T) {
+			g, srid, err := Unmarshal(c.WKB)
+			require.NoError(t, err)
+			assert.Equal(t, 0, srid)
+			assert.Equal(t, c.Want, g)
+		})
+	}
+}
+
+func TestUnmarshalHex(t *testing.T) {
+	for _, h := range []string{
+		"0101000020E6100000000000000000F03F0000000000000040",
+		`\x0101000020e6100000000000000000f03f0000000000000040`,
+		" 0101000020E6100000000000000000F03F0000000000000040\n",
+	} {
+		g, srid, err := Unmarshal([]byte(h))
+		require.NoError(t, err, h)
+		assert.Equal(t, 4326, srid)
+		assert.Equal(t, []float64{1, 2}, g.Point)
+	}
+
+	g, srid, err := Unmarshal([]byte("01" + "01000000" + "0000000000003E40" + "0000000000002440"))
+	require.NoError(t, err)
+	assert.Equal(t, 0, srid)
+	assert.Equal(t, []float64{30, 10}, g.Point)
+}
+
+func TestUnmarshalErrors(t *testing.T) {
+	le := binary.LittleEndian
+	cases := []struct {
+		Name  string
+		WKB   []byte
+		Error string
+	}{
+		{"Empty", nil, "wkb: truncated at offset 0"},
+		{"Hex", []byte("01zz"), "wkb: invalid hex: encoding/hex: invalid byte: U+007A 'z'"},
+		{"ByteOrder", []byte{2, 1, 0, 0, 0}, "wkb: invalid byte order 2 at offset 0"},
+		{"Type", build(le, little, 17), "wkb: unknown geometry type 17 at offset 0"},
+		{"Truncated", build(le, little, 1, 1.0), "wkb: truncated at offset 13"},
+		{"Count", build(le, little, 2, 1000000, 1.0, 2.0), "wkb: truncated at offset 9"},
+		{"Trailing", append(build(le, little, 1, 1.0, 2.0), 0), "wkb: 1 trailing bytes"},
+		{"Mixed", build(le, little, 4, 1, little, 2, 1, 1.0, 2.0), "wkb: MultiPoint contains LineString at offset 9"},
+	}
+	for _, c := range cases {
+		t.Run(c.Name, func(t *testing.T) {
+			_, _, err := Unmarshal(c.WKB)
+			assert.EqualError(t, err, c.Error)
+		})
+	}
+}
@@ -0,0 +1,75 @@
+package globe
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/mmcloughlin/globe/geojson"
+	"github.com/mmcloughlin/globe/wkb"
+	"github.com/mmcloughlin/globe/wkt"
+)
+
+// sridWGS84 is the SRID of WGS 84 longitude and latitude coordinates.
+const sridWGS84 = 4326
+
+// DrawWKT draws the geometry in well-known text s, which may have a PostGIS
+// SRID prefix, as in DrawFeatureCollection. Geometries with an SRID other than
+// 4326, or with coordinates out of range for longitude and latitude, are not
+// drawn.
+func (g *Globe) DrawWKT(s string, style ...Option) error {
+	geom, srid, err := wkt.Unmarshal(s)
+	if err != nil {
+		return err
+	}
+	return g.drawSRIDGeometry(geom, srid, style...)
+}
+
+// DrawWKB draws the geometry in well-known binary b, which may be hex encoded
+// and use the PostGIS extensions for Z, M and SRID, as in
+// DrawFeatureCollection. Geometries with an SRID other than 4326, or with
+// coordinates out of range for longitude and latitude, are not drawn.
+func (g *Globe) DrawWKB(b []byte, style ...Option) error {
+	geom, srid, err := wkb.Unmarshal(b)
+	if err != nil {
+		return err
+	}
+	return g.drawSRIDGeometry(geom, srid, style...)
+}
+
+// drawSRIDGeometry draws geom with the given SRID, which must be zero or
+// 4326.
+func (g *Globe) drawSRIDGeometry(geom *geojson.Geometry, srid int, style ...Option) error {
+	if srid != 0 && srid != sridWGS84 {
+		return fmt.Errorf("globe: unsupported SRID %d: coordinates must be WGS 84 longitude and latitude (SRID %d)", srid, sridWGS84)
+	}
+	if err := checkLatLngs(geom); err != nil {
+		return err
+	}
+	fc := &geojson.FeatureCollection{
+		Features: []*geojson.Feature{{Geometry: geom}},
+	}
+	g.DrawFeatureCollection(fc, style...)
+	return nil
+}
+
+// checkLatLngs checks that the positions of geom are valid longitudes and
+// latitudes.
+func checkLatLngs(geom *geojson.Geometry) error {
+	var s geojsonShapes
+	s.add(geom)
+	points := s.points
+	for _, line := range s.lines {
+		points = append(points, line...)
+	}
+	for _, polygon := range s.polygons {
+		for _, ring := range polygon {
+			points = append(points, ring...)
+		}
+	}
+	for _, p := range points {
+		if math.IsNaN(p.lat) || math.IsNaN(p.lng) || math.Abs(p.lat) > 90 || math.Abs(p.lng) > 180 {
+			return fmt.Errorf("globe: position (%v %v) out of range for longitude and latitude", p.lng, p.lat)
+		}
+	}
+	return nil
+}
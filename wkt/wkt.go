@@ -0,0 +1,298 @@
+// Package wkt parses geometries in the well-known text representation of the
+// OGC Simple Features specification, and PostGIS extended WKT with an SRID
+// prefix, into GeoJSON geometries.
+package wkt
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/mmcloughlin/globe/geojson"
+)
+
+// Unmarshal parses the WKT geometry s. It returns the geometry and its SRID,
+// or zero if s has no SRID prefix. Coordinates are taken to be longitude then
+// latitude. Z coordinates are kept as altitudes and M coordinates dropped.
+// Empty geometries are returned as empty geometry collections.
+func Unmarshal(s string) (*geojson.Geometry, int, error) {
+	p := &parser{s: s}
+	srid := 0
+	if p.keyword("SRID") {
+		if err := p.expect('='); err != nil {
+			return nil, 0, err
+		}
+		n, err := p.word()
+		if err != nil {
+			return nil, 0, err
+		}
+		srid, err = strconv.Atoi(n)
+		if err != nil {
+			return nil, 0, p.errorf("invalid SRID %q", n)
+		}
+		if err := p.expect(';'); err != nil {
+			return nil, 0, err
+		}
+	}
+	g, err := p.geometry()
+	if err != nil {
+		return nil, 0, err
+	}
+	p.space()
+	if p.pos < len(p.s) {
+		return nil, 0, p.errorf("unexpected %q", p.s[p.pos:])
+	}
+	return g, srid, nil
+}
+
+// Geometry tags, including the dimension suffixes of extended WKT.
+var tags = map[string]geojson.GeometryType{
+	"POINT":              geojson.Point,
+	"LINESTRING":         geojson.LineString,
+	"POLYGON":            geojson.Polygon,
+	"MULTIPOINT":         geojson.MultiPoint,
+	"MULTILINESTRING":    geojson.MultiLineString,
+	"MULTIPOLYGON":       geojson.MultiPolygon,
+	"GEOMETRYCOLLECTION": geojson.GeometryCollection,
+}
+
+// parser is a recursive descent parser of WKT.
+type parser struct {
+	s   string
+	pos int
+
+	// m is set if the coordinates of the current geometry have an M value.
+	m bool
+}
+
+// geometry parses a tagged geometry.
+func (p *parser) geometry() (*geojson.Geometry, error) {
+	tag, err := p.word()
+	if err != nil {
+		return nil, err
+	}
+	tag = strings.ToUpper(tag)
+
+	// Dimensions are given by a separate Z, M or ZM keyword, or a suffix.
+	dims := ""
+	if _, ok := tags[tag]; !ok {
+		for _, suffix := range []string{"ZM", "Z", "M"} {
+			if t := strings.TrimSuffix(tag, suffix); t != tag {
+				if _, ok := tags[t]; ok {
+					tag, dims = t, suffix
+					break
+				}
+			}
+		}
+	}
+	typ, ok := tags[tag]
+	if !ok {
+		return nil, p.errorf("unknown geometry type %q", tag)
+	}
+	if dims == "" {
+		for _, d := range []string{"ZM", "Z", "M"} {
+			if p.keyword(d) {
+				dims = d
+				break
+			}
+		}
+	}
+	m := p.m
+	p.m = dims == "M" || dims == "ZM"
+	defer func() { p.m = m }()
+
+	if p.keyword("EMPTY") {
+		return &geojson.Geometry{Type: geojson.GeometryCollection}, nil
+	}
+
+	g := &geojson.Geometry{Type: typ}
+	switch typ {
+	case geojson.Point:
+		g.Point, err = p.point()
+	case geojson.LineString:
+		g.LineString, err = p.positions()
+	case geojson.Polygon:
+		g.Polygon, err = p.polygon()
+	case geojson.MultiPoint:
+		err = p.list(func() error {
+			// Points may be given with or without parentheses.
+			var pos []float64
+			var err error
+			if p.peek('(') {
+				pos, err = p.point()
+			} else {
+				pos, err = p.position()
+			}
+			g.MultiPoint = append(g.MultiPoint, pos)
+			return err
+		})
+	case geojson.MultiLineString:
+		err = p.list(func() error {
+			line, err := p.positions()
+			g.MultiLineString = append(g.MultiLineString, line)
+			return err
+		})
+	case geojson.MultiPolygon:
+		err = p.list(func() error {
+			polygon, err := p.polygon()
+			g.MultiPolygon = append(g.MultiPolygon, polygon)
+			return err
+		})
+	case geojson.GeometryCollection:
+		err = p.list(func() error {
+			child, err := p.geometry()
+			g.Geometries = append(g.Geometries, child)
+			return err
+		})
+	}
+	if err != nil {
+		return nil, err
+	}
+	return g, nil
+}
+
+// point parses a parenthesized position.
+func (p *parser) point() ([]float64, error) {
+	var pos []float64
+	err := p.list(func() error {
+		if pos != nil {
+			return p.errorf("point has more than one position")
+		}
+		var err error
+		pos, err = p.position()
+		return err
+	})
+	return pos, err
+}
+
+// positions parses a parenthesized list of positions.
+func (p *parser) positions() ([][]float64, error) {
+	var ps [][]float64
+	err := p.list(func() error {
+		pos, err := p.position()
+		ps = append(ps, pos)
+		return err
+	})
+	return ps, err
+}
+
+// polygon parses a parenthesized list of rings.
+func (p *parser) polygon() ([][][]float64, error) {
+	var rings [][][]float64
+	err := p.list(func() error {
+		ring, err := p.positions()
+		rings = append(rings, ring)
+		return err
+	})
+	return rings, err
+}
+
+// position parses a position of two to four coordinates, keeping x, y and any
+// z coordinate.
+func (p *parser) position() ([]float64, error) {
+	var xs []float64
+	for len(xs) < 4 {
+		p.space()
+		if p.pos == len(p.s) || strings.IndexByte("(),", p.s[p.pos]) >= 0 {
+			break
+		}
+		w, err := p.word()
+		if err != nil {
+			return nil, err
+		}
+		x, err := strconv.ParseFloat(w, 64)
+		if err != nil {
+			return nil, p.errorf("invalid coordinate %q", w)
+		}
+		xs = append(xs, x)
+	}
+	if len(xs) < 2 {
+		return nil, p.errorf("position must have at least two coordinates")
+	}
+	if len(xs) == 4 || (len(xs) == 3 && p.m) {
+		xs = xs[:len(xs)-1]
+	}
+	return xs, nil
+}
+
+// list parses a parenthesized, comma separated list, calling item to parse
+// each item.
+func (p *parser) list(item func() error) error {
+	if err := p.expect('('); err != nil {
+		return err
+	}
+	for {
+		if err := item(); err != nil {
+			return err
+		}
+		p.space()
+		if p.pos < len(p.s) && p.s[p.pos] == ',' {
+			p.pos++
+			continue
+		}
+		return p.expect(')')
+	}
+}
+
+// word returns the next run of characters other than whitespace and
+// punctuation.
+func (p *parser) word() (string, error) {
+	p.space()
+	start := p.pos
+	for p.pos < len(p.s) && strings.IndexByte(" \t\r\n(),;=", p.s[p.pos]) < 0 {
+		p.pos++
+	}
+	if p.pos == start {
+		return "", p.errorf("unexpected %s", p.next())
+	}
+	return p.s[start:p.pos], nil
+}
+
+// keyword consumes the next word if it is kw, ignoring case.
+func (p *parser) keyword(kw string) bool {
+	p.space()
+	end := p.pos + len(kw)
+	if end > len(p.s) || !strings.EqualFold(p.s[p.pos:end], kw) {
+		return false
+	}
+	if end < len(p.s) && strings.IndexByte(" \t\r\n(),;=", p.s[end]) < 0 {
+		return false
+	}
+	p.pos = end
+	return true
+}
+
+// peek reports whether the next character is c.
+func (p *parser) peek(c byte) bool {
+	p.space()
+	return p.pos < len(p.s) && p.s[p.pos] == c
+}
+
+// expect consumes the character c.
+func (p *parser) expect(c byte) error {
+	if !p.peek(c) {
+		return p.errorf("expected %q, got %s", c, p.next())
+	}
+	p.pos++
+	return nil
+}
+
+// next describes the next character, for errors.
+func (p *parser) next() string {
+	if p.pos == len(p.s) {
+		return "end of input"
+	}
+	return strconv.Quote(p.s[p.pos : p.pos+1])
+}
+
+// space skips whitespace.
+func (p *parser) space() {
+	for p.pos < len(p.s) && strings.IndexByte(" \t\r\n", p.s[p.pos]) >= 0 {
+		p.pos++
+	}
+}
+
+// errorf returns an error at the current position.
+func (p *parser) errorf(format string, args ...interface{}) error {
+	return fmt.Errorf("wkt: offset %d: %s", p.pos, fmt.Sprintf(format, args...))
+}
@@ -0,0 +1,137 @@
+package wkt
+
+import (
+	"testing"
+
+	"github.com/mmcloughlin/globe/geojson"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnmarshal(t *testing.T) {
+	cases := []struct {
+		WKT  string
+		Want *geojson.Geometry
+	}{
+		{
+			"POINT (30 10)",
+			&geojson.Geometry{Type: geojson.Point, Point: []float64{30, 10}},
+		},
+		{
+			"point(-2.5e0 +51.25)",
+			&geojson.Geometry{Type: geojson.Point, Point: []float64{-2.5, 51.25}},
+		},
+		{
+			"LINESTRING (30 10, 10 30, 40 40)",
+			&geojson.Geometry{Type: geojson.LineString, LineString: [][]float64{{30, 10}, {10, 30}, {40, 40}}},
+		},
+		{
+			"POLYGON ((35 10, 45 45, 15 40, 10 20, 35 10), (20 30, 35 35, 30 20, 20 30))",
+			&geojson.Geometry{Type: geojson.Polygon, Polygon: [][][]float64{
+				{{35, 10}, {45, 45}, {15, 40}, {10, 20}, {35, 10}},
+				{{20, 30}, {35, 35}, {30, 20}, {20, 30}},
+			}},
+		},
+		{
+			"MULTIPOINT ((10 40), (40 30))",
+			&geojson.Geometry{Type: geojson.MultiPoint, MultiPoint: [][]float64{{10, 40}, {40, 30}}},
+		},
+		{
+			"MULTIPOINT (10 40, 40 30)",
+			&geojson.Geometry{Type: geojson.MultiPoint, MultiPoint: [][]float64{{10, 40}, {40, 30}}},
+		},
+		{
+			"MULTILINESTRING ((10 10, 20 20), (40 40, 30 30))",
+			&geojson.Geometry{Type: geojson.MultiLineString, MultiLineString: [][][]float64{
+				{{10, 10}, {20, 20}},
+				{{40, 40}, {30, 30}},
+			}},
+		},
+		{
+			"MULTIPOLYGON (((30 20, 45 40, 10 40, 30 20)), ((15 5, 40 10, 10 20, 5 10, 15 5)))",
+			&geojson.Geometry{Type: geojson.MultiPolygon, MultiPolygon: [][][][]float64{
+				{{{30, 20}, {45, 40}, {10, 40}, {30, 20}}},
+				{{{15, 5}, {40, 10}, {10, 20}, {5, 10}, {15, 5}}},
+			}},
+		},
+		{
+			"GEOMETRYCOLLECTION (POINT (40 10), LINESTRING (10 10, 20 20), POINT EMPTY)",
+			&geojson.Geometry{Type: geojson.GeometryCollection, Geometries: []*geojson.Geometry{
+				{Type: geojson.Point, Point: []float64{40, 10}},
+				{Type: geojson.LineString, LineString: [][]float64{{10, 10}, {20, 20}}},
+				{Type: geojson.GeometryCollection},
+			}},
+		},
+		// Dimensions.
+		{
+			"POINT Z (1 2 3)",
+			&geojson.Geometry{Type: geojson.Point, Point: []float64{1, 2, 3}},
+		},
+		{
+			"POINT (1 2 3)",
+			&geojson.Geometry{Type: geojson.Point, Point: []float64{1, 2, 3}},
+		},
+		{
+			"POINT M (1 2 3)",
+			&geojson.Geometry{Type: geojson.Point, Point: []float64{1, 2}},
+		},
+		{
+			"POINTM(1 2 3)",
+			&geojson.Geometry{Type: geojson.Point, Point: []float64{1, 2}},
+		},
+		{
+			"LINESTRING ZM (1 2 3 4, 5 6 7 8)",
+			&geojson.Geometry{Type: geojson.LineString, LineString: [][]float64{{1, 2, 3}, {5, 6, 7}}},
+		},
+		{
+			"GEOMETRYCOLLECTION M (POINT M (1 2 3), POINT Z (1 2 3))",
+			&geojson.Geometry{Type: geojson.GeometryCollection, Geometries: []*geojson.Geometry{
+				{Type: geojson.Point, Point: []float64{1, 2}},
+				{Type: geojson.Point, Point: []float64{1, 2, 3}},
+			}},
+		},
+		{
+			"POLYGON EMPTY",
+			&geojson.Geometry{Type: geojson.GeometryCollection},
+		},
+	}
+	for _, c := range cases {
+		g, srid, err := Unmarshal(c.WKT)
+		require.NoError(t, err, c.WKT)
+		assert.Equal(t, 0, srid)
+		assert.Equal(t, c.Want, g, c.WKT)
+	}
+}
+
+func TestUnmarshalSRID(t *testing.T) {
+	g, srid, err := Unmarshal("SRID=4326;POINT(-2.5 51.25)")
+	require.NoError(t, err)
+	assert.Equal(t, 4326, srid)
+	assert.Equal(t, []float64{-2.5, 51.25}, g.Point)
+
+	_, srid, err = Unmarshal(" srid = 3857 ; POINT (1 2)")
+	require.NoError(t, err)
+	assert.Equal(t, 3857, srid)
+}
+
+func TestUnmarshalErrors(t *testing.T) {
+	cases := []struct {
+		WKT   string
+		Error string
+	}{
+		{"", "wkt: offset 0: unexpected end of input"},
+		{"CIRCLE (1 2)", `wkt: offset 6: unknown geometry type "CIRCLE"`},
+		{"POINT 1 2", `wkt: offset 6: expected '(', got "1"`},
+		{"POINT (1)", "wkt: offset 8: position must have at least two coordinates"},
+		{"POINT (1 2, 3 4)", "wkt: offset 11: point has more than one position"},
+		{"POINT (1 north)", `wkt: offset 14: invalid coordinate "north"`},
+		{"LINESTRING (1 2, 3 4", "wkt: offset 20: expected ')', got end of input"},
+		{"POINT (1 2) POINT (3 4)", `wkt: offset 12: unexpected "POINT (3 4)"`},
+		{"SRID=x;POINT (1 2)", `wkt: offset 6: invalid SRID "x"`},
+		{"SRID=4326 POINT (1 2)", `wkt: offset 10: expected ';', got "P"`},
+	}
+	for _, c := range cases {
+		_, _, err := Unmarshal(c.WKT)
+		assert.EqualError(t, err, c.Error, c.WKT)
+	}
+}
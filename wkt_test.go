@@ -0,0 +1,63 @@
+package globe
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testWKT = `SRID=4326;GEOMETRYCOLLECTION(
+	POLYGON((-80.19 25.77, -64.78 32.30, -66.11 18.47, -80.19 25.77)),
+	LINESTRING(-2.588323 51.453349, -73.903879 40.645423, -122.4194 37.7749),
+	MULTIPOINT((-2.588323 51.453349), (-73.903879 40.645423))
+)`
+
+func TestDrawWKT(t *testing.T) {
+	g := New()
+	require.NoError(t, g.DrawWKT(testWKT))
+	require.Len(t, g.layers, 3)
+	assert.Len(t, g.layers[0].rings, 1)
+	lines := g.layers[1]
+	require.Len(t, lines.paths, 1)
+	path := lines.paths[0]
+	assert.Equal(t, latlng{51.453349, -2.588323}, path[0])
+	assert.Equal(t, latlng{37.7749, -122.4194}, path[len(path)-1])
+	assert.True(t, len(path) > 3)
+	assert.Len(t, g.layers[2].dots, 2)
+}
+
+func TestDrawWKB(t *testing.T) {
+	g := New()
+	// SRID=4326;POINT(1 2) as hex EWKB.
+	require.NoError(t, g.DrawWKB([]byte("0101000020E6100000000000000000F03F0000000000000040")))
+	require.Len(t, g.layers, 1)
+	assert.Equal(t, []dot{{latlng{2, 1}, defaultDotRadius}}, g.layers[0].dots)
+}
+
+func TestDrawWKTErrors(t *testing.T) {
+	g := New()
+	err := g.DrawWKT("SRID=3857;POINT(-288128 6703020)")
+	assert.EqualError(t, err, "globe: unsupported SRID 3857: coordinates must be WGS 84 longitude and latitude (SRID 4326)")
+	// SRID=3857;POINT(1 2) as hex EWKB.
+	err = g.DrawWKB([]byte("0101000020110F0000000000000000F03F0000000000000040"))
+	assert.EqualError(t, err, "globe: unsupported SRID 3857: coordinates must be WGS 84 longitude and latitude (SRID 4326)")
+	err = g.DrawWKT("LINESTRING(0 0, 10 95)")
+	assert.EqualError(t, err, "globe: position (10 95) out of range for longitude and latitude")
+	err = g.DrawWKT("SRID=4326;MULTIPOLYGON(((0 0, 181 0, 0 1, 0 0)))")
+	assert.EqualError(t, err, "globe: position (181 0) out of range for longitude and latitude")
+	// POINT(200 -100) as WKB.
+	err = g.DrawWKB([]byte("0101000000000000000000694000000000000059C0"))
+	assert.EqualError(t, err, "globe: position (200 -100) out of range for longitude and latitude")
+	assert.Error(t, g.DrawWKT("POINT(1)"))
+	assert.Error(t, g.DrawWKB([]byte{1, 1}))
+	assert.Empty(t, g.layers)
+}
+
+func TestSVGDrawWKT(t *testing.T) {
+	g := New()
+	g.DrawGraticule(10)
+	require.NoError(t, g.DrawWKT(testWKT))
+	g.CenterOn(35, -60)
+	AssertSVGMD5(t, g, "127bd502eaa3ee4ba0fb50953abc0118")
+}